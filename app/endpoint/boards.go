@@ -0,0 +1,189 @@
+package endpoint
+
+import (
+	"bytes"
+	"encoding/base64"
+	"time"
+
+	"github.com/rotisserie/eris"
+	"github.com/sirupsen/logrus"
+	"nevissGo/app/serializer"
+	"nevissGo/app/service"
+	"nevissGo/framework"
+	"nevissGo/pkg/boardfile"
+)
+
+var _ framework.Endpoint = &Boards{}
+
+type Boards struct {
+	service *service.Boards
+}
+
+func NewBoards(service *service.Boards) *Boards {
+	return &Boards{
+		service: service,
+	}
+}
+
+func (b *Boards) Endpoints(router *framework.Endpoints) {
+	router.Register("board/list", b.List)
+	router.Register("board/get", b.Get)
+	router.Register("board/create", b.Create)
+	router.Register("board/set_open", b.SetOpen)
+	router.Register("board/timelapse", b.Timelapse, framework.AdminOnly)
+	router.Register("board/export", b.Export, framework.AdminOnly)
+	router.Register("board/import", b.Import, framework.AdminOnly)
+}
+
+func (b *Boards) List(c *framework.Context) error {
+	boards, err := b.service.List(c.Request().Context())
+	if err != nil {
+		return eris.Wrap(err, "failed to list boards")
+	}
+	return c.Ok(serializer.NewBoardInfos(boards))
+}
+
+type GetBoardDto struct {
+	BoardID int `json:"board_id" validate:"min=0"`
+}
+
+func (b *Boards) Get(c *framework.Context) error {
+	request, err := framework.BindAndValidate[GetBoardDto](c)
+	if err != nil {
+		return eris.Wrap(err, "failed to bind and validate request")
+	}
+
+	board, err := b.service.Get(c.Request().Context(), request.BoardID)
+	if err != nil {
+		return eris.Wrap(err, "failed to get board")
+	}
+	return c.Ok(serializer.NewBoardInfo(board))
+}
+
+type CreateBoardDto struct {
+	Name                string  `json:"name" validate:"required,max=64"`
+	Width               int     `json:"width" validate:"required"`
+	Height              int     `json:"height" validate:"required"`
+	CooldownSeconds     float64 `json:"cooldown_seconds" validate:"min=0"`
+	UserCooldownSeconds float64 `json:"user_cooldown_seconds" validate:"min=0"`
+	HypeCost            int     `json:"hype_cost" validate:"min=0"`
+	Palette             string  `json:"palette" validate:"omitempty,palette"`
+}
+
+func (b *Boards) Create(c *framework.Context) error {
+	request, err := framework.BindAndValidate[CreateBoardDto](c)
+	if err != nil {
+		return eris.Wrap(err, "failed to bind and validate request")
+	}
+
+	board, err := b.service.Create(c.Request().Context(), c.User.ID, service.BoardSettings{
+		Name:         request.Name,
+		Width:        request.Width,
+		Height:       request.Height,
+		Cooldown:     time.Duration(request.CooldownSeconds * float64(time.Second)),
+		UserCooldown: time.Duration(request.UserCooldownSeconds * float64(time.Second)),
+		HypeCost:     request.HypeCost,
+		Palette:      request.Palette,
+	})
+	if err != nil {
+		return eris.Wrap(err, "failed to create board")
+	}
+	board.Edges.Owner = c.User
+	return c.Ok(serializer.NewBoardInfo(board))
+}
+
+type SetBoardOpenDto struct {
+	BoardID int  `json:"board_id" validate:"required"`
+	Open    bool `json:"open"`
+}
+
+func (b *Boards) SetOpen(c *framework.Context) error {
+	request, err := framework.BindAndValidate[SetBoardOpenDto](c)
+	if err != nil {
+		return eris.Wrap(err, "failed to bind and validate request")
+	}
+
+	board, err := b.service.SetOpen(c.Request().Context(), request.BoardID, c.User.ID, request.Open)
+	if err != nil {
+		return eris.Wrap(err, "failed to update board")
+	}
+	return c.Ok(serializer.NewBoardInfo(board))
+}
+
+type TimelapseDto struct {
+	BoardID         int     `json:"board_id" validate:"min=0"`
+	IntervalSeconds float64 `json:"interval_seconds" validate:"required,gt=0"`
+	FrameDelayMs    int     `json:"frame_delay_ms" validate:"min=0,max=10000"`
+	Scale           int     `json:"scale" validate:"min=0,max=16"`
+}
+
+func (b *Boards) Timelapse(c *framework.Context) error {
+	request, err := framework.BindAndValidate[TimelapseDto](c)
+	if err != nil {
+		return eris.Wrap(err, "failed to bind and validate request")
+	}
+
+	timelapse, err := b.service.Timelapse(c.Request().Context(), request.BoardID, service.TimelapseOptions{
+		Interval:   time.Duration(request.IntervalSeconds * float64(time.Second)),
+		FrameDelay: time.Duration(request.FrameDelayMs) * time.Millisecond,
+		Scale:      request.Scale,
+	})
+	if err != nil {
+		return eris.Wrap(err, "failed to render timelapse")
+	}
+	return c.Ok(serializer.NewTimelapse(timelapse))
+}
+
+type ExportBoardDto struct {
+	BoardID int    `json:"board_id" validate:"min=0"`
+	Format  string `json:"format" validate:"omitempty,oneof=json binary"`
+}
+
+func (b *Boards) Export(c *framework.Context) error {
+	request, err := framework.BindAndValidate[ExportBoardDto](c)
+	if err != nil {
+		return eris.Wrap(err, "failed to bind and validate request")
+	}
+	if request.Format == "" {
+		request.Format = boardfile.FormatJSON
+	}
+
+	board, file, err := b.service.Export(c.Request().Context(), request.BoardID)
+	if err != nil {
+		return eris.Wrap(err, "failed to export board")
+	}
+
+	var buf bytes.Buffer
+	if err := boardfile.Write(&buf, file, request.Format); err != nil {
+		logrus.WithError(err).WithField("board_id", board.ID).Error("Failed to encode board")
+		return framework.NewInternalError("Failed to encode board")
+	}
+	return c.Ok(serializer.NewBoardExport(board, request.Format, buf.Bytes()))
+}
+
+type ImportBoardDto struct {
+	Name string `json:"name" validate:"max=64"`
+	Data string `json:"data" validate:"required,base64"`
+}
+
+func (b *Boards) Import(c *framework.Context) error {
+	request, err := framework.BindAndValidate[ImportBoardDto](c)
+	if err != nil {
+		return eris.Wrap(err, "failed to bind and validate request")
+	}
+
+	data, _ := base64.StdEncoding.DecodeString(request.Data)
+	file, err := boardfile.Read(bytes.NewReader(data))
+	if err != nil {
+		return framework.NewValidationError("Invalid board file").WithFields(framework.Fields{
+			"reason": err.Error(),
+		})
+	}
+
+	board, err := b.service.Import(c.Request().Context(), c.User.ID, file, request.Name)
+	if err != nil {
+		return eris.Wrap(err, "failed to import board")
+	}
+	board.Edges.Owner = c.User
+	return c.Ok(serializer.NewBoardInfo(board))
+}
@@ -21,6 +21,7 @@ func NewHype(service *service.Hype) *Hype {
 
 func (h *Hype) Endpoints(router *framework.Endpoints) {
 	router.Register("hype/count", h.GetHype)
+	router.Register("hype/ledger", h.GetLedger)
 }
 
 func (h *Hype) GetHype(c *framework.Context) error {
@@ -30,3 +31,16 @@ func (h *Hype) GetHype(c *framework.Context) error {
 	}
 	return c.Ok(serializer.NewHype(hype))
 }
+
+func (h *Hype) GetLedger(c *framework.Context) error {
+	request, err := framework.BindAndValidate[PageDto](c)
+	if err != nil {
+		return eris.Wrap(err, "failed to bind and validate request")
+	}
+
+	entries, err := h.service.Ledger(c.Request().Context(), c.User.ID, request.Offset, request.PageLimit())
+	if err != nil {
+		return eris.Wrap(err, "failed to get hype ledger")
+	}
+	return c.Ok(serializer.NewHypeLedger(entries, request.Offset, request.PageLimit()))
+}
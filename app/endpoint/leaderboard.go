@@ -0,0 +1,57 @@
+package endpoint
+
+import (
+	"github.com/rotisserie/eris"
+	"nevissGo/app/serializer"
+	"nevissGo/app/service"
+	"nevissGo/framework"
+)
+
+var _ framework.Endpoint = &Leaderboard{}
+
+type Leaderboard struct {
+	service *service.Leaderboard
+}
+
+func NewLeaderboard(service *service.Leaderboard) *Leaderboard {
+	return &Leaderboard{
+		service: service,
+	}
+}
+
+func (l *Leaderboard) Endpoints(router *framework.Endpoints) {
+	router.Register("leaderboard/top", l.Top)
+}
+
+type LeaderboardTopDto struct {
+	BoardID int    `json:"board_id" validate:"min=0"`
+	Metric  string `json:"metric" validate:"omitempty,oneof=owned paints"`
+	Window  string `json:"window" validate:"omitempty,oneof=day week all"`
+	Limit   int    `json:"limit" validate:"min=0,max=100"`
+}
+
+func (l *Leaderboard) Top(c *framework.Context) error {
+	request, err := framework.BindAndValidate[LeaderboardTopDto](c)
+	if err != nil {
+		return eris.Wrap(err, "failed to bind and validate request")
+	}
+
+	metric := service.MetricOwned
+	if request.Metric != "" {
+		metric = service.LeaderboardMetric(request.Metric)
+	}
+	window := service.WindowAll
+	if request.Window != "" {
+		window = service.LeaderboardWindow(request.Window)
+	}
+	limit := request.Limit
+	if limit == 0 {
+		limit = defaultPageLimit
+	}
+
+	result, err := l.service.Top(c.Request().Context(), request.BoardID, metric, window, limit, c.User.ID)
+	if err != nil {
+		return eris.Wrap(err, "failed to get leaderboard")
+	}
+	return c.Ok(serializer.NewLeaderboard(result))
+}
@@ -0,0 +1,161 @@
+package endpoint
+
+import (
+	"time"
+
+	"github.com/rotisserie/eris"
+	"nevissGo/app/serializer"
+	"nevissGo/app/service"
+	"nevissGo/ent"
+	"nevissGo/ent/user"
+	"nevissGo/framework"
+)
+
+var _ framework.Endpoint = &Moderation{}
+
+type Moderation struct {
+	service     *service.Moderation
+	users       *service.Users
+	leaderboard *service.Leaderboard
+}
+
+func NewModeration(service *service.Moderation, users *service.Users, leaderboard *service.Leaderboard) *Moderation {
+	return &Moderation{
+		service:     service,
+		users:       users,
+		leaderboard: leaderboard,
+	}
+}
+
+func (m *Moderation) Endpoints(router *framework.Endpoints) {
+	router.Register("admin/ban", m.Ban, framework.AdminOnly)
+	router.Register("admin/unban", m.Unban, framework.AdminOnly)
+	router.Register("admin/set_role", m.SetRole, framework.AdminOnly)
+	router.Register("admin/rollback", m.Rollback, framework.AdminOnly)
+	router.Register("admin/wipe", m.Wipe, framework.AdminOnly)
+}
+
+type BanUserDto struct {
+	GameID string `json:"game_id" validate:"required"`
+	Reason string `json:"reason" validate:"max=255"`
+}
+
+func (m *Moderation) Ban(c *framework.Context) error {
+	request, err := framework.BindAndValidate[BanUserDto](c)
+	if err != nil {
+		return eris.Wrap(err, "failed to bind and validate request")
+	}
+
+	target, err := m.users.GetByGameID(c.Request().Context(), request.GameID)
+	if err != nil {
+		return eris.Wrap(err, "failed to get user")
+	}
+
+	banned, err := m.service.Ban(c.Request().Context(), target.ID, request.Reason, c.User.ID)
+	if err != nil {
+		return eris.Wrap(err, "failed to ban user")
+	}
+	return c.Ok(serializer.NewModeratedUser(banned))
+}
+
+type UnbanUserDto struct {
+	GameID string `json:"game_id" validate:"required"`
+}
+
+func (m *Moderation) Unban(c *framework.Context) error {
+	request, err := framework.BindAndValidate[UnbanUserDto](c)
+	if err != nil {
+		return eris.Wrap(err, "failed to bind and validate request")
+	}
+
+	target, err := m.users.GetByGameID(c.Request().Context(), request.GameID)
+	if err != nil {
+		return eris.Wrap(err, "failed to get user")
+	}
+
+	unbanned, err := m.service.Unban(c.Request().Context(), target.ID, c.User.ID)
+	if err != nil {
+		return eris.Wrap(err, "failed to unban user")
+	}
+	return c.Ok(serializer.NewModeratedUser(unbanned))
+}
+
+type SetRoleDto struct {
+	GameID string `json:"game_id" validate:"required"`
+	Role   string `json:"role" validate:"required,oneof=user admin"`
+}
+
+func (m *Moderation) SetRole(c *framework.Context) error {
+	request, err := framework.BindAndValidate[SetRoleDto](c)
+	if err != nil {
+		return eris.Wrap(err, "failed to bind and validate request")
+	}
+
+	target, err := m.users.GetByGameID(c.Request().Context(), request.GameID)
+	if err != nil {
+		return eris.Wrap(err, "failed to get user")
+	}
+
+	updated, err := m.service.SetRole(c.Request().Context(), target.ID, user.Role(request.Role), c.User.ID)
+	if err != nil {
+		return eris.Wrap(err, "failed to set user role")
+	}
+	return c.Ok(serializer.NewModeratedUser(updated))
+}
+
+type RollbackDto struct {
+	BoardID int    `json:"board_id" validate:"min=0"`
+	GameID  string `json:"game_id" validate:"required"`
+	Since   int64  `json:"since" validate:"min=0"`
+}
+
+func (m *Moderation) Rollback(c *framework.Context) error {
+	request, err := framework.BindAndValidate[RollbackDto](c)
+	if err != nil {
+		return eris.Wrap(err, "failed to bind and validate request")
+	}
+
+	target, err := m.users.GetByGameID(c.Request().Context(), request.GameID)
+	if err != nil {
+		return eris.Wrap(err, "failed to get user")
+	}
+
+	board, pixels, err := m.service.Rollback(c.Request().Context(), request.BoardID, target.ID, time.Unix(request.Since, 0), c.User.ID)
+	if err != nil {
+		return eris.Wrap(err, "failed to roll back user")
+	}
+
+	m.changed(board, pixels)
+	return c.Ok(serializer.NewModerationResult(board, pixels))
+}
+
+type WipeDto struct {
+	BoardID int `json:"board_id" validate:"min=0"`
+	X       int `json:"x" validate:"min=0"`
+	Y       int `json:"y" validate:"min=0"`
+	Width   int `json:"width" validate:"required,min=1"`
+	Height  int `json:"height" validate:"required,min=1"`
+}
+
+func (m *Moderation) Wipe(c *framework.Context) error {
+	request, err := framework.BindAndValidate[WipeDto](c)
+	if err != nil {
+		return eris.Wrap(err, "failed to bind and validate request")
+	}
+
+	board, pixels, err := m.service.Wipe(c.Request().Context(), request.BoardID, request.X, request.Y, request.Width, request.Height, c.User.ID)
+	if err != nil {
+		return eris.Wrap(err, "failed to wipe area")
+	}
+
+	m.changed(board, pixels)
+	return c.Ok(serializer.NewModerationResult(board, pixels))
+}
+
+// changed marks the leaderboard of the board dirty when pixels changed. The
+// pixels themselves are announced by the service.
+func (m *Moderation) changed(board *ent.Board, pixels []*ent.Pixel) {
+	if len(pixels) > 0 {
+		m.leaderboard.MarkDirty(board.ID)
+	}
+}
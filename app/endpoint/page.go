@@ -0,0 +1,16 @@
+package endpoint
+
+const defaultPageLimit = 20
+
+// PageDto is embedded in requests of paginated actions.
+type PageDto struct {
+	Offset int `json:"offset" validate:"min=0"`
+	Limit  int `json:"limit" validate:"min=0,max=100"`
+}
+
+func (p PageDto) PageLimit() int {
+	if p.Limit == 0 {
+		return defaultPageLimit
+	}
+	return p.Limit
+}
@@ -0,0 +1,23 @@
+package endpoint
+
+import (
+	"nevissGo/app/serializer"
+	"nevissGo/framework"
+	"nevissGo/pkg/palette"
+)
+
+var _ framework.Endpoint = &Palette{}
+
+type Palette struct{}
+
+func NewPalette() *Palette {
+	return &Palette{}
+}
+
+func (p *Palette) Endpoints(router *framework.Endpoints) {
+	router.Register("palette/list", p.List)
+}
+
+func (p *Palette) List(c *framework.Context) error {
+	return c.Ok(serializer.NewPalettes(palette.All()))
+}
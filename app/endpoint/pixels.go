@@ -2,35 +2,52 @@
 package endpoint
 
 import (
-	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
 	"github.com/rotisserie/eris"
 	"github.com/sirupsen/logrus"
 	"nevissGo/app/serializer"
 	"nevissGo/app/service"
 	"nevissGo/ent/user"
 	"nevissGo/framework"
+	"nevissGo/pkg/render"
 )
 
 var _ framework.Endpoint = &Pixels{}
 
 type Pixels struct {
-	service *service.Pixels
+	service     *service.Pixels
+	leaderboard *service.Leaderboard
 }
 
-func NewPixels(service *service.Pixels) *Pixels {
+func NewPixels(service *service.Pixels, leaderboard *service.Leaderboard) *Pixels {
 	return &Pixels{
-		service: service,
+		service:     service,
+		leaderboard: leaderboard,
 	}
 }
 
 func (p *Pixels) Endpoints(router *framework.Endpoints) {
 	router.Register("pixels/update", p.UpdatePixel)
+	router.Register("pixels/update_batch", p.UpdatePixels)
 	router.Register("pixels/board", p.GetBoard)
+	router.Register("pixels/board_since", p.GetBoardSince)
+	router.Register("pixels/chunk", p.GetChunk)
+	router.Register("pixels/get", p.GetPixel)
+	router.Register("pixels/history", p.GetHistory)
+
+	router.Route(http.MethodGet, "/boards/:board_id/image.png", p.GetBoardImage)
 }
 
 type UpdatePixelDto struct {
+	BoardID  int    `json:"board_id" validate:"min=0"`
 	PixelID  int    `json:"pixel_id"`
-	NewColor string `json:"new_color" validate:"required"`
+	NewColor string `json:"new_color" validate:"required,max=32,color"`
 }
 
 func (p *Pixels) UpdatePixel(c *framework.Context) error {
@@ -38,9 +55,10 @@ func (p *Pixels) UpdatePixel(c *framework.Context) error {
 	if err != nil {
 		return eris.Wrap(err, "failed to bind and validate request")
 	}
-	err = p.service.UpdateColor(c.Request().Context(), request.PixelID, request.NewColor, c.User.ID)
+	pixel, err := p.service.UpdateColor(c.Request().Context(), request.BoardID, request.PixelID, request.NewColor, c.User.ID)
 	if err != nil {
 		logrus.WithError(err).WithFields(logrus.Fields{
+			"board_id":  request.BoardID,
 			"pixel_id":  request.PixelID,
 			"user_id":   user.ID,
 			"new_color": request.NewColor,
@@ -48,22 +66,244 @@ func (p *Pixels) UpdatePixel(c *framework.Context) error {
 		return eris.Wrap(err, "failed to update pixel color")
 	}
 
-	board, err := p.service.GetBoard(c.Request().Context())
+	p.leaderboard.MarkDirty(pixel.Edges.Board.ID)
+
+	return c.Ok("Pixel updated")
+}
+
+type PixelPaintDto struct {
+	PixelID int    `json:"pixel_id"`
+	Color   string `json:"color" validate:"required,max=32,color"`
+}
+
+type UpdatePixelsDto struct {
+	BoardID int             `json:"board_id" validate:"min=0"`
+	Pixels  []PixelPaintDto `json:"pixels" validate:"required,min=1,max=64,dive"`
+}
+
+func (p *Pixels) UpdatePixels(c *framework.Context) error {
+	request, err := framework.BindAndValidate[UpdatePixelsDto](c)
 	if err != nil {
-		return eris.Wrap(err, "failed to get board")
+		return eris.Wrap(err, "failed to bind and validate request")
+	}
+
+	paints := make([]service.PixelPaint, len(request.Pixels))
+	for i, paint := range request.Pixels {
+		paints[i] = service.PixelPaint{PixelID: paint.PixelID, Color: paint.Color}
 	}
 
-	go func() {
-		c.App.Event.Broadcast(context.Background(), "board:updated", serializer.NewBoardUpdatedSerializer(board, c.User))
-	}()
+	pixels, err := p.service.UpdateColors(c.Request().Context(), request.BoardID, paints, c.User.ID)
+	if err != nil {
+		return eris.Wrap(err, "failed to update pixels")
+	}
 
-	return c.Ok("Pixel updated")
+	p.leaderboard.MarkDirty(pixels[0].Edges.Board.ID)
+
+	return c.Ok("Pixels updated")
 }
 
+const (
+	BoardEncodingJSON    = "json"
+	BoardEncodingCompact = "compact"
+)
+
+type GetPixelsBoardDto struct {
+	BoardID  int    `json:"board_id" validate:"min=0"`
+	Encoding string `json:"encoding" validate:"omitempty,oneof=json compact"`
+}
+
+// GetBoard sends the board with every pixel as an object by default. The
+// compact encoding sends one color index per pixel instead, base64 encoded,
+// or as the raw body when the request accepts application/octet-stream, with
+// the rest of the board in headers.
 func (p *Pixels) GetBoard(c *framework.Context) error {
-	board, err := p.service.GetBoard(c.Request().Context())
+	request, err := framework.BindAndValidate[GetPixelsBoardDto](c)
+	if err != nil {
+		return eris.Wrap(err, "failed to bind and validate request")
+	}
+
+	board, err := p.service.GetBoard(c.Request().Context(), request.BoardID)
 	if err != nil {
 		return eris.Wrap(err, "failed to get board")
 	}
-	return c.Ok(serializer.NewBoard(board))
+	if request.Encoding != BoardEncodingCompact {
+		return c.Ok(serializer.NewBoard(board))
+	}
+
+	colors, indices, err := board.Indexed()
+	if err != nil {
+		return eris.Wrap(err, "failed to encode board")
+	}
+
+	if !strings.Contains(c.Request().Header.Get(echo.HeaderAccept), echo.MIMEOctetStream) {
+		return c.Ok(serializer.NewCompactBoard(board, colors, indices))
+	}
+
+	header := c.Response().Header()
+	header.Set("X-Board-Id", strconv.Itoa(board.ID))
+	header.Set("X-Board-Width", strconv.Itoa(board.Width))
+	header.Set("X-Board-Height", strconv.Itoa(board.Height))
+	header.Set("X-Board-Seq", strconv.FormatInt(board.Seq, 10))
+	header.Set("X-Board-Colors", strings.Join(colors, ","))
+	return c.Blob(http.StatusOK, echo.MIMEOctetStream, indices)
+}
+
+type GetChunkDto struct {
+	BoardID  int    `json:"board_id" validate:"min=0"`
+	ChunkX   int    `json:"chunk_x" validate:"min=0"`
+	ChunkY   int    `json:"chunk_y" validate:"min=0"`
+	Encoding string `json:"encoding" validate:"omitempty,oneof=json compact"`
+}
+
+// GetChunk sends a ChunkSize square tile of the board, so clients of large
+// boards only load what they show.
+func (p *Pixels) GetChunk(c *framework.Context) error {
+	request, err := framework.BindAndValidate[GetChunkDto](c)
+	if err != nil {
+		return eris.Wrap(err, "failed to bind and validate request")
+	}
+
+	chunk, err := p.service.GetChunk(c.Request().Context(), request.BoardID, request.ChunkX, request.ChunkY)
+	if err != nil {
+		return eris.Wrap(err, "failed to get chunk")
+	}
+	if request.Encoding != BoardEncodingCompact {
+		return c.Ok(serializer.NewChunk(chunk))
+	}
+
+	colors, indices, err := chunk.Indexed()
+	if err != nil {
+		return eris.Wrap(err, "failed to encode chunk")
+	}
+	return c.Ok(serializer.NewCompactChunk(chunk, colors, indices))
+}
+
+type GetPixelDto struct {
+	BoardID int `json:"board_id" validate:"min=0"`
+	PixelID int `json:"pixel_id" validate:"min=0"`
+}
+
+func (p *Pixels) GetPixel(c *framework.Context) error {
+	request, err := framework.BindAndValidate[GetPixelDto](c)
+	if err != nil {
+		return eris.Wrap(err, "failed to bind and validate request")
+	}
+
+	_, pixel, err := p.service.GetPixel(c.Request().Context(), request.BoardID, request.PixelID)
+	if err != nil {
+		return eris.Wrap(err, "failed to get pixel")
+	}
+	return c.Ok(serializer.NewPixelWithUser(pixel))
+}
+
+type BoardSinceDto struct {
+	BoardID int   `json:"board_id" validate:"min=0"`
+	Since   int64 `json:"since" validate:"min=0"`
+}
+
+func (p *Pixels) GetBoardSince(c *framework.Context) error {
+	request, err := framework.BindAndValidate[BoardSinceDto](c)
+	if err != nil {
+		return eris.Wrap(err, "failed to bind and validate request")
+	}
+
+	board, pixels, seq, err := p.service.GetBoardSince(c.Request().Context(), request.BoardID, request.Since)
+	if err != nil {
+		return eris.Wrap(err, "failed to get board changes")
+	}
+	return c.Ok(serializer.NewBoardSince(board, pixels, seq))
+}
+
+type PixelHistoryDto struct {
+	BoardID int `json:"board_id" validate:"min=0"`
+	PixelID int `json:"pixel_id" validate:"min=0"`
+	PageDto
+}
+
+func (p *Pixels) GetHistory(c *framework.Context) error {
+	request, err := framework.BindAndValidate[PixelHistoryDto](c)
+	if err != nil {
+		return eris.Wrap(err, "failed to bind and validate request")
+	}
+
+	_, changes, err := p.service.PixelHistory(c.Request().Context(), request.BoardID, request.PixelID, request.Offset, request.PageLimit())
+	if err != nil {
+		return eris.Wrap(err, "failed to get pixel history")
+	}
+	return c.Ok(serializer.NewPixelChanges(changes, request.Offset, request.PageLimit()))
+}
+
+const defaultImageScale = 8
+
+// GetBoardImage serves the board as a PNG so it can be embedded in messages
+// and pages without the web app. It is public and cacheable: clients revalidate
+// with the ETag, built from the board sequence number, or the Last-Modified
+// of the latest paint. Errors are answered with their HTTP status, as the
+// clients of this route do not read the JSON body.
+func (p *Pixels) GetBoardImage(c *framework.Context) error {
+	boardID, err := strconv.Atoi(c.Param("board_id"))
+	if err != nil || boardID < 0 {
+		return imageError(c, framework.NewValidationError("Invalid board ID"))
+	}
+
+	scale := defaultImageScale
+	if raw := c.QueryParam("scale"); raw != "" {
+		scale, err = strconv.Atoi(raw)
+		if err != nil {
+			return imageError(c, framework.NewValidationError("Invalid scale"))
+		}
+	}
+	grid := c.QueryParam("grid") == "1" || c.QueryParam("grid") == "true"
+
+	board, updatedAt, err := p.service.LastUpdatedAt(c.Request().Context(), boardID)
+	if err != nil {
+		return imageError(c, err)
+	}
+
+	etag := fmt.Sprintf(`"%d-%d-%dx%d-%d-%t"`, board.ID, board.Seq, board.Width, board.Height, scale, grid)
+	lastModified := updatedAt.UTC().Truncate(time.Second)
+
+	header := c.Response().Header()
+	header.Set("ETag", etag)
+	header.Set("Last-Modified", lastModified.Format(http.TimeFormat))
+	header.Set("Cache-Control", "public, no-cache")
+
+	if notModified(c.Request(), etag, lastModified) {
+		return c.NoContent(http.StatusNotModified)
+	}
+
+	image, err := p.service.Snapshot(c.Request().Context(), board.ID, render.SnapshotOptions{
+		Scale: scale,
+		Grid:  grid,
+	})
+	if err != nil {
+		return imageError(c, err)
+	}
+
+	return c.Blob(http.StatusOK, "image/png", image)
+}
+
+func imageError(c *framework.Context, err error) error {
+	code := framework.ExtErrorCode(err)
+	if code == http.StatusInternalServerError {
+		logrus.WithError(err).Error("Failed to serve board image")
+	}
+	// Caching headers set before the error do not apply to it.
+	c.Response().Header().Del("ETag")
+	c.Response().Header().Del("Last-Modified")
+	c.Response().Header().Set("Cache-Control", "no-store")
+	return c.String(code, framework.ExtErrorMessage(err))
+}
+
+func notModified(r *http.Request, etag string, lastModified time.Time) bool {
+	if match := r.Header.Get("If-None-Match"); match != "" {
+		return match == etag || match == "*"
+	}
+
+	if since := r.Header.Get("If-Modified-Since"); since != "" {
+		t, err := http.ParseTime(since)
+		return err == nil && !lastModified.After(t)
+	}
+
+	return false
 }
@@ -0,0 +1,68 @@
+package endpoint
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"nevissGo/app/service"
+	"nevissGo/framework"
+)
+
+func TestGetBoardOctetStream(t *testing.T) {
+	app := framework.NewTestingApp(t)
+	bridge := service.TestBridge(t)
+	ctx := context.Background()
+
+	painter, err := app.Client().User.Create().
+		SetDisplayName("Painter").
+		SetGameID("painter").
+		Save(ctx)
+	require.NoError(t, err)
+	board, err := service.NewBoards(app.App).Create(ctx, painter.ID, service.BoardSettings{
+		Name:     "test",
+		Width:    3,
+		Height:   2,
+		HypeCost: 1,
+	})
+	require.NoError(t, err)
+
+	pixels := service.NewPixels(app.App, bridge.Bridge)
+	bridge.Hype.On("UseHypeTX", mock.Anything, mock.Anything, painter.ID, 2).Return(nil)
+	_, err = pixels.UpdateColors(ctx, board.ID, []service.PixelPaint{
+		{PixelID: 1, Color: "red-dark"},
+		{PixelID: 5, Color: "blue-dark"},
+	}, painter.ID)
+	require.NoError(t, err)
+
+	body := `{"board_id": ` + strconv.Itoa(board.ID) + `, "encoding": "compact"}`
+	req := httptest.NewRequest(http.MethodPost, "/pixels/board", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set(echo.HeaderAccept, echo.MIMEOctetStream)
+	rec := httptest.NewRecorder()
+	c := &framework.Context{Context: echo.New().NewContext(req, rec), App: app.App}
+
+	require.NoError(t, NewPixels(pixels, nil).GetBoard(c))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, echo.MIMEOctetStream, rec.Header().Get(echo.HeaderContentType))
+	require.Equal(t, strconv.Itoa(board.ID), rec.Header().Get("X-Board-Id"))
+	require.Equal(t, "3", rec.Header().Get("X-Board-Width"))
+	require.Equal(t, "2", rec.Header().Get("X-Board-Height"))
+	require.Equal(t, "2", rec.Header().Get("X-Board-Seq"))
+
+	colors := strings.Split(rec.Header().Get("X-Board-Colors"), ",")
+	indices := rec.Body.Bytes()
+	require.Len(t, indices, 6)
+	decoded := make([]string, len(indices))
+	for i, index := range indices {
+		require.Less(t, int(index), len(colors))
+		decoded[i] = colors[index]
+	}
+	require.Equal(t, []string{"white", "red-dark", "white", "white", "white", "blue-dark"}, decoded)
+}
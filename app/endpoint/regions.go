@@ -0,0 +1,89 @@
+package endpoint
+
+import (
+	"github.com/rotisserie/eris"
+	"nevissGo/app/serializer"
+	"nevissGo/app/service"
+	"nevissGo/framework"
+)
+
+var _ framework.Endpoint = &Regions{}
+
+type Regions struct {
+	service *service.Regions
+}
+
+func NewRegions(service *service.Regions) *Regions {
+	return &Regions{
+		service: service,
+	}
+}
+
+func (r *Regions) Endpoints(router *framework.Endpoints) {
+	router.Register("regions/list", r.List)
+	router.Register("regions/create", r.Create)
+	router.Register("regions/delete", r.Delete)
+}
+
+type ListRegionsDto struct {
+	BoardID int `json:"board_id" validate:"min=0"`
+}
+
+func (r *Regions) List(c *framework.Context) error {
+	request, err := framework.BindAndValidate[ListRegionsDto](c)
+	if err != nil {
+		return eris.Wrap(err, "failed to bind and validate request")
+	}
+
+	board, regions, err := r.service.List(c.Request().Context(), request.BoardID)
+	if err != nil {
+		return eris.Wrap(err, "failed to list regions")
+	}
+	return c.Ok(serializer.NewRegions(board, regions))
+}
+
+type CreateRegionDto struct {
+	BoardID int    `json:"board_id" validate:"min=0"`
+	Name    string `json:"name" validate:"required,max=64"`
+	X       int    `json:"x" validate:"min=0"`
+	Y       int    `json:"y" validate:"min=0"`
+	Width   int    `json:"width" validate:"required,min=1,max=512"`
+	Height  int    `json:"height" validate:"required,min=1,max=512"`
+	Mask    string `json:"mask"`
+}
+
+func (r *Regions) Create(c *framework.Context) error {
+	request, err := framework.BindAndValidate[CreateRegionDto](c)
+	if err != nil {
+		return eris.Wrap(err, "failed to bind and validate request")
+	}
+
+	region, err := r.service.Create(c.Request().Context(), request.BoardID, c.User.ID, service.RegionSettings{
+		Name:   request.Name,
+		X:      request.X,
+		Y:      request.Y,
+		Width:  request.Width,
+		Height: request.Height,
+		Mask:   request.Mask,
+	})
+	if err != nil {
+		return eris.Wrap(err, "failed to create region")
+	}
+	return c.Ok(serializer.NewRegion(region))
+}
+
+type DeleteRegionDto struct {
+	RegionID int `json:"region_id" validate:"required"`
+}
+
+func (r *Regions) Delete(c *framework.Context) error {
+	request, err := framework.BindAndValidate[DeleteRegionDto](c)
+	if err != nil {
+		return eris.Wrap(err, "failed to bind and validate request")
+	}
+
+	if err := r.service.Delete(c.Request().Context(), request.RegionID, c.User.ID); err != nil {
+		return eris.Wrap(err, "failed to delete region")
+	}
+	return c.Ok("Region deleted")
+}
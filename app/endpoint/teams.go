@@ -0,0 +1,157 @@
+package endpoint
+
+import (
+	"github.com/rotisserie/eris"
+	"nevissGo/app/serializer"
+	"nevissGo/app/service"
+	"nevissGo/config"
+	"nevissGo/ent"
+	"nevissGo/framework"
+)
+
+var _ framework.Endpoint = &Teams{}
+
+type Teams struct {
+	service     *service.Teams
+	leaderboard *service.Leaderboard
+	auth        config.Auth
+}
+
+func NewTeams(service *service.Teams, leaderboard *service.Leaderboard, auth config.Auth) *Teams {
+	return &Teams{
+		service:     service,
+		leaderboard: leaderboard,
+		auth:        auth,
+	}
+}
+
+func (t *Teams) Endpoints(router *framework.Endpoints) {
+	router.Register("teams/create", t.Create)
+	router.Register("teams/join", t.Join)
+	router.Register("teams/leave", t.Leave)
+	router.Register("teams/get", t.Get)
+	router.Register("teams/leaderboard", t.Leaderboard)
+	router.Register("teams/message", t.Message)
+}
+
+type CreateTeamDto struct {
+	Name string `json:"name" validate:"required,max=64"`
+}
+
+func (t *Teams) Create(c *framework.Context) error {
+	request, err := framework.BindAndValidate[CreateTeamDto](c)
+	if err != nil {
+		return eris.Wrap(err, "failed to bind and validate request")
+	}
+
+	team, err := t.service.Create(c.Request().Context(), c.User.ID, request.Name)
+	if err != nil {
+		return eris.Wrap(err, "failed to create team")
+	}
+	return c.Ok(serializer.NewTeamMembership(team, t.memberToken(c.User, &team.ID)))
+}
+
+type JoinTeamDto struct {
+	InviteCode string `json:"invite_code" validate:"required,max=32"`
+}
+
+func (t *Teams) Join(c *framework.Context) error {
+	request, err := framework.BindAndValidate[JoinTeamDto](c)
+	if err != nil {
+		return eris.Wrap(err, "failed to bind and validate request")
+	}
+
+	team, err := t.service.Join(c.Request().Context(), c.User.ID, request.InviteCode)
+	if err != nil {
+		return eris.Wrap(err, "failed to join team")
+	}
+
+	return c.Ok(serializer.NewTeamMembership(team, t.memberToken(c.User, &team.ID)))
+}
+
+func (t *Teams) Leave(c *framework.Context) error {
+	_, err := t.service.Leave(c.Request().Context(), c.User.ID)
+	if err != nil {
+		return eris.Wrap(err, "failed to leave team")
+	}
+
+	return c.Ok(serializer.NewTeamMembership(nil, t.memberToken(c.User, nil)))
+}
+
+func (t *Teams) Get(c *framework.Context) error {
+	team, err := t.service.ForUser(c.Request().Context(), c.User.ID)
+	if err != nil {
+		return eris.Wrap(err, "failed to get team")
+	}
+	return c.Ok(serializer.NewOwnTeam(team))
+}
+
+type TeamLeaderboardDto struct {
+	BoardID int    `json:"board_id" validate:"min=0"`
+	Metric  string `json:"metric" validate:"omitempty,oneof=owned paints"`
+	Window  string `json:"window" validate:"omitempty,oneof=day week all"`
+	Limit   int    `json:"limit" validate:"min=0,max=100"`
+}
+
+func (t *Teams) Leaderboard(c *framework.Context) error {
+	request, err := framework.BindAndValidate[TeamLeaderboardDto](c)
+	if err != nil {
+		return eris.Wrap(err, "failed to bind and validate request")
+	}
+
+	metric := service.MetricOwned
+	if request.Metric != "" {
+		metric = service.LeaderboardMetric(request.Metric)
+	}
+	window := service.WindowAll
+	if request.Window != "" {
+		window = service.LeaderboardWindow(request.Window)
+	}
+	limit := request.Limit
+	if limit == 0 {
+		limit = defaultPageLimit
+	}
+
+	var teamID int
+	if c.User.TeamID != nil {
+		teamID = *c.User.TeamID
+	}
+
+	result, err := t.leaderboard.TopTeams(c.Request().Context(), request.BoardID, metric, window, limit, teamID)
+	if err != nil {
+		return eris.Wrap(err, "failed to get team leaderboard")
+	}
+	return c.Ok(serializer.NewTeamLeaderboard(result))
+}
+
+type TeamMessageDto struct {
+	Text string `json:"text" validate:"required,max=500"`
+}
+
+// Message relays a coordination message to the other members of the team over
+// the team channel. Messages are only kept in the outbox until they are sent.
+func (t *Teams) Message(c *framework.Context) error {
+	request, err := framework.BindAndValidate[TeamMessageDto](c)
+	if err != nil {
+		return eris.Wrap(err, "failed to bind and validate request")
+	}
+
+	team, err := t.service.ForUser(c.Request().Context(), c.User.ID)
+	if err != nil {
+		return eris.Wrap(err, "failed to get team")
+	}
+
+	err = c.App.Publish(c.Request().Context(), framework.TeamEvent(team.ID, "team:message", serializer.NewTeamEvent(team.ID, c.User, request.Text)))
+	if err != nil {
+		return framework.NewInternalError("Failed to send team message")
+	}
+
+	return c.Ok("Message sent")
+}
+
+// memberToken issues a token for the user as a member of the given team.
+func (t *Teams) memberToken(user *ent.User, teamID *int) string {
+	member := *user
+	member.TeamID = teamID
+	return generateJWT(t.auth.SecretKey, &member)
+}
@@ -7,34 +7,42 @@ import (
 	"fmt"
 	"github.com/golang-jwt/jwt"
 	"github.com/labstack/echo/v4"
+	"github.com/rotisserie/eris"
 	"github.com/sirupsen/logrus"
 	"gopkg.in/telebot.v4"
 	"net/url"
 	"nevissGo/app/serializer"
 	"nevissGo/app/service"
+	"nevissGo/config"
 	"nevissGo/ent"
 	"nevissGo/framework"
 	"nevissGo/pkg/jsonhelper"
-	"os"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 )
 
 var _ framework.Endpoint = &Users{}
 
 type Users struct {
-	service *service.Users
+	service  *service.Users
+	auth     config.Auth
+	telegram config.Telegram
 }
 
-func NewUsers(service *service.Users) *Users {
+func NewUsers(service *service.Users, auth config.Auth, telegram config.Telegram) *Users {
 	return &Users{
-		service: service,
+		service:  service,
+		auth:     auth,
+		telegram: telegram,
 	}
 }
 
 func (u *Users) Endpoints(router *framework.Endpoints) {
 	router.Register("users/login", u.Login)
+	router.Register("users/history", u.History)
+	router.Register("users/status", u.Status)
 
 	router.Middleware(func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
@@ -42,13 +50,11 @@ func (u *Users) Endpoints(router *framework.Endpoints) {
 			if strings.Contains(authKey, "INIT_DATA:") {
 				initData := strings.Replace(authKey, "INIT_DATA:", "", 1)
 
-				fmt.Println(initData)
-				
 				if initData == "TEST_TOKEN" {
-					initData = os.Getenv("TEST_TOKEN_REPLACE")
+					initData = u.auth.TestInitData
 				}
 
-				isValid, err := validateInitData(initData)
+				isValid, err := validateInitData(u.telegram.Token, initData)
 				if err != nil {
 					return err
 				}
@@ -71,6 +77,10 @@ func (u *Users) Endpoints(router *framework.Endpoints) {
 					return c.String(500, "Couldn't register telegram user")
 				}
 
+				if err := framework.EnsureNotBanned(user); err != nil {
+					return err
+				}
+
 				c.Set("user", *user)
 
 				return next(c)
@@ -78,7 +88,7 @@ func (u *Users) Endpoints(router *framework.Endpoints) {
 
 			if strings.Contains(authKey, "JWT:") {
 				userJwt := strings.Replace(authKey, "JWT:", "", 1)
-				claims, err := validateToken(userJwt)
+				claims, err := validateToken(u.auth.SecretKey, userJwt)
 				if err != nil {
 					logrus.WithError(err).Error("couldn't validate token")
 					return framework.NewUnauthorizedError("Unauthorized")
@@ -91,6 +101,10 @@ func (u *Users) Endpoints(router *framework.Endpoints) {
 					return framework.NewUnauthorizedError("Unauthorized")
 				}
 
+				if err := framework.EnsureNotBanned(user); err != nil {
+					return err
+				}
+
 				c.Set("user", *user)
 
 				return next(c)
@@ -102,26 +116,70 @@ func (u *Users) Endpoints(router *framework.Endpoints) {
 }
 
 func (u *Users) Login(c *framework.Context) error {
-	token := generateJWT(c.User)
+	token := generateJWT(u.auth.SecretKey, c.User)
 
 	return c.Ok(serializer.NewUserWithJwt(c.User, token))
 }
 
-func generateJWT(user *ent.User) string {
+type UserHistoryDto struct {
+	GameID string `json:"game_id"`
+	PageDto
+}
+
+func (u *Users) History(c *framework.Context) error {
+	request, err := framework.BindAndValidate[UserHistoryDto](c)
+	if err != nil {
+		return eris.Wrap(err, "failed to bind and validate request")
+	}
+
+	target := c.User
+	if request.GameID != "" {
+		target, err = u.service.GetByGameID(c.Request().Context(), request.GameID)
+		if err != nil {
+			return eris.Wrap(err, "failed to get user")
+		}
+	}
+
+	changes, err := u.service.History(c.Request().Context(), target.ID, request.Offset, request.PageLimit())
+	if err != nil {
+		return eris.Wrap(err, "failed to get user history")
+	}
+	return c.Ok(serializer.NewPixelChanges(changes, request.Offset, request.PageLimit()))
+}
+
+type UserStatusDto struct {
+	BoardID int `json:"board_id" validate:"min=0"`
+}
+
+func (u *Users) Status(c *framework.Context) error {
+	request, err := framework.BindAndValidate[UserStatusDto](c)
+	if err != nil {
+		return eris.Wrap(err, "failed to bind and validate request")
+	}
+
+	status, err := u.service.Status(c.Request().Context(), request.BoardID, c.User.ID)
+	if err != nil {
+		return eris.Wrap(err, "failed to get user status")
+	}
+	return c.Ok(serializer.NewUserStatus(status))
+}
+
+// tokenLifetime is how long a token from users/login is accepted. The web app
+// logs in again with its Telegram init data once it expires.
+const tokenLifetime = 24 * time.Hour
+
+func generateJWT(secretKey string, user *ent.User) string {
 	claims := jwt.MapClaims{
-		"sub": fmt.Sprint(user.ID),
-		"channels": []string{
-			fmt.Sprintf("personal:#%d", user.ID),
-			fmt.Sprintf("personal:#%s", user.GameID),
-			"personal:broadcast",
-		},
+		"sub":      fmt.Sprint(user.ID),
+		"channels": framework.UserChannels(user),
+		"exp":      time.Now().Add(tokenLifetime).Unix(),
 	}
 
 	// Create a new JWT token with the claims
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 
 	// Sign the token with the secret
-	signedToken, err := token.SignedString([]byte(os.Getenv("SECRET_KEY")))
+	signedToken, err := token.SignedString([]byte(secretKey))
 	if err != nil {
 		logrus.WithError(err).Fatalf("Failed to sign the token: %v", err)
 	}
@@ -129,7 +187,7 @@ func generateJWT(user *ent.User) string {
 	return signedToken
 }
 
-func validateInitData(inputData string) (bool, error) {
+func validateInitData(botToken string, inputData string) (bool, error) {
 	initData, err := url.ParseQuery(inputData)
 	if err != nil {
 		logrus.WithError(err).Errorln("couldn't parse web app input data")
@@ -149,7 +207,7 @@ func validateInitData(inputData string) (bool, error) {
 	sort.Strings(dataCheckString)
 
 	secret := hmac.New(sha256.New, []byte("WebAppData"))
-	secret.Write([]byte(os.Getenv("TELEGRAM_TOKEN")))
+	secret.Write([]byte(botToken))
 
 	hHash := hmac.New(sha256.New, secret.Sum(nil))
 	hHash.Write([]byte(strings.Join(dataCheckString, "\n")))
@@ -163,14 +221,14 @@ func validateInitData(inputData string) (bool, error) {
 	return true, nil
 }
 
-func validateToken(tokenString string) (jwt.MapClaims, error) {
+func validateToken(secretKey string, tokenString string) (jwt.MapClaims, error) {
 	// Parse the token
 	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
 		// Check the signing method
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
 		}
-		return []byte(os.Getenv("SECRET_KEY")), nil
+		return []byte(secretKey), nil
 	})
 	if err != nil {
 		return nil, err
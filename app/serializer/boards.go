@@ -0,0 +1,88 @@
+package serializer
+
+import (
+	"encoding/base64"
+
+	"nevissGo/app/service"
+	"nevissGo/ent"
+	"nevissGo/pkg/boardfile"
+)
+
+type BoardInfoSerializer struct {
+	ID                  int     `json:"id"`
+	Name                string  `json:"name"`
+	Width               int     `json:"width"`
+	Height              int     `json:"height"`
+	CooldownSeconds     float64 `json:"cooldown_seconds"`
+	UserCooldownSeconds float64 `json:"user_cooldown_seconds"`
+	HypeCost            int     `json:"hype_cost"`
+	Palette             string  `json:"palette"`
+	Open                bool    `json:"open"`
+	Owner               *User   `json:"owner,omitempty"`
+}
+
+func NewBoardInfo(board *ent.Board) *BoardInfoSerializer {
+	var owner *User
+	if board.Edges.Owner != nil {
+		u := NewUser(board.Edges.Owner)
+		owner = &u
+	}
+
+	return &BoardInfoSerializer{
+		ID:                  board.ID,
+		Name:                board.Name,
+		Width:               board.Width,
+		Height:              board.Height,
+		CooldownSeconds:     board.Cooldown.Seconds(),
+		UserCooldownSeconds: board.UserCooldown.Seconds(),
+		HypeCost:            board.HypeCost,
+		Palette:             board.Palette,
+		Open:                board.Open,
+		Owner:               owner,
+	}
+}
+
+func NewBoardInfos(boards []*ent.Board) []*BoardInfoSerializer {
+	result := make([]*BoardInfoSerializer, len(boards))
+	for i, board := range boards {
+		result[i] = NewBoardInfo(board)
+	}
+	return result
+}
+
+type TimelapseSerializer struct {
+	BoardID     int    `json:"board_id"`
+	Frames      int    `json:"frames"`
+	ContentType string `json:"content_type"`
+	Data        string `json:"data"`
+}
+
+func NewTimelapse(timelapse *service.Timelapse) *TimelapseSerializer {
+	return &TimelapseSerializer{
+		BoardID:     timelapse.Board.ID,
+		Frames:      timelapse.Frames,
+		ContentType: "image/gif",
+		Data:        base64.StdEncoding.EncodeToString(timelapse.GIF),
+	}
+}
+
+type BoardExportSerializer struct {
+	BoardID     int    `json:"board_id"`
+	Format      string `json:"format"`
+	ContentType string `json:"content_type"`
+	Data        string `json:"data"`
+}
+
+func NewBoardExport(board *ent.Board, format string, data []byte) *BoardExportSerializer {
+	contentType := "application/json"
+	if format == boardfile.FormatBinary {
+		contentType = "application/octet-stream"
+	}
+
+	return &BoardExportSerializer{
+		BoardID:     board.ID,
+		Format:      format,
+		ContentType: contentType,
+		Data:        base64.StdEncoding.EncodeToString(data),
+	}
+}
@@ -0,0 +1,23 @@
+package serializer
+
+import (
+	"nevissGo/app/service"
+	"nevissGo/framework"
+)
+
+// RegisterEvents sets how the events services store in the outbox are sent
+// to clients. Every app that runs services has to register them.
+func RegisterEvents(app *framework.App) {
+	framework.RegisterEventEncoder(app, func(e service.PixelEvent) any {
+		return NewPixelUpdated(e.Pixel, e.Actor)
+	})
+	framework.RegisterEventEncoder(app, func(e service.PixelsEvent) any {
+		return NewPixelsUpdated(e.Pixels, e.Actor)
+	})
+	framework.RegisterEventEncoder(app, func(e service.BoardResizedEvent) any {
+		return NewBoardResized(e.Board)
+	})
+	framework.RegisterEventEncoder(app, func(e service.TeamMemberEvent) any {
+		return NewTeamEvent(e.TeamID, e.User, "")
+	})
+}
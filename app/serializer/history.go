@@ -0,0 +1,55 @@
+package serializer
+
+import "nevissGo/ent"
+
+type PixelChangeSerializer struct {
+	BoardID   int    `json:"board_id"`
+	PixelID   int    `json:"pixel_id"`
+	OldColor  string `json:"old_color"`
+	NewColor  string `json:"new_color"`
+	User      *User  `json:"user,omitempty"`
+	Seq       int64  `json:"seq"`
+	CreatedAt int64  `json:"created_at"`
+}
+
+func NewPixelChange(change *ent.PixelChange) *PixelChangeSerializer {
+	var user *User
+	if change.Edges.User != nil {
+		u := NewUser(change.Edges.User)
+		user = &u
+	}
+
+	var boardID int
+	if change.Edges.Board != nil {
+		boardID = change.Edges.Board.ID
+	}
+
+	return &PixelChangeSerializer{
+		BoardID:   boardID,
+		PixelID:   change.Position,
+		OldColor:  change.OldColor,
+		NewColor:  change.NewColor,
+		User:      user,
+		Seq:       change.Seq,
+		CreatedAt: change.CreatedAt.Unix(),
+	}
+}
+
+type PixelChangesSerializer struct {
+	Changes []*PixelChangeSerializer `json:"changes"`
+	Offset  int                      `json:"offset"`
+	Limit   int                      `json:"limit"`
+}
+
+func NewPixelChanges(changes []*ent.PixelChange, offset, limit int) *PixelChangesSerializer {
+	result := make([]*PixelChangeSerializer, len(changes))
+	for i, change := range changes {
+		result[i] = NewPixelChange(change)
+	}
+
+	return &PixelChangesSerializer{
+		Changes: result,
+		Offset:  offset,
+		Limit:   limit,
+	}
+}
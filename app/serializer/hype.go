@@ -41,3 +41,48 @@ func NewHype(hype *ent.Hype) *HypeSerializer {
 		LastUpdatedAt:     hype.LastUpdatedAt.Format(time.RFC3339),
 	}
 }
+
+type HypeLedgerEntrySerializer struct {
+	Kind      string `json:"kind"`
+	Amount    int    `json:"amount"`
+	Balance   int    `json:"balance"`
+	Reason    string `json:"reason"`
+	Actor     *User  `json:"actor,omitempty"`
+	CreatedAt int64  `json:"created_at"`
+}
+
+func NewHypeLedgerEntry(entry *ent.HypeLedger) *HypeLedgerEntrySerializer {
+	var actor *User
+	if entry.Edges.Actor != nil {
+		u := NewUser(entry.Edges.Actor)
+		actor = &u
+	}
+
+	return &HypeLedgerEntrySerializer{
+		Kind:      entry.Kind.String(),
+		Amount:    entry.Amount,
+		Balance:   entry.Balance,
+		Reason:    entry.Reason,
+		Actor:     actor,
+		CreatedAt: entry.CreatedAt.Unix(),
+	}
+}
+
+type HypeLedgerSerializer struct {
+	Entries []*HypeLedgerEntrySerializer `json:"entries"`
+	Offset  int                          `json:"offset"`
+	Limit   int                          `json:"limit"`
+}
+
+func NewHypeLedger(entries []*ent.HypeLedger, offset, limit int) *HypeLedgerSerializer {
+	result := make([]*HypeLedgerEntrySerializer, len(entries))
+	for i, entry := range entries {
+		result[i] = NewHypeLedgerEntry(entry)
+	}
+
+	return &HypeLedgerSerializer{
+		Entries: result,
+		Offset:  offset,
+		Limit:   limit,
+	}
+}
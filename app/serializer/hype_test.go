@@ -26,7 +26,7 @@ func TestNewHype(t *testing.T) {
 			},
 			elapsedTime:     500 * time.Millisecond,
 			expectedSeconds: 1,
-		},,
+		},
 	{
 		name: "Partial time elapsed",
 		hype: &ent.Hype{
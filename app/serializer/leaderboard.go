@@ -0,0 +1,64 @@
+package serializer
+
+import "nevissGo/app/service"
+
+type LeaderboardEntrySerializer struct {
+	Rank  int   `json:"rank"`
+	User  *User `json:"user,omitempty"`
+	Score int   `json:"score"`
+}
+
+func NewLeaderboardEntry(entry *service.LeaderboardEntry) *LeaderboardEntrySerializer {
+	if entry == nil {
+		return nil
+	}
+
+	var user *User
+	if entry.User != nil {
+		u := NewUser(entry.User)
+		user = &u
+	}
+
+	return &LeaderboardEntrySerializer{
+		Rank:  entry.Rank,
+		User:  user,
+		Score: entry.Score,
+	}
+}
+
+type LeaderboardSerializer struct {
+	BoardID int                           `json:"board_id"`
+	Metric  string                        `json:"metric"`
+	Window  string                        `json:"window"`
+	Entries []*LeaderboardEntrySerializer `json:"entries"`
+	Me      *LeaderboardEntrySerializer   `json:"me,omitempty"`
+}
+
+func NewLeaderboard(result *service.LeaderboardResult) *LeaderboardSerializer {
+	entries := make([]*LeaderboardEntrySerializer, len(result.Entries))
+	for i, entry := range result.Entries {
+		entries[i] = NewLeaderboardEntry(entry)
+	}
+
+	return &LeaderboardSerializer{
+		BoardID: result.Board.ID,
+		Metric:  string(result.Metric),
+		Window:  string(result.Window),
+		Entries: entries,
+		Me:      NewLeaderboardEntry(result.Me),
+	}
+}
+
+type LeaderboardUpdatedSerializer struct {
+	BoardID int                    `json:"board_id"`
+	Owned   *LeaderboardSerializer `json:"owned"`
+	Paints  *LeaderboardSerializer `json:"paints"`
+}
+
+func NewLeaderboardUpdated(update *service.LeaderboardUpdate) *LeaderboardUpdatedSerializer {
+	return &LeaderboardUpdatedSerializer{
+		BoardID: update.Owned.Board.ID,
+		Owned:   NewLeaderboard(update.Owned),
+		Paints:  NewLeaderboard(update.Paints),
+	}
+}
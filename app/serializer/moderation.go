@@ -0,0 +1,46 @@
+package serializer
+
+import "nevissGo/ent"
+
+// ModeratedUserSerializer is what admins see of a user.
+type ModeratedUserSerializer struct {
+	User
+	Role      string `json:"role"`
+	Banned    bool   `json:"banned"`
+	BanReason string `json:"ban_reason,omitempty"`
+	BannedAt  int64  `json:"banned_at,omitempty"`
+}
+
+func NewModeratedUser(user *ent.User) *ModeratedUserSerializer {
+	result := &ModeratedUserSerializer{
+		User:      NewUser(user),
+		Role:      user.Role.String(),
+		Banned:    user.BannedAt != nil,
+		BanReason: user.BanReason,
+	}
+	if user.BannedAt != nil {
+		result.BannedAt = user.BannedAt.Unix()
+	}
+	return result
+}
+
+// ModerationResultSerializer reports how many pixels a rollback or wipe
+// changed.
+type ModerationResultSerializer struct {
+	BoardID int   `json:"board_id"`
+	Changed int   `json:"changed"`
+	Seq     int64 `json:"seq"`
+}
+
+func NewModerationResult(board *ent.Board, pixels []*ent.Pixel) *ModerationResultSerializer {
+	var seq int64
+	for _, pixel := range pixels {
+		seq = max(seq, pixel.Seq)
+	}
+
+	return &ModerationResultSerializer{
+		BoardID: board.ID,
+		Changed: len(pixels),
+		Seq:     seq,
+	}
+}
@@ -0,0 +1,36 @@
+package serializer
+
+import "nevissGo/pkg/palette"
+
+type ColorSerializer struct {
+	Name string `json:"name"`
+	Hex  string `json:"hex"`
+}
+
+type PaletteSerializer struct {
+	Name   string             `json:"name"`
+	Colors []*ColorSerializer `json:"colors"`
+}
+
+func NewPalette(p palette.Palette) *PaletteSerializer {
+	colors := make([]*ColorSerializer, len(p.Colors))
+	for i, c := range p.Colors {
+		colors[i] = &ColorSerializer{
+			Name: c.Name,
+			Hex:  c.Hex,
+		}
+	}
+
+	return &PaletteSerializer{
+		Name:   p.Name,
+		Colors: colors,
+	}
+}
+
+func NewPalettes(palettes []palette.Palette) []*PaletteSerializer {
+	result := make([]*PaletteSerializer, len(palettes))
+	for i, p := range palettes {
+		result[i] = NewPalette(p)
+	}
+	return result
+}
@@ -1,6 +1,7 @@
 package serializer
 
 import (
+	"encoding/base64"
 	"nevissGo/app/service"
 	"nevissGo/ent"
 	"time"
@@ -20,15 +21,17 @@ type PixelWithUserSerializer struct {
 }
 
 type BoardSerializer struct {
+	BoardID   int                        `json:"board_id"`
 	Pixels    []*PixelWithUserSerializer `json:"pixels"`
 	Width     int                        `json:"width"`
 	Height    int                        `json:"height"`
 	UpdatedAt int64                      `json:"updated_at"`
+	Seq       int64                      `json:"seq"`
 }
 
 func NewPixel(pixel *ent.Pixel) *PixelSerializer {
 	return &PixelSerializer{
-		ID:    pixel.ID,
+		ID:    pixel.Position,
 		Color: pixel.Color,
 	}
 }
@@ -41,7 +44,7 @@ func NewPixelWithUser(pixel *ent.Pixel) *PixelWithUserSerializer {
 	}
 
 	return &PixelWithUserSerializer{
-		ID:        pixel.ID,
+		ID:        pixel.Position,
 		Color:     pixel.Color,
 		User:      user,
 		UpdatedAt: pixel.UpdatedAt.Unix(),
@@ -52,7 +55,7 @@ func NewBoard(board *service.Board) *BoardSerializer {
 	pixels := make([]*PixelWithUserSerializer, board.Width*board.Height)
 	for _, pixel := range board.Pixels {
 		if pixel != nil {
-			pixels[pixel.ID] = NewPixelWithUser(pixel)
+			pixels[pixel.Position] = NewPixelWithUser(pixel)
 		}
 	}
 
@@ -76,21 +79,190 @@ func NewBoard(board *service.Board) *BoardSerializer {
 		}
 	}
 	return &BoardSerializer{
+		BoardID:   board.ID,
 		Pixels:    pixels,
 		Width:     board.Width,
 		Height:    board.Height,
 		UpdatedAt: minUpdatedAt,
+		Seq:       board.Seq,
 	}
 }
 
-type UpdatedBoardSerializer struct {
-	Board *BoardSerializer `json:"board"`
-	User  User             `json:"user"`
+// CompactBoardSerializer is the board with one byte per pixel. Pixels holds
+// the base64 encoded indices into Colors in position order. Owners and update
+// times are left out and fetched per pixel with pixels/get.
+type CompactBoardSerializer struct {
+	BoardID int      `json:"board_id"`
+	Width   int      `json:"width"`
+	Height  int      `json:"height"`
+	Seq     int64    `json:"seq"`
+	Colors  []string `json:"colors"`
+	Pixels  string   `json:"pixels"`
 }
 
-func NewBoardUpdatedSerializer(board *service.Board, user *ent.User) *UpdatedBoardSerializer {
-	return &UpdatedBoardSerializer{
-		Board: NewBoard(board),
-		User:  NewUser(user),
+func NewCompactBoard(board *service.Board, colors []string, indices []byte) *CompactBoardSerializer {
+	return &CompactBoardSerializer{
+		BoardID: board.ID,
+		Width:   board.Width,
+		Height:  board.Height,
+		Seq:     board.Seq,
+		Colors:  colors,
+		Pixels:  base64.StdEncoding.EncodeToString(indices),
+	}
+}
+
+// ChunkSerializer is a tile of a board. X and Y are the pixel coordinates of
+// its top left corner and Seq is its version.
+type ChunkSerializer struct {
+	BoardID  int                        `json:"board_id"`
+	ChunkX   int                        `json:"chunk_x"`
+	ChunkY   int                        `json:"chunk_y"`
+	X        int                        `json:"x"`
+	Y        int                        `json:"y"`
+	Width    int                        `json:"width"`
+	Height   int                        `json:"height"`
+	Seq      int64                      `json:"seq"`
+	BoardSeq int64                      `json:"board_seq"`
+	Pixels   []*PixelWithUserSerializer `json:"pixels"`
+}
+
+func NewChunk(chunk *service.Chunk) *ChunkSerializer {
+	pixels := make([]*PixelWithUserSerializer, len(chunk.Pixels))
+	for i, pixel := range chunk.Pixels {
+		pixels[i] = NewPixelWithUser(pixel)
+	}
+
+	return &ChunkSerializer{
+		BoardID:  chunk.Board.ID,
+		ChunkX:   chunk.X,
+		ChunkY:   chunk.Y,
+		X:        chunk.X * service.ChunkSize,
+		Y:        chunk.Y * service.ChunkSize,
+		Width:    chunk.Width,
+		Height:   chunk.Height,
+		Seq:      chunk.Seq,
+		BoardSeq: chunk.BoardSeq,
+		Pixels:   pixels,
+	}
+}
+
+// CompactChunkSerializer is a chunk encoded like CompactBoardSerializer.
+type CompactChunkSerializer struct {
+	BoardID  int      `json:"board_id"`
+	ChunkX   int      `json:"chunk_x"`
+	ChunkY   int      `json:"chunk_y"`
+	X        int      `json:"x"`
+	Y        int      `json:"y"`
+	Width    int      `json:"width"`
+	Height   int      `json:"height"`
+	Seq      int64    `json:"seq"`
+	BoardSeq int64    `json:"board_seq"`
+	Colors   []string `json:"colors"`
+	Pixels   string   `json:"pixels"`
+}
+
+func NewCompactChunk(chunk *service.Chunk, colors []string, indices []byte) *CompactChunkSerializer {
+	return &CompactChunkSerializer{
+		BoardID:  chunk.Board.ID,
+		ChunkX:   chunk.X,
+		ChunkY:   chunk.Y,
+		X:        chunk.X * service.ChunkSize,
+		Y:        chunk.Y * service.ChunkSize,
+		Width:    chunk.Width,
+		Height:   chunk.Height,
+		Seq:      chunk.Seq,
+		BoardSeq: chunk.BoardSeq,
+		Colors:   colors,
+		Pixels:   base64.StdEncoding.EncodeToString(indices),
+	}
+}
+
+type PixelUpdatedSerializer struct {
+	BoardID int                      `json:"board_id"`
+	Pixel   *PixelWithUserSerializer `json:"pixel"`
+	User    User                     `json:"user"`
+	Seq     int64                    `json:"seq"`
+}
+
+func NewPixelUpdated(pixel *ent.Pixel, user *ent.User) *PixelUpdatedSerializer {
+	return &PixelUpdatedSerializer{
+		BoardID: pixel.Edges.Board.ID,
+		Pixel:   NewPixelWithUser(pixel),
+		User:    NewUser(user),
+		Seq:     pixel.Seq,
+	}
+}
+
+// PixelsUpdatedSerializer is the single event sent for a batch paint. Its
+// pixels carry consecutive sequence numbers ending at Seq.
+type PixelsUpdatedSerializer struct {
+	BoardID int                        `json:"board_id"`
+	Pixels  []*PixelWithUserSerializer `json:"pixels"`
+	User    User                       `json:"user"`
+	Seq     int64                      `json:"seq"`
+}
+
+func NewPixelsUpdated(pixels []*ent.Pixel, user *ent.User) *PixelsUpdatedSerializer {
+	result := make([]*PixelWithUserSerializer, len(pixels))
+	var seq int64
+	for i, pixel := range pixels {
+		result[i] = NewPixelWithUser(pixel)
+		seq = max(seq, pixel.Seq)
+	}
+
+	// Changes made by operator commands have no user.
+	var by User
+	if user != nil {
+		by = NewUser(user)
+	}
+
+	return &PixelsUpdatedSerializer{
+		BoardID: pixels[0].Edges.Board.ID,
+		Pixels:  result,
+		User:    by,
+		Seq:     seq,
+	}
+}
+
+// BoardResizedSerializer is sent when a board grows. Its pixels moved, so
+// clients reload it.
+type BoardResizedSerializer struct {
+	BoardID int   `json:"board_id"`
+	Width   int   `json:"width"`
+	Height  int   `json:"height"`
+	Seq     int64 `json:"seq"`
+}
+
+func NewBoardResized(board *ent.Board) *BoardResizedSerializer {
+	return &BoardResizedSerializer{
+		BoardID: board.ID,
+		Width:   board.Width,
+		Height:  board.Height,
+		Seq:     board.Seq,
+	}
+}
+
+// BoardSinceSerializer carries the board size, so clients notice a resize
+// they missed and reload the board.
+type BoardSinceSerializer struct {
+	BoardID int                        `json:"board_id"`
+	Width   int                        `json:"width"`
+	Height  int                        `json:"height"`
+	Pixels  []*PixelWithUserSerializer `json:"pixels"`
+	Seq     int64                      `json:"seq"`
+}
+
+func NewBoardSince(board *ent.Board, pixels []*ent.Pixel, seq int64) *BoardSinceSerializer {
+	result := make([]*PixelWithUserSerializer, len(pixels))
+	for i, pixel := range pixels {
+		result[i] = NewPixelWithUser(pixel)
+	}
+
+	return &BoardSinceSerializer{
+		BoardID: board.ID,
+		Width:   board.Width,
+		Height:  board.Height,
+		Pixels:  result,
+		Seq:     seq,
 	}
 }
@@ -0,0 +1,44 @@
+package serializer
+
+import "nevissGo/ent"
+
+type RegionSerializer struct {
+	ID      int    `json:"id"`
+	BoardID int    `json:"board_id"`
+	Name    string `json:"name"`
+	X       int    `json:"x"`
+	Y       int    `json:"y"`
+	Width   int    `json:"width"`
+	Height  int    `json:"height"`
+	Mask    string `json:"mask,omitempty"`
+}
+
+func NewRegion(region *ent.Region) *RegionSerializer {
+	return &RegionSerializer{
+		ID:      region.ID,
+		BoardID: region.BoardID,
+		Name:    region.Name,
+		X:       region.X,
+		Y:       region.Y,
+		Width:   region.Width,
+		Height:  region.Height,
+		Mask:    region.Mask,
+	}
+}
+
+type RegionsSerializer struct {
+	BoardID int                 `json:"board_id"`
+	Regions []*RegionSerializer `json:"regions"`
+}
+
+func NewRegions(board *ent.Board, regions []*ent.Region) *RegionsSerializer {
+	result := make([]*RegionSerializer, len(regions))
+	for i, region := range regions {
+		result[i] = NewRegion(region)
+	}
+
+	return &RegionsSerializer{
+		BoardID: board.ID,
+		Regions: result,
+	}
+}
@@ -0,0 +1,117 @@
+package serializer
+
+import (
+	"time"
+
+	"nevissGo/app/service"
+	"nevissGo/ent"
+)
+
+type TeamSerializer struct {
+	ID         int    `json:"id"`
+	Name       string `json:"name"`
+	InviteCode string `json:"invite_code,omitempty"`
+	Members    []User `json:"members,omitempty"`
+}
+
+// NewTeam serializes a team for anyone to see, without its invite code.
+func NewTeam(team *ent.Team) *TeamSerializer {
+	if team == nil {
+		return nil
+	}
+
+	return &TeamSerializer{
+		ID:   team.ID,
+		Name: team.Name,
+	}
+}
+
+// NewOwnTeam serializes the team of the current user, with its invite code
+// and members.
+func NewOwnTeam(team *ent.Team) *TeamSerializer {
+	result := NewTeam(team)
+	result.InviteCode = team.InviteCode
+
+	result.Members = make([]User, len(team.Edges.Members))
+	for i, member := range team.Edges.Members {
+		result.Members[i] = NewUser(member)
+	}
+
+	return result
+}
+
+type TeamMembershipSerializer struct {
+	Team *TeamSerializer `json:"team,omitempty"`
+	// Token replaces the user's token so their connection subscribes to the
+	// channel of their new team.
+	Token string `json:"token"`
+}
+
+func NewTeamMembership(team *ent.Team, token string) *TeamMembershipSerializer {
+	var result *TeamSerializer
+	if team != nil {
+		result = NewOwnTeam(team)
+	}
+
+	return &TeamMembershipSerializer{
+		Team:  result,
+		Token: token,
+	}
+}
+
+type TeamEventSerializer struct {
+	TeamID int    `json:"team_id"`
+	User   User   `json:"user"`
+	Text   string `json:"text,omitempty"`
+	SentAt int64  `json:"sent_at"`
+}
+
+func NewTeamEvent(teamID int, user *ent.User, text string) *TeamEventSerializer {
+	return &TeamEventSerializer{
+		TeamID: teamID,
+		User:   NewUser(user),
+		Text:   text,
+		SentAt: time.Now().Unix(),
+	}
+}
+
+type TeamLeaderboardEntrySerializer struct {
+	Rank  int             `json:"rank"`
+	Team  *TeamSerializer `json:"team"`
+	Score int             `json:"score"`
+}
+
+func NewTeamLeaderboardEntry(entry *service.TeamLeaderboardEntry) *TeamLeaderboardEntrySerializer {
+	if entry == nil {
+		return nil
+	}
+
+	return &TeamLeaderboardEntrySerializer{
+		Rank:  entry.Rank,
+		Team:  NewTeam(entry.Team),
+		Score: entry.Score,
+	}
+}
+
+type TeamLeaderboardSerializer struct {
+	BoardID int                               `json:"board_id"`
+	Metric  string                            `json:"metric"`
+	Window  string                            `json:"window"`
+	Entries []*TeamLeaderboardEntrySerializer `json:"entries"`
+	Mine    *TeamLeaderboardEntrySerializer   `json:"mine,omitempty"`
+}
+
+func NewTeamLeaderboard(result *service.TeamLeaderboardResult) *TeamLeaderboardSerializer {
+	entries := make([]*TeamLeaderboardEntrySerializer, len(result.Entries))
+	for i, entry := range result.Entries {
+		entries[i] = NewTeamLeaderboardEntry(entry)
+	}
+
+	return &TeamLeaderboardSerializer{
+		BoardID: result.Board.ID,
+		Metric:  string(result.Metric),
+		Window:  string(result.Window),
+		Entries: entries,
+		Mine:    NewTeamLeaderboardEntry(result.Mine),
+	}
+}
@@ -1,6 +1,11 @@
 package serializer
 
-import "nevissGo/ent"
+import (
+	"math"
+
+	"nevissGo/app/service"
+	"nevissGo/ent"
+)
 
 type User struct {
 	ID          string `json:"id"`
@@ -25,3 +30,19 @@ func NewUserWithJwt(user *ent.User, token string) UserWithToken {
 		Token: token,
 	}
 }
+
+type UserStatusSerializer struct {
+	BoardID             int     `json:"board_id"`
+	UserCooldownSeconds float64 `json:"user_cooldown_seconds"`
+	CanPaint            bool    `json:"can_paint"`
+	RetryAfter          int     `json:"retry_after"`
+}
+
+func NewUserStatus(status *service.UserStatus) *UserStatusSerializer {
+	return &UserStatusSerializer{
+		BoardID:             status.Board.ID,
+		UserCooldownSeconds: status.Board.UserCooldown.Seconds(),
+		CanPaint:            status.RetryAfter <= 0,
+		RetryAfter:          int(math.Ceil(status.RetryAfter.Seconds())),
+	}
+}
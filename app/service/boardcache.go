@@ -0,0 +1,124 @@
+package service
+
+import (
+	"sync"
+
+	"nevissGo/ent"
+)
+
+// boardCache keeps the pixels of the boards that were read in memory, so
+// reading a board does not scan and join all of its pixels. Every position
+// holds a pixel; unpainted positions hold a white pixel with a zero sequence
+// number.
+type boardCache struct {
+	mu     sync.Mutex
+	boards map[int]*cachedBoard
+}
+
+type cachedBoard struct {
+	mu     sync.Mutex
+	width  int
+	height int
+	pixels []*ent.Pixel
+	seq    int64
+}
+
+func newBoardCache() *boardCache {
+	return &boardCache{
+		boards: map[int]*cachedBoard{},
+	}
+}
+
+func (c *boardCache) board(boardID int) *cachedBoard {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cached, ok := c.boards[boardID]
+	if !ok {
+		cached = &cachedBoard{}
+		c.boards[boardID] = cached
+	}
+	return cached
+}
+
+func (c *boardCache) boardIDs() []int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ids := make([]int, 0, len(c.boards))
+	for id := range c.boards {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// snapshot copies the cached pixels of the w by h area at x, y row by row,
+// along with the board sequence number. It reports false when the board was
+// never loaded or has been resized since.
+func (b *cachedBoard) snapshot(width, height int, x, y, w, h int) ([]*ent.Pixel, int64, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.pixels == nil || b.width != width || b.height != height {
+		return nil, 0, false
+	}
+
+	pixels := make([]*ent.Pixel, 0, w*h)
+	for row := y; row < y+h; row++ {
+		pixels = append(pixels, b.pixels[row*width+x:row*width+x+w]...)
+	}
+	return pixels, b.seq, true
+}
+
+func (b *cachedBoard) replace(width, height int, pixels []*ent.Pixel, seq int64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.width, b.height, b.pixels, b.seq = width, height, pixels, seq
+}
+
+// apply stores pixels that are newer than the cached ones. Pixels can arrive
+// out of order or twice, as paints are applied after their transaction
+// commits and also read back from the database, so older ones are ignored.
+func (b *cachedBoard) apply(pixels []*ent.Pixel) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, p := range pixels {
+		if b.pixels == nil || p.Position < 0 || p.Position >= len(b.pixels) {
+			continue
+		}
+		if p.Seq > b.pixels[p.Position].Seq {
+			b.pixels[p.Position] = p
+		}
+		b.seq = max(b.seq, p.Seq)
+	}
+}
+
+// merge brings the cache in line with pixels freshly loaded from the database
+// and returns how many cached pixels were wrong. Cached pixels newer than the
+// loaded ones were painted after the load and are kept, and loaded pixels
+// newer than anything cached were simply not applied yet.
+func (b *cachedBoard) merge(width, height int, loaded []*ent.Pixel, seq int64) int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.pixels == nil || b.width != width || b.height != height {
+		b.width, b.height, b.pixels, b.seq = width, height, loaded, seq
+		return 0
+	}
+
+	fixed := 0
+	for i, p := range loaded {
+		cached := b.pixels[i]
+		if cached.Seq > p.Seq {
+			continue
+		}
+		if p.Seq <= b.seq && (cached.Seq != p.Seq || cached.Color != p.Color || cached.UserID != p.UserID) {
+			fixed++
+		}
+		b.pixels[i] = p
+	}
+	b.seq = max(b.seq, seq)
+	return fixed
+}
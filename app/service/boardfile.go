@@ -0,0 +1,192 @@
+package service
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+	"nevissGo/ent"
+	"nevissGo/ent/pixel"
+	"nevissGo/ent/user"
+	"nevissGo/framework"
+	"nevissGo/pkg/boardfile"
+	"nevissGo/pkg/palette"
+)
+
+// importBatchSize is how many pixels are inserted per statement on import.
+const importBatchSize = 500
+
+// Export returns the board in the portable board file format. Pixels that are
+// white and unowned, like wiped ones, are left out as they look unpainted.
+func (s *Boards) Export(ctx context.Context, boardID int) (*ent.Board, *boardfile.Board, error) {
+	b, err := findBoard(ctx, s.app.Client(), boardID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pixels, err := b.QueryPixels().
+		Where(pixel.PositionGTE(0), pixel.PositionLT(b.Width*b.Height)).
+		Order(ent.Asc(pixel.FieldPosition)).
+		All(ctx)
+	if err != nil {
+		logrus.WithError(err).WithField("board_id", b.ID).Error("Failed to retrieve pixels")
+		return nil, nil, framework.NewInternalError("Failed to retrieve pixels")
+	}
+
+	file := &boardfile.Board{
+		Version: boardfile.Version,
+		Name:    b.Name,
+		Width:   b.Width,
+		Height:  b.Height,
+		Palette: b.Palette,
+		Pixels:  make([]boardfile.Pixel, 0, len(pixels)),
+	}
+	for _, p := range pixels {
+		if p.Color == "white" && p.UserID == 0 {
+			continue
+		}
+		file.Pixels = append(file.Pixels, boardfile.Pixel{
+			Position: p.Position,
+			Color:    p.Color,
+			Owner:    p.UserID,
+		})
+	}
+
+	return b, file, nil
+}
+
+// Import creates a new board from a board file. Owners that do not exist in
+// this database are dropped, leaving their pixels unowned. The restored
+// pixels are recorded in the history without a user, so they show up in
+// timelapses but do not count as anyone's paints. ownerID may be zero for a
+// board without an owner, and name overrides the name in the file.
+func (s *Boards) Import(ctx context.Context, ownerID int64, file *boardfile.Board, name string) (*ent.Board, error) {
+	if err := file.Validate(); err != nil {
+		return nil, framework.NewValidationError("Invalid board file").WithFields(framework.Fields{
+			"reason": err.Error(),
+		})
+	}
+
+	p, ok := palette.Get(file.Palette)
+	if !ok {
+		return nil, framework.NewValidationError("Unknown palette")
+	}
+	for _, px := range file.Pixels {
+		if !p.Contains(px.Color) {
+			return nil, framework.NewValidationError("Color is not in the board palette").WithFields(framework.Fields{
+				"pixel_id": px.Position,
+				"color":    px.Color,
+			})
+		}
+	}
+
+	if name == "" {
+		name = file.Name
+	}
+	if name == "" {
+		return nil, framework.NewValidationError("Board name is required")
+	}
+
+	var created *ent.Board
+	err := s.app.TX(ctx, func(tx *ent.Tx) error {
+		owners, err := existingUsers(ctx, tx.Client(), file.Pixels)
+		if err != nil {
+			return err
+		}
+
+		create := tx.Board.Create().
+			SetName(name).
+			SetWidth(file.Width).
+			SetHeight(file.Height).
+			SetPalette(file.Palette).
+			SetSeq(int64(len(file.Pixels)))
+		if ownerID != 0 {
+			create.SetOwnerID(ownerID)
+		}
+		created, err = create.Save(ctx)
+		if err != nil {
+			logrus.WithError(err).WithField("name", name).Error("Failed to create board")
+			return framework.NewInternalError("Failed to create board")
+		}
+
+		for start := 0; start < len(file.Pixels); start += importBatchSize {
+			batch := file.Pixels[start:min(start+importBatchSize, len(file.Pixels))]
+			if err := importPixels(ctx, tx, created, batch, int64(start+1), owners); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"board_id": created.ID,
+		"name":     name,
+		"pixels":   len(file.Pixels),
+	}).Info("Board imported")
+	return created.Unwrap(), nil
+}
+
+// existingUsers returns which of the pixel owners exist in the database.
+func existingUsers(ctx context.Context, client *ent.Client, pixels []boardfile.Pixel) (map[int64]bool, error) {
+	seen := make(map[int64]bool)
+	var ids []int64
+	for _, p := range pixels {
+		if p.Owner != 0 && !seen[p.Owner] {
+			seen[p.Owner] = true
+			ids = append(ids, p.Owner)
+		}
+	}
+
+	owners := make(map[int64]bool, len(ids))
+	for start := 0; start < len(ids); start += importBatchSize {
+		found, err := client.User.Query().
+			Where(user.IDIn(ids[start:min(start+importBatchSize, len(ids))]...)).
+			IDs(ctx)
+		if err != nil {
+			logrus.WithError(err).Error("Failed to retrieve pixel owners")
+			return nil, framework.NewInternalError("Failed to retrieve pixel owners")
+		}
+		for _, id := range found {
+			owners[id] = true
+		}
+	}
+	return owners, nil
+}
+
+func importPixels(ctx context.Context, tx *ent.Tx, b *ent.Board, pixels []boardfile.Pixel, seq int64, owners map[int64]bool) error {
+	creates := make([]*ent.PixelCreate, len(pixels))
+	for i, p := range pixels {
+		creates[i] = tx.Pixel.Create().
+			SetBoard(b).
+			SetPosition(p.Position).
+			SetColor(p.Color).
+			SetSeq(seq + int64(i))
+		if owners[p.Owner] {
+			creates[i].SetUserID(p.Owner)
+		}
+	}
+
+	created, err := tx.Pixel.CreateBulk(creates...).Save(ctx)
+	if err != nil {
+		logrus.WithError(err).WithField("board_id", b.ID).Error("Failed to import pixels")
+		return framework.NewInternalError("Failed to import pixels")
+	}
+
+	changes := make([]*ent.PixelChangeCreate, len(created))
+	for i, p := range created {
+		changes[i] = tx.PixelChange.Create().
+			SetBoard(b).
+			SetPixel(p).
+			SetPosition(p.Position).
+			SetOldColor("white").
+			SetNewColor(p.Color).
+			SetSeq(p.Seq)
+	}
+	if err := tx.PixelChange.CreateBulk(changes...).Exec(ctx); err != nil {
+		logrus.WithError(err).WithField("board_id", b.ID).Error("Failed to record imported pixels")
+		return framework.NewInternalError("Failed to record imported pixels")
+	}
+	return nil
+}
@@ -0,0 +1,348 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"nevissGo/ent"
+	"nevissGo/ent/board"
+	"nevissGo/ent/pixel"
+	"nevissGo/ent/pixelchange"
+	"nevissGo/ent/user"
+	"nevissGo/framework"
+	"nevissGo/pkg/palette"
+)
+
+const (
+	// MaxBoardSize is the largest width and height of a board.
+	MaxBoardSize = 512
+	// MaxBoardsPerOwner is how many boards a user other than an admin can
+	// create.
+	MaxBoardsPerOwner = 5
+)
+
+type BoardSettings struct {
+	Name         string
+	Width        int
+	Height       int
+	Cooldown     time.Duration
+	UserCooldown time.Duration
+	HypeCost     int
+	Palette      string
+}
+
+type Boards struct {
+	app *framework.App
+}
+
+func NewBoards(app *framework.App) *Boards {
+	return &Boards{
+		app: app,
+	}
+}
+
+// Create makes a new board owned by the user. Users other than admins can own
+// up to MaxBoardsPerOwner boards.
+func (s *Boards) Create(ctx context.Context, ownerID int64, settings BoardSettings) (*ent.Board, error) {
+	if err := checkBoardSize(settings.Width, settings.Height); err != nil {
+		return nil, err
+	}
+	if settings.Palette != "" {
+		if _, ok := palette.Get(settings.Palette); !ok {
+			return nil, framework.NewValidationError("Unknown palette")
+		}
+	}
+
+	var created *ent.Board
+	err := s.app.TX(ctx, func(tx *ent.Tx) error {
+		// Locking the owner makes concurrent creations count each other.
+		owner, err := tx.User.Query().Where(user.ID(ownerID), forUpdate).Only(ctx)
+		if err != nil {
+			logrus.WithError(err).WithField("user_id", ownerID).Error("Failed to get user")
+			return framework.NewInternalError("Failed to get user")
+		}
+		if owner.Role != user.RoleAdmin {
+			owned, err := owner.QueryBoards().Count(ctx)
+			if err != nil {
+				logrus.WithError(err).WithField("user_id", ownerID).Error("Failed to count boards")
+				return framework.NewInternalError("Failed to count boards")
+			}
+			if owned >= MaxBoardsPerOwner {
+				return framework.NewForbiddenError("You can not create more boards").WithFields(framework.Fields{
+					"max_boards": MaxBoardsPerOwner,
+				})
+			}
+		}
+
+		create := tx.Board.Create().
+			SetName(settings.Name).
+			SetWidth(settings.Width).
+			SetHeight(settings.Height).
+			SetCooldown(settings.Cooldown).
+			SetUserCooldown(settings.UserCooldown).
+			SetHypeCost(settings.HypeCost).
+			SetOwnerID(ownerID)
+		if settings.Palette != "" {
+			create.SetPalette(settings.Palette)
+		}
+
+		created, err = create.Save(ctx)
+		if err != nil {
+			logrus.WithError(err).WithField("name", settings.Name).Error("Failed to create board")
+			return framework.NewInternalError("Failed to create board")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created.Unwrap(), nil
+}
+
+// checkBoardSize keeps boards between 1x1 and MaxBoardSize on each side.
+func checkBoardSize(width, height int) error {
+	if width < 1 || height < 1 {
+		return framework.NewValidationError("Board size must be positive")
+	}
+	if width > MaxBoardSize || height > MaxBoardSize {
+		return framework.NewValidationError("Board is too large").WithFields(framework.Fields{
+			"max_size": MaxBoardSize,
+		})
+	}
+	return nil
+}
+
+// EnsureDefault makes sure there is at least one board to paint on. Pixels
+// created before boards existed are adopted by the default board, keeping
+// their old ID as their position.
+func (s *Boards) EnsureDefault(ctx context.Context, settings BoardSettings) (*ent.Board, error) {
+	var result *ent.Board
+	err := s.app.TX(ctx, func(tx *ent.Tx) error {
+		existing, err := findBoard(ctx, tx.Client(), 0)
+		if err == nil {
+			result = existing
+		} else if framework.ExtErrorCode(err) == 404 {
+			if err := checkBoardSize(settings.Width, settings.Height); err != nil {
+				return err
+			}
+			create := tx.Board.Create().
+				SetName(settings.Name).
+				SetWidth(settings.Width).
+				SetHeight(settings.Height).
+				SetCooldown(settings.Cooldown).
+				SetUserCooldown(settings.UserCooldown).
+				SetHypeCost(settings.HypeCost)
+			if settings.Palette != "" {
+				create.SetPalette(settings.Palette)
+			}
+			result, err = create.Save(ctx)
+			if err != nil {
+				logrus.WithError(err).Error("Failed to create default board")
+				return framework.NewInternalError("Failed to create default board")
+			}
+		} else {
+			return err
+		}
+
+		orphans, err := tx.Pixel.Query().
+			Where(pixel.Not(pixel.HasBoard())).
+			All(ctx)
+		if err != nil {
+			logrus.WithError(err).Error("Failed to retrieve orphan pixels")
+			return framework.NewInternalError("Failed to retrieve orphan pixels")
+		}
+
+		for _, orphan := range orphans {
+			err := tx.Pixel.UpdateOne(orphan).
+				SetBoard(result).
+				SetPosition(orphan.ID).
+				Exec(ctx)
+			if err != nil {
+				logrus.WithError(err).WithField("pixel_id", orphan.ID).Error("Failed to adopt orphan pixel")
+				return framework.NewInternalError("Failed to adopt orphan pixel")
+			}
+		}
+
+		if len(orphans) > 0 {
+			logrus.WithFields(logrus.Fields{
+				"board_id": result.ID,
+				"pixels":   len(orphans),
+			}).Info("Orphan pixels adopted by default board")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *Boards) Get(ctx context.Context, boardID int) (*ent.Board, error) {
+	return findBoard(ctx, s.app.Client(), boardID)
+}
+
+func (s *Boards) List(ctx context.Context) ([]*ent.Board, error) {
+	boards, err := s.app.Client().Board.Query().
+		WithOwner().
+		Order(ent.Asc(board.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, framework.NewInternalError("Failed to retrieve boards")
+	}
+
+	return boards, nil
+}
+
+// SetOpen opens or closes a board for painting. Only the owner of the board
+// may change it.
+func (s *Boards) SetOpen(ctx context.Context, boardID int, userID int64, open bool) (*ent.Board, error) {
+	b, err := findBoard(ctx, s.app.Client(), boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	if b.Edges.Owner == nil || b.Edges.Owner.ID != userID {
+		return nil, framework.NewForbiddenError("Only the board owner can change it")
+	}
+
+	updated, err := b.Update().SetOpen(open).Save(ctx)
+	if err != nil {
+		logrus.WithError(err).WithField("board_id", boardID).Error("Failed to update board")
+		return nil, framework.NewInternalError("Failed to update board")
+	}
+	updated.Edges.Owner = b.Edges.Owner
+
+	return updated, nil
+}
+
+// Resize grows a board, up to MaxBoardSize. Positions are row-major, so
+// painted pixels and their history are moved to keep their coordinates when
+// the width changes. Boards can not shrink, so no paint is ever lost. The
+// resize takes a sequence number and is announced as board:resized, so
+// clients reload the board.
+func (s *Boards) Resize(ctx context.Context, boardID int, width, height int) (*ent.Board, error) {
+	if err := checkBoardSize(width, height); err != nil {
+		return nil, err
+	}
+
+	var resized *ent.Board
+	err := s.app.TX(ctx, func(tx *ent.Tx) error {
+		b, err := findBoard(ctx, tx.Client(), boardID)
+		if err != nil {
+			return err
+		}
+		if width < b.Width || height < b.Height {
+			return framework.NewValidationError("Boards can only grow")
+		}
+
+		if width != b.Width {
+			if err := movePositions(ctx, tx, b, width); err != nil {
+				return err
+			}
+		}
+
+		resized, err = tx.Board.UpdateOne(b).
+			SetWidth(width).
+			SetHeight(height).
+			AddSeq(1).
+			Save(ctx)
+		if err != nil {
+			logrus.WithError(err).WithField("board_id", b.ID).Error("Failed to resize board")
+			return framework.NewInternalError("Failed to resize board")
+		}
+		resized.Edges.Owner = b.Edges.Owner
+		return s.app.Enqueue(ctx, tx, framework.BroadcastEvent("board:resized", BoardResizedEvent{Board: resized}))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"board_id": resized.ID,
+		"width":    width,
+		"height":   height,
+	}).Info("Board resized")
+	return resized.Unwrap(), nil
+}
+
+// movePositions moves every row of the board to its place at the new width.
+// Pixel positions are unique per board, so rows are first moved to distinct
+// negative positions and then all shifted back up at once.
+func movePositions(ctx context.Context, tx *ent.Tx, b *ent.Board, width int) error {
+	offset := width * b.Height
+	for y := 0; y < b.Height; y++ {
+		from, to := y*b.Width, (y+1)*b.Width
+		shift := y*(width-b.Width) - offset
+
+		err := tx.Pixel.Update().
+			Where(pixel.BoardIDEQ(b.ID), pixel.PositionGTE(from), pixel.PositionLT(to)).
+			AddPosition(shift).
+			Exec(ctx)
+		if err == nil {
+			err = tx.PixelChange.Update().
+				Where(pixelchange.BoardIDEQ(b.ID), pixelchange.PositionGTE(from), pixelchange.PositionLT(to)).
+				AddPosition(shift).
+				Exec(ctx)
+		}
+		if err != nil {
+			logrus.WithError(err).WithField("board_id", b.ID).Error("Failed to move pixels")
+			return framework.NewInternalError("Failed to move pixels")
+		}
+	}
+
+	err := tx.Pixel.Update().
+		Where(pixel.BoardIDEQ(b.ID), pixel.PositionLT(0)).
+		AddPosition(offset).
+		Exec(ctx)
+	if err == nil {
+		err = tx.PixelChange.Update().
+			Where(pixelchange.BoardIDEQ(b.ID), pixelchange.PositionLT(0)).
+			AddPosition(offset).
+			Exec(ctx)
+	}
+	if err != nil {
+		logrus.WithError(err).WithField("board_id", b.ID).Error("Failed to move pixels")
+		return framework.NewInternalError("Failed to move pixels")
+	}
+	return nil
+}
+
+// findBoard loads a board with its owner. A zero ID refers to the default
+// board, which is the oldest one.
+func findBoard(ctx context.Context, client *ent.Client, boardID int) (*ent.Board, error) {
+	query := client.Board.Query().WithOwner()
+	if boardID == 0 {
+		query = query.Order(ent.Asc(board.FieldID))
+	} else {
+		query = query.Where(board.IDEQ(boardID))
+	}
+
+	b, err := query.First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, framework.NewNotFoundError("Board not found")
+	}
+	if err != nil {
+		logrus.WithError(err).WithField("board_id", boardID).Error("Failed to retrieve board")
+		return nil, framework.NewInternalError("Failed to retrieve board")
+	}
+
+	return b, nil
+}
+
+// boardPalette returns the palette a board is painted with, falling back to the
+// default palette when the board refers to one that is no longer registered.
+func boardPalette(board *ent.Board) palette.Palette {
+	if p, ok := palette.Get(board.Palette); ok {
+		return p
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"board_id": board.ID,
+		"palette":  board.Palette,
+	}).Warn("Board uses an unknown palette")
+	return palette.Default
+}
@@ -0,0 +1,335 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"image/gif"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"nevissGo/ent"
+	"nevissGo/ent/outboxevent"
+	"nevissGo/ent/pixel"
+	"nevissGo/ent/pixelchange"
+	"nevissGo/ent/user"
+	"nevissGo/framework"
+	"nevissGo/pkg/boardfile"
+)
+
+type BoardsSuite struct {
+	suite.Suite
+	app     *framework.TestingApp
+	service *Boards
+	ctx     context.Context
+	user    *ent.User
+}
+
+func TestBoardsSuite(t *testing.T) {
+	suite.Run(t, new(BoardsSuite))
+}
+
+func (s *BoardsSuite) SetupTest() {
+	s.app = framework.NewTestingApp(s.T())
+	s.service = NewBoards(s.app.App)
+	s.ctx = context.Background()
+
+	var err error
+	s.user, err = s.app.Client().User.Create().
+		SetDisplayName("TestUser").
+		SetGameID("game123").
+		Save(s.ctx)
+	s.NoError(err)
+}
+
+func (s *BoardsSuite) TestEnsureDefaultAdoptsOrphanPixels() {
+	_, err := s.app.Client().Pixel.Create().
+		SetID(7).
+		SetColor("red-dark").
+		SetUserID(s.user.ID).
+		Save(s.ctx)
+	s.NoError(err)
+
+	board, err := s.service.EnsureDefault(s.ctx, BoardSettings{
+		Name:     "main",
+		Width:    40,
+		Height:   40,
+		Cooldown: time.Second,
+		HypeCost: 1,
+	})
+	s.NoError(err)
+	s.Equal("main", board.Name)
+	s.Equal(time.Second, board.Cooldown)
+
+	adopted, err := s.app.Client().Pixel.Query().
+		Where(pixel.IDEQ(7)).
+		WithBoard().
+		Only(s.ctx)
+	s.NoError(err)
+	s.Equal(7, adopted.Position)
+	s.Equal(board.ID, adopted.Edges.Board.ID)
+
+	again, err := s.service.EnsureDefault(s.ctx, BoardSettings{Name: "other", Width: 1, Height: 1})
+	s.NoError(err)
+	s.Equal(board.ID, again.ID)
+}
+
+func (s *BoardsSuite) TestGetDefaultBoard() {
+	first, err := s.service.Create(s.ctx, s.user.ID, BoardSettings{Name: "first", Width: 5, Height: 5})
+	s.NoError(err)
+	_, err = s.service.Create(s.ctx, s.user.ID, BoardSettings{Name: "second", Width: 5, Height: 5})
+	s.NoError(err)
+
+	board, err := s.service.Get(s.ctx, 0)
+	s.NoError(err)
+	s.Equal(first.ID, board.ID)
+	s.Equal("default", board.Palette)
+
+	_, err = s.service.Get(s.ctx, 999)
+	s.Error(err)
+	s.Equal(404, framework.ExtErrorCode(err))
+}
+
+func (s *BoardsSuite) TestSetOpenOnlyByOwner() {
+	board, err := s.service.Create(s.ctx, s.user.ID, BoardSettings{Name: "event", Width: 5, Height: 5})
+	s.NoError(err)
+	s.True(board.Open)
+
+	other, err := s.app.Client().User.Create().
+		SetDisplayName("Other").
+		SetGameID("game456").
+		Save(s.ctx)
+	s.NoError(err)
+
+	_, err = s.service.SetOpen(s.ctx, board.ID, other.ID, false)
+	s.Error(err)
+	s.Equal(403, framework.ExtErrorCode(err))
+
+	updated, err := s.service.SetOpen(s.ctx, board.ID, s.user.ID, false)
+	s.NoError(err)
+	s.False(updated.Open)
+}
+
+func (s *BoardsSuite) TestCreateWithUnknownPalette() {
+	_, err := s.service.Create(s.ctx, s.user.ID, BoardSettings{Name: "event", Width: 5, Height: 5, Palette: "neon"})
+	s.Error(err)
+	s.Equal(400, framework.ExtErrorCode(err))
+	s.Equal("Unknown palette", framework.ExtErrorMessage(err))
+}
+
+func (s *BoardsSuite) TestCreateLimits() {
+	_, err := s.service.Create(s.ctx, s.user.ID, BoardSettings{Name: "huge", Width: MaxBoardSize + 1, Height: 5})
+	s.Equal("Board is too large", framework.ExtErrorMessage(err))
+
+	for i := 0; i < MaxBoardsPerOwner; i++ {
+		_, err := s.service.Create(s.ctx, s.user.ID, BoardSettings{Name: "event", Width: 5, Height: 5})
+		s.NoError(err)
+	}
+	_, err = s.service.Create(s.ctx, s.user.ID, BoardSettings{Name: "event", Width: 5, Height: 5})
+	s.Equal(403, framework.ExtErrorCode(err))
+
+	// Admins are not limited.
+	s.NoError(s.user.Update().SetRole(user.RoleAdmin).Exec(s.ctx))
+	_, err = s.service.Create(s.ctx, s.user.ID, BoardSettings{Name: "event", Width: 5, Height: 5})
+	s.NoError(err)
+}
+
+func (s *BoardsSuite) TestTimelapse() {
+	board, err := s.service.Create(s.ctx, s.user.ID, BoardSettings{Name: "event", Width: 4, Height: 4})
+	s.NoError(err)
+
+	start := time.Now().Add(-time.Hour)
+	for i, color := range []string{"red-dark", "blue-light", "black"} {
+		err := s.app.Client().PixelChange.Create().
+			SetBoard(board).
+			SetUser(s.user).
+			SetPosition(i).
+			SetOldColor("white").
+			SetNewColor(color).
+			SetSeq(int64(i + 1)).
+			SetCreatedAt(start.Add(time.Duration(i) * time.Minute)).
+			Exec(s.ctx)
+		s.NoError(err)
+	}
+
+	timelapse, err := s.service.Timelapse(s.ctx, board.ID, TimelapseOptions{Interval: time.Minute})
+	s.NoError(err)
+	s.Equal(4, timelapse.Frames)
+
+	decoded, err := gif.DecodeAll(bytes.NewReader(timelapse.GIF))
+	s.NoError(err)
+	s.Len(decoded.Image, 4)
+	s.Equal(4*defaultTimelapseScale, decoded.Config.Width)
+
+	_, err = s.service.Timelapse(s.ctx, board.ID, TimelapseOptions{Interval: time.Millisecond})
+	s.Error(err)
+	s.Equal(400, framework.ExtErrorCode(err))
+}
+
+func (s *BoardsSuite) TestTimelapseIsBounded() {
+	board, err := s.service.Create(s.ctx, s.user.ID, BoardSettings{Name: "huge", Width: 512, Height: 512})
+	s.NoError(err)
+
+	start := time.Now().Add(-time.Hour)
+	for i := 0; i < 4; i++ {
+		err := s.app.Client().PixelChange.Create().
+			SetBoard(board).
+			SetPosition(i).
+			SetOldColor("white").
+			SetNewColor("black").
+			SetSeq(int64(i + 1)).
+			SetCreatedAt(start.Add(time.Duration(i) * time.Minute)).
+			Exec(s.ctx)
+		s.NoError(err)
+	}
+
+	// Few frames, but every one of them is 8192x8192.
+	_, err = s.service.Timelapse(s.ctx, board.ID, TimelapseOptions{Interval: time.Minute, Scale: 16})
+	s.Error(err)
+	s.Equal("Timelapse is too large, use a longer interval or a smaller scale", framework.ExtErrorMessage(err))
+}
+
+func (s *BoardsSuite) TestResizeKeepsCoordinates() {
+	board, err := s.service.Create(s.ctx, s.user.ID, BoardSettings{Name: "event", Width: 3, Height: 2})
+	s.NoError(err)
+
+	// (2, 0), (0, 1) and (2, 1) on the 3x2 board.
+	for i, position := range []int{2, 3, 5} {
+		p, err := s.app.Client().Pixel.Create().
+			SetBoard(board).
+			SetPosition(position).
+			SetColor("black").
+			SetUserID(s.user.ID).
+			SetSeq(int64(i + 1)).
+			Save(s.ctx)
+		s.NoError(err)
+		err = s.app.Client().PixelChange.Create().
+			SetBoard(board).
+			SetPixel(p).
+			SetUser(s.user).
+			SetPosition(position).
+			SetOldColor("white").
+			SetNewColor("black").
+			SetSeq(int64(i + 1)).
+			Exec(s.ctx)
+		s.NoError(err)
+	}
+	s.NoError(board.Update().SetSeq(3).Exec(s.ctx))
+
+	resized, err := s.service.Resize(s.ctx, board.ID, 5, 3)
+	s.NoError(err)
+	s.Equal(5, resized.Width)
+	s.Equal(3, resized.Height)
+
+	// Clients learn about the resize from the event or when catching up.
+	s.Equal(int64(4), resized.Seq)
+	announced, err := s.app.Client().OutboxEvent.Query().Where(outboxevent.Name("board:resized")).Exist(s.ctx)
+	s.NoError(err)
+	s.True(announced)
+	_, missed, seq, err := NewPixels(s.app.App, Bridge{}).GetBoardSince(s.ctx, board.ID, 3)
+	s.NoError(err)
+	s.Empty(missed)
+	s.Equal(int64(4), seq)
+
+	positions, err := s.app.Client().Pixel.Query().
+		Where(pixel.BoardIDEQ(board.ID)).
+		Order(ent.Asc(pixel.FieldPosition)).
+		Select(pixel.FieldPosition).
+		Ints(s.ctx)
+	s.NoError(err)
+	s.Equal([]int{2, 5, 7}, positions)
+
+	history, err := s.app.Client().PixelChange.Query().
+		Where(pixelchange.BoardIDEQ(board.ID)).
+		Order(ent.Asc(pixelchange.FieldPosition)).
+		Select(pixelchange.FieldPosition).
+		Ints(s.ctx)
+	s.NoError(err)
+	s.Equal([]int{2, 5, 7}, history)
+
+	_, err = s.service.Resize(s.ctx, board.ID, 4, 3)
+	s.Equal("Boards can only grow", framework.ExtErrorMessage(err))
+
+	_, err = s.service.Resize(s.ctx, board.ID, 5, MaxBoardSize+1)
+	s.Equal("Board is too large", framework.ExtErrorMessage(err))
+}
+
+func (s *BoardsSuite) TestStats() {
+	board, err := s.service.Create(s.ctx, s.user.ID, BoardSettings{Name: "event", Width: 3, Height: 2})
+	s.NoError(err)
+	err = s.app.Client().PixelChange.Create().
+		SetBoard(board).
+		SetUser(s.user).
+		SetPosition(0).
+		SetOldColor("white").
+		SetNewColor("black").
+		SetSeq(1).
+		Exec(s.ctx)
+	s.NoError(err)
+
+	stats, err := NewStats(s.app.App).Collect(s.ctx)
+	s.NoError(err)
+	s.Equal(1, stats.Users)
+	s.Equal(1, stats.Boards)
+	s.Equal(1, stats.PaintsToday)
+	s.Equal(1, stats.PaintersToday)
+	s.Require().Len(stats.BoardStats, 1)
+	s.Equal(1, stats.BoardStats[0].Paints)
+}
+
+func (s *BoardsSuite) TestExportImport() {
+	board, err := s.service.Create(s.ctx, s.user.ID, BoardSettings{Name: "event", Width: 4, Height: 3, Palette: "classic"})
+	s.NoError(err)
+	for i, position := range []int{1, 6, 11} {
+		create := s.app.Client().Pixel.Create().
+			SetBoard(board).
+			SetPosition(position).
+			SetColor("black").
+			SetSeq(int64(i + 1))
+		if position != 6 {
+			create.SetUserID(s.user.ID)
+		}
+		s.NoError(create.Exec(s.ctx))
+	}
+
+	_, file, err := s.service.Export(s.ctx, board.ID)
+	s.NoError(err)
+	s.Equal(4, file.Width)
+	s.Equal("classic", file.Palette)
+	s.Equal([]boardfile.Pixel{
+		{Position: 1, Color: "black", Owner: s.user.ID},
+		{Position: 6, Color: "black"},
+		{Position: 11, Color: "black", Owner: s.user.ID},
+	}, file.Pixels)
+
+	file.Pixels = append(file.Pixels, boardfile.Pixel{Position: 0, Color: "black", Owner: 999})
+	imported, err := s.service.Import(s.ctx, 0, file, "copy")
+	s.NoError(err)
+	s.Equal("copy", imported.Name)
+	s.Equal(3, imported.Height)
+
+	pixels, err := imported.QueryPixels().Order(ent.Asc(pixel.FieldPosition)).All(s.ctx)
+	s.NoError(err)
+	s.Require().Len(pixels, 4)
+	s.Equal(int64(0), pixels[0].UserID)
+	s.Equal(s.user.ID, pixels[1].UserID)
+	s.Equal(int64(4), pixels[3].Seq)
+
+	changes, err := imported.QueryChanges().Where(pixelchange.UserIDIsNil()).Count(s.ctx)
+	s.NoError(err)
+	s.Equal(4, changes)
+
+	file.Pixels[0].Color = "not-a-color"
+	_, err = s.service.Import(s.ctx, 0, file, "broken")
+	s.Equal(400, framework.ExtErrorCode(err))
+
+	boards, err := s.app.Client().Board.Query().Count(s.ctx)
+	s.NoError(err)
+	file.Pixels[0].Color = "black"
+	file.Width = boardfile.MaxSize + 1
+	_, err = s.service.Import(s.ctx, 0, file, "huge")
+	s.Equal(400, framework.ExtErrorCode(err))
+	after, err := s.app.Client().Board.Query().Count(s.ctx)
+	s.NoError(err)
+	s.Equal(boards, after)
+}
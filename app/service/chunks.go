@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+
+	"nevissGo/ent"
+	"nevissGo/framework"
+	"nevissGo/pkg/palette"
+)
+
+// ChunkSize is the width and height of the tiles a board can be fetched in.
+// Chunks on the right and bottom edges are cut to the board.
+const ChunkSize = 64
+
+// Chunk is a tile of a board. Its pixels keep their board wide position as
+// their ID, so they are painted and bounds checked like any other pixel.
+type Chunk struct {
+	Board *ent.Board
+	// X and Y are the chunk coordinates, the pixel coordinates of its top left
+	// corner divided by ChunkSize.
+	X       int
+	Y       int
+	Width   int
+	Height  int
+	Palette palette.Palette
+	// Pixels are in row order within the chunk.
+	Pixels []*ent.Pixel
+	// Seq is the version of the chunk: the sequence number of its latest
+	// paint, so clients only fetch it again once it is higher.
+	Seq      int64
+	BoardSeq int64
+}
+
+// Indexed encodes the chunk like Board.Indexed.
+func (c *Chunk) Indexed() ([]string, []byte, error) {
+	return indexColors(c.Board.ID, c.Palette, c.Pixels)
+}
+
+// GetChunk returns a chunk of a board from the cache.
+func (s *Pixels) GetChunk(ctx context.Context, boardID int, chunkX, chunkY int) (*Chunk, error) {
+	b, err := findBoard(ctx, s.app.Client(), boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	x, y := chunkX*ChunkSize, chunkY*ChunkSize
+	if chunkX < 0 || chunkY < 0 || x >= b.Width || y >= b.Height {
+		return nil, framework.NewValidationError("Chunk is out of bounds")
+	}
+
+	chunk := &Chunk{
+		Board:   b,
+		X:       chunkX,
+		Y:       chunkY,
+		Width:   min(ChunkSize, b.Width-x),
+		Height:  min(ChunkSize, b.Height-y),
+		Palette: boardPalette(b),
+	}
+
+	chunk.Pixels, chunk.BoardSeq, err = s.readArea(ctx, b, x, y, chunk.Width, chunk.Height)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range chunk.Pixels {
+		chunk.Seq = max(chunk.Seq, p.Seq)
+	}
+
+	return chunk, nil
+}
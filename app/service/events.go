@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+	"nevissGo/ent"
+	"nevissGo/framework"
+)
+
+// The events services store in the outbox. The serializers register how they
+// are sent to clients.
+
+// PixelEvent announces a single painted pixel, sent as pixel:updated.
+type PixelEvent struct {
+	Pixel *ent.Pixel
+	Actor *ent.User
+}
+
+// PixelsEvent announces several changed pixels at once, sent as
+// pixels:updated. Actor is who changed them, nil for operator commands.
+type PixelsEvent struct {
+	Pixels []*ent.Pixel
+	Actor  *ent.User
+}
+
+// BoardResizedEvent announces a new board size, sent as board:resized.
+// Clients reload the board, as the positions of its pixels moved.
+type BoardResizedEvent struct {
+	Board *ent.Board
+}
+
+// TeamMemberEvent announces a user joining or leaving a team.
+type TeamMemberEvent struct {
+	TeamID int
+	User   *ent.User
+}
+
+// actor loads the user behind a moderation action, or nil for operator
+// commands, which have none.
+func actor(ctx context.Context, tx *ent.Tx, actorID int64) (*ent.User, error) {
+	if actorID == 0 {
+		return nil, nil
+	}
+
+	found, err := tx.User.Get(ctx, actorID)
+	if err != nil {
+		logrus.WithError(err).WithField("actor_id", actorID).Error("Failed to retrieve actor")
+		return nil, framework.NewInternalError("Failed to retrieve actor")
+	}
+	return found, nil
+}
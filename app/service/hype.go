@@ -4,65 +4,229 @@ import (
 	"context"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/sirupsen/logrus"
 	"nevissGo/ent"
+	"nevissGo/ent/hype"
+	"nevissGo/ent/hypeledger"
+	"nevissGo/ent/user"
 	"nevissGo/framework"
 )
 
+// HypeConfig holds the hype settings of users without custom limits.
+type HypeConfig struct {
+	MaxHype       int
+	HypePerMinute int
+}
+
+func DefaultHypeConfig() HypeConfig {
+	return HypeConfig{
+		MaxHype:       10,
+		HypePerMinute: 2,
+	}
+}
+
+const maxHypeAttempts = 5
+
 type Hype struct {
-	app            *framework.App
-	client         *ent.Client
-	defaultMaxHype int
+	app    *framework.App
+	client *ent.Client
+	config HypeConfig
 }
 
-func NewHype(app *framework.App) *Hype {
+func NewHype(app *framework.App, config HypeConfig) *Hype {
 	return &Hype{
-		app:            app,
-		client:         app.Client(),
-		defaultMaxHype: 10,
+		app:    app,
+		client: app.Client(),
+		config: config,
 	}
 }
 
+// UseHypeTX spends hype as part of the caller's transaction. Two concurrent
+// paints by the same user can not both spend the same hype: the row is read
+// locked and written with a compare-and-set on its version, see mutate.
 func (h *Hype) UseHypeTX(ctx context.Context, tx *ent.Tx, userID int64, amount int) error {
-	hype, err := h.fetchOrCreateHype(ctx, tx.Client(), userID)
-	if err != nil {
-		return err
+	_, err := h.mutate(ctx, tx.Client(), userID, func(row *ent.Hype) error {
+		if row.AmountRemaining < amount {
+			return framework.NewValidationError("not enough hype remaining")
+		}
+
+		row.AmountRemaining -= amount
+		row.LastUpdatedAt = time.Now()
+		return nil
+	})
+	return err
+}
+
+func (h *Hype) GetHype(ctx context.Context, userID int64) (*ent.Hype, error) {
+	return h.mutate(ctx, h.client, userID, nil)
+}
+
+// Adjust adds hype to a user, or takes it away with a negative amount, and
+// records the reason in the ledger. Grants may lift the user above their max
+// hype; refills just stop until they are back under it. actorID is zero when
+// the system makes the adjustment.
+func (h *Hype) Adjust(ctx context.Context, userID int64, kind hypeledger.Kind, amount int, reason string, actorID int64) (*ent.HypeLedger, error) {
+	if kind == hypeledger.KindLimits {
+		return nil, framework.NewValidationError("Use SetLimits to change hype limits")
+	}
+	if amount == 0 {
+		return nil, framework.NewValidationError("Amount must not be zero")
 	}
 
-	err = h.updateHypeAmount(ctx, tx.Client(), hype)
-	if err != nil {
+	var entry *ent.HypeLedger
+	err := h.app.TX(ctx, func(tx *ent.Tx) error {
+		adjusted, err := h.mutate(ctx, tx.Client(), userID, func(row *ent.Hype) error {
+			row.AmountRemaining = max(row.AmountRemaining+amount, 0)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		entry, err = h.record(ctx, tx, userID, kind, amount, adjusted.AmountRemaining, reason, actorID)
 		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"user_id":  userID,
+		"kind":     kind,
+		"amount":   amount,
+		"actor_id": actorID,
+	}).Info("Hype adjusted")
+	return entry, nil
+}
+
+// SetLimits overrides the max hype and refill rate of a user. Passing zero for
+// both resets the user to the configured defaults.
+func (h *Hype) SetLimits(ctx context.Context, userID int64, maxHype, hypePerMinute int, reason string, actorID int64) (*ent.Hype, error) {
+	if maxHype < 0 || hypePerMinute < 0 {
+		return nil, framework.NewValidationError("Hype limits must not be negative")
 	}
 
-	if hype.AmountRemaining < amount {
-		return framework.NewValidationError("not enough hype remaining")
+	custom := maxHype != 0 || hypePerMinute != 0
+	if !custom {
+		maxHype, hypePerMinute = h.config.MaxHype, h.config.HypePerMinute
+	}
+	if maxHype == 0 || hypePerMinute == 0 {
+		return nil, framework.NewValidationError("Both hype limits are required")
 	}
 
-	hype.AmountRemaining -= amount
-	hype.LastUpdatedAt = time.Now()
+	var updated *ent.Hype
+	err := h.app.TX(ctx, func(tx *ent.Tx) error {
+		var err error
+		updated, err = h.mutate(ctx, tx.Client(), userID, func(row *ent.Hype) error {
+			row.MaxHype = maxHype
+			row.HypePerMinute = hypePerMinute
+			row.CustomLimits = custom
+			return nil
+		})
+		if err != nil {
+			return err
+		}
 
-	_, err = tx.Hype.UpdateOne(hype).
-		SetAmountRemaining(hype.AmountRemaining).
-		SetLastUpdatedAt(hype.LastUpdatedAt).
-		Save(ctx)
+		_, err = h.record(ctx, tx, userID, hypeledger.KindLimits, 0, updated.AmountRemaining, reason, actorID)
+		return err
+	})
 	if err != nil {
-		return framework.NewInternalError("Failed to update hype")
+		return nil, err
 	}
 
-	return nil
+	return updated, nil
 }
 
-func (h *Hype) GetHype(ctx context.Context, userID int64) (*ent.Hype, error) {
-	hype, err := h.fetchOrCreateHype(ctx, h.client, userID)
+// Ledger returns the recorded hype adjustments of a user, newest first.
+func (h *Hype) Ledger(ctx context.Context, userID int64, offset, limit int) ([]*ent.HypeLedger, error) {
+	entries, err := h.client.HypeLedger.Query().
+		Where(hypeledger.HasUserWith(user.IDEQ(userID))).
+		Order(ent.Desc(hypeledger.FieldCreatedAt), ent.Desc(hypeledger.FieldID)).
+		Offset(offset).
+		Limit(limit).
+		WithActor().
+		All(ctx)
 	if err != nil {
-		return nil, err
+		logrus.WithError(err).WithField("user_id", userID).Error("Failed to retrieve hype ledger")
+		return nil, framework.NewInternalError("Failed to retrieve hype ledger")
 	}
 
-	err = h.updateHypeAmount(ctx, h.client, hype)
+	return entries, nil
+}
+
+func (h *Hype) record(ctx context.Context, tx *ent.Tx, userID int64, kind hypeledger.Kind, amount, balance int, reason string, actorID int64) (*ent.HypeLedger, error) {
+	create := tx.HypeLedger.Create().
+		SetUserID(userID).
+		SetKind(kind).
+		SetAmount(amount).
+		SetBalance(balance).
+		SetReason(reason)
+	if actorID != 0 {
+		create.SetActorID(actorID)
+	}
+
+	entry, err := create.Save(ctx)
 	if err != nil {
-		return nil, err
+		logrus.WithError(err).WithField("user_id", userID).Error("Failed to record hype adjustment")
+		return nil, framework.NewInternalError("Failed to record hype adjustment")
+	}
+	return entry, nil
+}
+
+// mutate loads the hype of a user, applies the configured limits and any
+// pending refill, lets fn change it and writes it back only if nobody else
+// wrote the row in the meantime. Inside a transaction the row is read locked,
+// so the write only loses a race outside of one; then it re-reads and tries
+// again, giving up with a conflict after maxHypeAttempts. Re-reading alone
+// would not help in a transaction: under MySQL's repeatable read every plain
+// read returns the same snapshot.
+func (h *Hype) mutate(ctx context.Context, client *ent.Client, userID int64, fn func(hype *ent.Hype) error) (*ent.Hype, error) {
+	for attempt := 0; attempt < maxHypeAttempts; attempt++ {
+		current, err := h.fetchOrCreateHype(ctx, client, userID)
+		if err != nil {
+			return nil, err
+		}
+
+		next := *current
+		h.applyConfig(&next)
+		regenerate(&next, time.Now())
+		if fn != nil {
+			if err := fn(&next); err != nil {
+				return nil, err
+			}
+		}
+
+		if sameHype(current, &next) {
+			return current, nil
+		}
+
+		n, err := client.Hype.Update().
+			Where(hype.IDEQ(current.ID), hype.VersionEQ(current.Version)).
+			SetAmountRemaining(next.AmountRemaining).
+			SetMaxHype(next.MaxHype).
+			SetHypePerMinute(next.HypePerMinute).
+			SetCustomLimits(next.CustomLimits).
+			SetLastUpdatedAt(next.LastUpdatedAt).
+			SetVersion(current.Version + 1).
+			Save(ctx)
+		if err != nil {
+			logrus.WithError(err).WithField("user_id", userID).Error("Failed to update hype")
+			return nil, framework.NewInternalError("Failed to update hype")
+		}
+		if n == 1 {
+			next.Version = current.Version + 1
+			return &next, nil
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"user_id": userID,
+			"attempt": attempt + 1,
+		}).Warn("Hype changed concurrently, retrying")
 	}
 
-	return hype, nil
+	return nil, framework.NewConflictError("Hype changed concurrently, please try again")
 }
 
 func (h *Hype) fetchOrCreateHype(ctx context.Context, client *ent.Client, userID int64) (*ent.Hype, error) {
@@ -74,15 +238,19 @@ func (h *Hype) fetchOrCreateHype(ctx context.Context, client *ent.Client, userID
 		return nil, framework.NewInternalError("Failed to get user")
 	}
 
-	hype, err := user.QueryHype().Only(ctx)
+	current, err := user.QueryHype().Where(forUpdate).Only(ctx)
 	if ent.IsNotFound(err) {
-		hype, err = client.Hype.Create().
+		current, err = client.Hype.Create().
 			SetUser(user).
-			SetAmountRemaining(h.defaultMaxHype).
-			SetMaxHype(h.defaultMaxHype).
-			SetHypePerMinute(2).
+			SetAmountRemaining(h.config.MaxHype).
+			SetMaxHype(h.config.MaxHype).
+			SetHypePerMinute(h.config.HypePerMinute).
 			SetLastUpdatedAt(time.Now()).
 			Save(ctx)
+		if ent.IsConstraintError(err) {
+			// A concurrent request created it first.
+			current, err = user.QueryHype().Where(forUpdate).Only(ctx)
+		}
 		if err != nil {
 			return nil, framework.NewInternalError("Failed to create hype for user")
 		}
@@ -90,28 +258,51 @@ func (h *Hype) fetchOrCreateHype(ctx context.Context, client *ent.Client, userID
 		return nil, framework.NewInternalError("Failed to query hype for user")
 	}
 
-	return hype, nil
+	return current, nil
+}
+
+// forUpdate makes a query lock the rows it reads until the transaction ends.
+// SQLite has no row locks. The server opens it with _txlock=immediate, see
+// sqliteDSN, so its transactions take the write lock when they begin; without
+// that, the version compare-and-set in mutate still keeps a concurrent write
+// from being lost.
+func forUpdate(s *sql.Selector) {
+	if s.Dialect() != dialect.SQLite {
+		s.ForUpdate()
+	}
+}
+
+// applyConfig moves users without custom limits onto the configured defaults,
+// so changing the config takes effect without a migration.
+func (h *Hype) applyConfig(row *ent.Hype) {
+	if row.CustomLimits {
+		return
+	}
+	row.MaxHype = h.config.MaxHype
+	row.HypePerMinute = h.config.HypePerMinute
 }
 
-func (h *Hype) updateHypeAmount(ctx context.Context, client *ent.Client, hype *ent.Hype) error {
-	timeSinceUpdate := time.Since(hype.LastUpdatedAt)
-	hypePerSecond := float64(hype.HypePerMinute) / 60.0
+// regenerate adds the hype refilled since the last update.
+func regenerate(row *ent.Hype, now time.Time) {
+	timeSinceUpdate := now.Sub(row.LastUpdatedAt)
+	hypePerSecond := float64(row.HypePerMinute) / 60.0
 	secondsPassed := timeSinceUpdate.Seconds()
 	replenished := int(secondsPassed * hypePerSecond)
 	if replenished > 0 {
-		newAmount := hype.AmountRemaining + replenished
-		if newAmount > hype.MaxHype {
-			newAmount = hype.MaxHype
-		}
-		hype.AmountRemaining = newAmount
-		hype.LastUpdatedAt = hype.LastUpdatedAt.Add(time.Duration(float64(time.Second) * float64(replenished) / hypePerSecond))
-		_, err := client.Hype.UpdateOne(hype).
-			SetAmountRemaining(hype.AmountRemaining).
-			SetLastUpdatedAt(hype.LastUpdatedAt).
-			Save(ctx)
-		if err != nil {
-			return framework.NewInternalError("Failed to update hype amount")
+		newAmount := row.AmountRemaining + replenished
+		if newAmount > row.MaxHype {
+			// Refills stop at max hype but never take granted hype away.
+			newAmount = max(row.MaxHype, row.AmountRemaining)
 		}
+		row.AmountRemaining = newAmount
+		row.LastUpdatedAt = row.LastUpdatedAt.Add(time.Duration(float64(time.Second) * float64(replenished) / hypePerSecond))
 	}
-	return nil
+}
+
+func sameHype(a, b *ent.Hype) bool {
+	return a.AmountRemaining == b.AmountRemaining &&
+		a.MaxHype == b.MaxHype &&
+		a.HypePerMinute == b.HypePerMinute &&
+		a.CustomLimits == b.CustomLimits &&
+		a.LastUpdatedAt.Equal(b.LastUpdatedAt)
 }
@@ -4,11 +4,16 @@ import (
 	"context"
 	"nevissGo/ent"
 	hype2 "nevissGo/ent/hype"
+	"nevissGo/ent/hypeledger"
 	"nevissGo/ent/user"
 	"nevissGo/framework"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -29,7 +34,7 @@ func TestHypeSuite(t *testing.T) {
 func (s *HypeSuite) SetupTest() {
 	s.app = framework.NewTestingApp(s.T())
 	s.bridge = TestBridge(s.T())
-	s.service = NewHype(s.app.App)
+	s.service = NewHype(s.app.App, DefaultHypeConfig())
 	s.ctx = context.Background()
 
 	var err error
@@ -125,3 +130,179 @@ func (s *HypeSuite) TestUseHype_NotEnoughHype() {
 	s.NoError(err)
 	s.Equal(20, hype.AmountRemaining)
 }
+
+func (s *HypeSuite) TestConfigAppliesToDefaultUsers() {
+	hype, err := s.service.GetHype(s.ctx, s.user.ID)
+	s.NoError(err)
+	s.Equal(10, hype.MaxHype)
+
+	promo := NewHype(s.app.App, HypeConfig{MaxHype: 25, HypePerMinute: 6})
+	hype, err = promo.GetHype(s.ctx, s.user.ID)
+	s.NoError(err)
+	s.Equal(25, hype.MaxHype)
+	s.Equal(6, hype.HypePerMinute)
+}
+
+func (s *HypeSuite) TestSetLimitsOverridesConfig() {
+	hype, err := s.service.SetLimits(s.ctx, s.user.ID, 40, 4, "supporter", 0)
+	s.NoError(err)
+	s.True(hype.CustomLimits)
+	s.Equal(40, hype.MaxHype)
+
+	promo := NewHype(s.app.App, HypeConfig{MaxHype: 25, HypePerMinute: 6})
+	hype, err = promo.GetHype(s.ctx, s.user.ID)
+	s.NoError(err)
+	s.Equal(40, hype.MaxHype)
+	s.Equal(4, hype.HypePerMinute)
+
+	hype, err = promo.SetLimits(s.ctx, s.user.ID, 0, 0, "supporter expired", 0)
+	s.NoError(err)
+	s.False(hype.CustomLimits)
+	s.Equal(25, hype.MaxHype)
+
+	_, err = s.service.SetLimits(s.ctx, s.user.ID, 40, 0, "half", 0)
+	s.Equal(400, framework.ExtErrorCode(err))
+}
+
+func (s *HypeSuite) TestAdjustAboveMaxSurvivesRefill() {
+	admin, err := s.app.Client().User.Create().
+		SetDisplayName("Admin").
+		SetGameID("admin").
+		Save(s.ctx)
+	s.NoError(err)
+
+	entry, err := s.service.Adjust(s.ctx, s.user.ID, hypeledger.KindRefund, 5, "incident 42", admin.ID)
+	s.NoError(err)
+	s.Equal(15, entry.Balance)
+
+	_, err = s.app.Client().Hype.Update().
+		SetLastUpdatedAt(time.Now().Add(-time.Hour)).
+		Save(s.ctx)
+	s.NoError(err)
+
+	hype, err := s.service.GetHype(s.ctx, s.user.ID)
+	s.NoError(err)
+	s.Equal(15, hype.AmountRemaining)
+
+	entry, err = s.service.Adjust(s.ctx, s.user.ID, hypeledger.KindGrant, -100, "abuse", admin.ID)
+	s.NoError(err)
+	s.Equal(0, entry.Balance)
+
+	_, err = s.service.Adjust(s.ctx, s.user.ID, hypeledger.KindLimits, 1, "nope", 0)
+	s.Equal(400, framework.ExtErrorCode(err))
+}
+
+func (s *HypeSuite) TestLedger() {
+	_, err := s.service.Adjust(s.ctx, s.user.ID, hypeledger.KindBonus, 3, "weekend event", 0)
+	s.NoError(err)
+	_, err = s.service.SetLimits(s.ctx, s.user.ID, 20, 2, "supporter", 0)
+	s.NoError(err)
+
+	entries, err := s.service.Ledger(s.ctx, s.user.ID, 0, 10)
+	s.NoError(err)
+	s.Require().Len(entries, 2)
+	s.Equal(hypeledger.KindLimits, entries[0].Kind)
+	s.Equal(hypeledger.KindBonus, entries[1].Kind)
+	s.Equal("weekend event", entries[1].Reason)
+	s.Nil(entries[1].Edges.Actor)
+
+	entries, err = s.service.Ledger(s.ctx, s.user.ID, 1, 10)
+	s.NoError(err)
+	s.Len(entries, 1)
+}
+
+// The tests run on SQLite with deferred transactions, unlike the server, so
+// concurrent transactions here either fail on SQLite's locks or lose the
+// version compare-and-set in mutate and retry. This does not exercise the row
+// lock MySQL and PostgreSQL rely on; TestForUpdate checks that they get one.
+func (s *HypeSuite) TestUseHype_Concurrent() {
+	_, err := s.service.GetHype(s.ctx, s.user.ID)
+	s.NoError(err)
+
+	const workers = 30
+	var (
+		wg        sync.WaitGroup
+		succeeded atomic.Int32
+	)
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := s.app.TX(s.ctx, func(tx *ent.Tx) error {
+				return s.service.UseHypeTX(s.ctx, tx, s.user.ID, 1)
+			})
+			if err == nil {
+				succeeded.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	hype, err := s.app.Client().Hype.
+		Query().
+		Where(hype2.HasUserWith(user.IDEQ(s.user.ID))).
+		Only(s.ctx)
+	s.NoError(err)
+	s.GreaterOrEqual(hype.AmountRemaining, 0)
+	s.LessOrEqual(int(succeeded.Load()), 10)
+	s.Equal(10-int(succeeded.Load()), hype.AmountRemaining)
+}
+
+func (s *HypeSuite) TestUseHype_RetriesAfterConcurrentWrite() {
+	_, err := s.service.GetHype(s.ctx, s.user.ID)
+	s.NoError(err)
+
+	calls := 0
+	_, err = s.service.mutate(s.ctx, s.app.Client(), s.user.ID, func(row *ent.Hype) error {
+		calls++
+		if calls == 1 {
+			// Another paint spends 7 hype between our read and our write.
+			s.NoError(s.app.Client().Hype.Update().
+				SetAmountRemaining(3).
+				AddVersion(1).
+				Exec(s.ctx))
+		}
+		if row.AmountRemaining < 5 {
+			return framework.NewValidationError("not enough hype remaining")
+		}
+		row.AmountRemaining -= 5
+		return nil
+	})
+	s.Error(err)
+	s.Equal(400, framework.ExtErrorCode(err))
+	s.Equal(2, calls)
+
+	hype, err := s.service.GetHype(s.ctx, s.user.ID)
+	s.NoError(err)
+	s.Equal(3, hype.AmountRemaining)
+}
+
+func (s *HypeSuite) TestUseHype_GivesUpWithConflict() {
+	_, err := s.service.GetHype(s.ctx, s.user.ID)
+	s.NoError(err)
+
+	calls := 0
+	_, err = s.service.mutate(s.ctx, s.app.Client(), s.user.ID, func(row *ent.Hype) error {
+		calls++
+		s.NoError(s.app.Client().Hype.Update().AddVersion(1).Exec(s.ctx))
+		row.AmountRemaining--
+		return nil
+	})
+	s.Error(err)
+	s.Equal(409, framework.ExtErrorCode(err))
+	s.Equal(maxHypeAttempts, calls)
+}
+
+func (s *HypeSuite) TestForUpdate() {
+	for _, d := range []string{dialect.MySQL, dialect.Postgres} {
+		selector := sql.Dialect(d).Select("*").From(sql.Table("hypes"))
+		forUpdate(selector)
+		query, _ := selector.Query()
+		s.Contains(query, "FOR UPDATE", d)
+	}
+
+	selector := sql.Dialect(dialect.SQLite).Select("*").From(sql.Table("hypes"))
+	forUpdate(selector)
+	query, _ := selector.Query()
+	s.NotContains(query, "FOR UPDATE")
+}
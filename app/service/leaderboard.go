@@ -0,0 +1,348 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"nevissGo/ent"
+	"nevissGo/ent/pixel"
+	"nevissGo/ent/pixelchange"
+	"nevissGo/ent/team"
+	"nevissGo/ent/user"
+	"nevissGo/framework"
+)
+
+type LeaderboardMetric string
+
+const (
+	// MetricOwned ranks users by the pixels they currently own.
+	MetricOwned LeaderboardMetric = "owned"
+	// MetricPaints ranks users by how many times they painted in the window.
+	MetricPaints LeaderboardMetric = "paints"
+)
+
+type LeaderboardWindow string
+
+const (
+	WindowDay  LeaderboardWindow = "day"
+	WindowWeek LeaderboardWindow = "week"
+	WindowAll  LeaderboardWindow = "all"
+)
+
+// Since returns the start of the window, or the zero time for all-time.
+func (w LeaderboardWindow) Since(now time.Time) time.Time {
+	switch w {
+	case WindowDay:
+		return now.Add(-24 * time.Hour)
+	case WindowWeek:
+		return now.Add(-7 * 24 * time.Hour)
+	default:
+		return time.Time{}
+	}
+}
+
+type LeaderboardEntry struct {
+	Rank  int
+	User  *ent.User
+	Score int
+}
+
+type LeaderboardResult struct {
+	Board   *ent.Board
+	Metric  LeaderboardMetric
+	Window  LeaderboardWindow
+	Entries []*LeaderboardEntry
+	// Me is the rank of the requesting user, nil when they have no score.
+	Me *LeaderboardEntry
+}
+
+type Leaderboard struct {
+	app *framework.App
+
+	mu    sync.Mutex
+	dirty map[int]struct{}
+}
+
+func NewLeaderboard(app *framework.App) *Leaderboard {
+	return &Leaderboard{
+		app:   app,
+		dirty: map[int]struct{}{},
+	}
+}
+
+// Top ranks the painters of a board and includes the rank of the given user.
+// The window only applies to paints; owned pixels are always the current state.
+func (s *Leaderboard) Top(ctx context.Context, boardID int, metric LeaderboardMetric, window LeaderboardWindow, limit int, userID int64) (*LeaderboardResult, error) {
+	b, err := findBoard(ctx, s.app.Client(), boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	var scores map[int64]int
+	switch metric {
+	case MetricOwned:
+		scores, err = s.ownedScores(ctx, b)
+	case MetricPaints:
+		scores, err = s.paintScores(ctx, b, window.Since(time.Now()))
+	default:
+		return nil, framework.NewValidationError("Unknown leaderboard metric")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ids := sortByScore(scores)
+	result := &LeaderboardResult{
+		Board:  b,
+		Metric: metric,
+		Window: window,
+	}
+
+	top := ids
+	if limit > 0 && len(top) > limit {
+		top = top[:limit]
+	}
+	result.Entries, err = s.entries(ctx, scores, top, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, id := range ids {
+		if id != userID {
+			continue
+		}
+		me, err := s.entries(ctx, scores, []int64{id}, i+1)
+		if err != nil {
+			return nil, err
+		}
+		result.Me = me[0]
+		break
+	}
+
+	return result, nil
+}
+
+// TopByOwnedPixels ranks the painters of a board by how many of its pixels
+// they currently own.
+func (s *Leaderboard) TopByOwnedPixels(ctx context.Context, boardID int, limit int) (*ent.Board, []*LeaderboardEntry, error) {
+	result, err := s.Top(ctx, boardID, MetricOwned, WindowAll, limit, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+	return result.Board, result.Entries, nil
+}
+
+func (s *Leaderboard) ownedScores(ctx context.Context, b *ent.Board) (map[int64]int, error) {
+	var counts []struct {
+		// The column keeps its legacy edge name, see schema.Pixel.
+		UserID int64 `json:"user_pixels"`
+		Count  int   `json:"count"`
+	}
+	err := s.app.Client().Pixel.Query().
+		Where(pixel.BoardIDEQ(b.ID), pixel.UserIDNotNil()).
+		GroupBy(pixel.FieldUserID).
+		Aggregate(ent.Count()).
+		Scan(ctx, &counts)
+	if err != nil {
+		logrus.WithError(err).WithField("board_id", b.ID).Error("Failed to count owned pixels")
+		return nil, framework.NewInternalError("Failed to count owned pixels")
+	}
+
+	scores := make(map[int64]int, len(counts))
+	for _, c := range counts {
+		scores[c.UserID] = c.Count
+	}
+	return scores, nil
+}
+
+func (s *Leaderboard) paintScores(ctx context.Context, b *ent.Board, since time.Time) (map[int64]int, error) {
+	query := s.app.Client().PixelChange.Query().
+		Where(pixelchange.BoardIDEQ(b.ID), pixelchange.UserIDNotNil())
+	if !since.IsZero() {
+		query = query.Where(pixelchange.CreatedAtGTE(since))
+	}
+
+	var counts []struct {
+		// The column keeps its legacy edge name, see schema.PixelChange.
+		UserID int64 `json:"user_changes"`
+		Count  int   `json:"count"`
+	}
+	err := query.
+		GroupBy(pixelchange.FieldUserID).
+		Aggregate(ent.Count()).
+		Scan(ctx, &counts)
+	if err != nil {
+		logrus.WithError(err).WithField("board_id", b.ID).Error("Failed to count paints")
+		return nil, framework.NewInternalError("Failed to count paints")
+	}
+
+	scores := make(map[int64]int, len(counts))
+	for _, c := range counts {
+		scores[c.UserID] = c.Count
+	}
+	return scores, nil
+}
+
+// sortByScore orders users or teams by score, breaking ties by ID so ranks
+// are stable.
+func sortByScore[K cmp.Ordered](scores map[K]int) []K {
+	ids := make([]K, 0, len(scores))
+	for id := range scores {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		if scores[ids[i]] != scores[ids[j]] {
+			return scores[ids[i]] > scores[ids[j]]
+		}
+		return ids[i] < ids[j]
+	})
+	return ids
+}
+
+// entries loads the users of the ranked IDs, numbering them from firstRank.
+func (s *Leaderboard) entries(ctx context.Context, scores map[int64]int, ids []int64, firstRank int) ([]*LeaderboardEntry, error) {
+	users, err := s.app.Client().User.Query().
+		Where(user.IDIn(ids...)).
+		All(ctx)
+	if err != nil {
+		logrus.WithError(err).Error("Failed to retrieve leaderboard users")
+		return nil, framework.NewInternalError("Failed to retrieve leaderboard users")
+	}
+
+	byID := make(map[int64]*ent.User, len(users))
+	for _, u := range users {
+		byID[u.ID] = u
+	}
+
+	entries := make([]*LeaderboardEntry, len(ids))
+	for i, id := range ids {
+		entries[i] = &LeaderboardEntry{
+			Rank:  firstRank + i,
+			User:  byID[id],
+			Score: scores[id],
+		}
+	}
+
+	return entries, nil
+}
+
+// OwnedPixels returns how many pixels the user currently owns across all
+// boards.
+func (s *Leaderboard) OwnedPixels(ctx context.Context, userID int64) (int, error) {
+	count, err := s.app.Client().Pixel.Query().
+		Where(pixel.UserIDEQ(userID)).
+		Count(ctx)
+	if err != nil {
+		logrus.WithError(err).WithField("user_id", userID).Error("Failed to count owned pixels")
+		return 0, framework.NewInternalError("Failed to count owned pixels")
+	}
+	return count, nil
+}
+
+// TotalPaints returns how many times the user has painted across all boards.
+func (s *Leaderboard) TotalPaints(ctx context.Context, userID int64) (int, error) {
+	count, err := s.app.Client().PixelChange.Query().
+		Where(pixelchange.UserIDEQ(userID)).
+		Count(ctx)
+	if err != nil {
+		logrus.WithError(err).WithField("user_id", userID).Error("Failed to count paints")
+		return 0, framework.NewInternalError("Failed to count paints")
+	}
+	return count, nil
+}
+
+type TeamLeaderboardEntry struct {
+	Rank  int
+	Team  *ent.Team
+	Score int
+}
+
+type TeamLeaderboardResult struct {
+	Board   *ent.Board
+	Metric  LeaderboardMetric
+	Window  LeaderboardWindow
+	Entries []*TeamLeaderboardEntry
+	// Mine is the rank of the requesting user's team, nil when it has no score.
+	Mine *TeamLeaderboardEntry
+}
+
+// TopTeams ranks the teams of a board by the territory they hold or by how
+// much their members painted in the window.
+func (s *Leaderboard) TopTeams(ctx context.Context, boardID int, metric LeaderboardMetric, window LeaderboardWindow, limit int, teamID int) (*TeamLeaderboardResult, error) {
+	b, err := findBoard(ctx, s.app.Client(), boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	var counts []struct {
+		TeamID int `json:"team_id"`
+		Count  int `json:"count"`
+	}
+	switch metric {
+	case MetricOwned:
+		err = s.app.Client().Pixel.Query().
+			Where(pixel.BoardIDEQ(b.ID), pixel.TeamIDNotNil()).
+			GroupBy(pixel.FieldTeamID).
+			Aggregate(ent.Count()).
+			Scan(ctx, &counts)
+	case MetricPaints:
+		query := s.app.Client().PixelChange.Query().
+			Where(pixelchange.BoardIDEQ(b.ID), pixelchange.TeamIDNotNil())
+		if since := window.Since(time.Now()); !since.IsZero() {
+			query = query.Where(pixelchange.CreatedAtGTE(since))
+		}
+		err = query.
+			GroupBy(pixelchange.FieldTeamID).
+			Aggregate(ent.Count()).
+			Scan(ctx, &counts)
+	default:
+		return nil, framework.NewValidationError("Unknown leaderboard metric")
+	}
+	if err != nil {
+		logrus.WithError(err).WithField("board_id", b.ID).Error("Failed to count team scores")
+		return nil, framework.NewInternalError("Failed to count team scores")
+	}
+
+	scores := make(map[int]int, len(counts))
+	for _, c := range counts {
+		scores[c.TeamID] = c.Count
+	}
+	ids := sortByScore(scores)
+
+	teams, err := s.app.Client().Team.Query().
+		Where(team.IDIn(ids...)).
+		All(ctx)
+	if err != nil {
+		logrus.WithError(err).Error("Failed to retrieve leaderboard teams")
+		return nil, framework.NewInternalError("Failed to retrieve leaderboard teams")
+	}
+	byID := make(map[int]*ent.Team, len(teams))
+	for _, t := range teams {
+		byID[t.ID] = t
+	}
+
+	result := &TeamLeaderboardResult{
+		Board:  b,
+		Metric: metric,
+		Window: window,
+	}
+	for i, id := range ids {
+		entry := &TeamLeaderboardEntry{
+			Rank:  i + 1,
+			Team:  byID[id],
+			Score: scores[id],
+		}
+		if limit <= 0 || i < limit {
+			result.Entries = append(result.Entries, entry)
+		}
+		if id == teamID {
+			result.Mine = entry
+		}
+	}
+
+	return result, nil
+}
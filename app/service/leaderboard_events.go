@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const leaderboardEventLimit = 10
+
+// LeaderboardUpdate is what gets broadcast as leaderboard:updated.
+type LeaderboardUpdate struct {
+	Owned  *LeaderboardResult
+	Paints *LeaderboardResult
+}
+
+// MarkDirty records that a board's leaderboard may have changed. It never
+// blocks; the next tick of Run picks the board up.
+func (s *Leaderboard) MarkDirty(boardID int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.dirty[boardID] = struct{}{}
+}
+
+func (s *Leaderboard) takeDirty() []int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	boards := make([]int, 0, len(s.dirty))
+	for id := range s.dirty {
+		boards = append(boards, id)
+	}
+	clear(s.dirty)
+	return boards
+}
+
+// Run broadcasts the leaderboards of boards marked dirty at most once per
+// interval, so a burst of paints turns into a single event per board.
+func (s *Leaderboard) Run(ctx context.Context, interval time.Duration, publish func(ctx context.Context, update *LeaderboardUpdate)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		for _, boardID := range s.takeDirty() {
+			update, err := s.Update(ctx, boardID)
+			if err != nil {
+				logrus.WithError(err).WithField("board_id", boardID).Error("Failed to compute leaderboard update")
+				continue
+			}
+			publish(ctx, update)
+		}
+	}
+}
+
+// Update computes the all-time leaderboards of a board.
+func (s *Leaderboard) Update(ctx context.Context, boardID int) (*LeaderboardUpdate, error) {
+	owned, err := s.Top(ctx, boardID, MetricOwned, WindowAll, leaderboardEventLimit, 0)
+	if err != nil {
+		return nil, err
+	}
+	paints, err := s.Top(ctx, boardID, MetricPaints, WindowAll, leaderboardEventLimit, 0)
+	if err != nil {
+		return nil, err
+	}
+	return &LeaderboardUpdate{
+		Owned:  owned,
+		Paints: paints,
+	}, nil
+}
@@ -0,0 +1,171 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"nevissGo/ent"
+	"nevissGo/framework"
+)
+
+type LeaderboardSuite struct {
+	suite.Suite
+	app     *framework.TestingApp
+	service *Leaderboard
+	ctx     context.Context
+	board   *ent.Board
+	alice   *ent.User
+	bob     *ent.User
+}
+
+func TestLeaderboardSuite(t *testing.T) {
+	suite.Run(t, new(LeaderboardSuite))
+}
+
+func (s *LeaderboardSuite) SetupTest() {
+	s.app = framework.NewTestingApp(s.T())
+	s.service = NewLeaderboard(s.app.App)
+	s.ctx = context.Background()
+
+	var err error
+	s.alice, err = s.app.Client().User.Create().
+		SetDisplayName("Alice").
+		SetGameID("alice").
+		Save(s.ctx)
+	s.NoError(err)
+	s.bob, err = s.app.Client().User.Create().
+		SetDisplayName("Bob").
+		SetGameID("bob").
+		Save(s.ctx)
+	s.NoError(err)
+
+	s.board, err = NewBoards(s.app.App).Create(s.ctx, s.alice.ID, BoardSettings{Name: "main", Width: 4, Height: 4})
+	s.NoError(err)
+}
+
+func (s *LeaderboardSuite) paint(position int, u *ent.User) {
+	_, err := s.app.Client().Pixel.Create().
+		SetBoard(s.board).
+		SetPosition(position).
+		SetColor("red-dark").
+		SetUser(u).
+		SetSeq(int64(position + 1)).
+		Save(s.ctx)
+	s.NoError(err)
+}
+
+func (s *LeaderboardSuite) TestTopByOwnedPixels() {
+	s.paint(0, s.bob)
+	s.paint(1, s.bob)
+	s.paint(2, s.alice)
+
+	board, entries, err := s.service.TopByOwnedPixels(s.ctx, 0, 10)
+	s.NoError(err)
+	s.Equal(s.board.ID, board.ID)
+	s.Len(entries, 2)
+	s.Equal(1, entries[0].Rank)
+	s.Equal(s.bob.ID, entries[0].User.ID)
+	s.Equal(2, entries[0].Score)
+	s.Equal(s.alice.ID, entries[1].User.ID)
+	s.Equal(1, entries[1].Score)
+
+	_, entries, err = s.service.TopByOwnedPixels(s.ctx, 0, 1)
+	s.NoError(err)
+	s.Len(entries, 1)
+}
+
+func (s *LeaderboardSuite) TestOwnedPixels() {
+	s.paint(0, s.bob)
+	s.paint(1, s.bob)
+
+	count, err := s.service.OwnedPixels(s.ctx, s.bob.ID)
+	s.NoError(err)
+	s.Equal(2, count)
+
+	count, err = s.service.OwnedPixels(s.ctx, s.alice.ID)
+	s.NoError(err)
+	s.Equal(0, count)
+}
+
+func (s *LeaderboardSuite) change(position int, u *ent.User, at time.Time) {
+	err := s.app.Client().PixelChange.Create().
+		SetBoard(s.board).
+		SetPosition(position).
+		SetOldColor("white").
+		SetSeq(int64(position + 1)).
+		SetNewColor("red-dark").
+		SetUser(u).
+		SetCreatedAt(at).
+		Exec(s.ctx)
+	s.NoError(err)
+}
+
+func (s *LeaderboardSuite) TestTopByPaintsWindow() {
+	now := time.Now()
+	s.change(0, s.alice, now.Add(-10*24*time.Hour))
+	s.change(1, s.alice, now.Add(-10*24*time.Hour))
+	s.change(2, s.alice, now.Add(-3*24*time.Hour))
+	s.change(3, s.bob, now.Add(-time.Hour))
+	s.change(4, s.bob, now.Add(-time.Hour))
+
+	all, err := s.service.Top(s.ctx, s.board.ID, MetricPaints, WindowAll, 10, s.bob.ID)
+	s.NoError(err)
+	s.Len(all.Entries, 2)
+	s.Equal(s.alice.ID, all.Entries[0].User.ID)
+	s.Equal(3, all.Entries[0].Score)
+	s.Equal(2, all.Me.Rank)
+
+	week, err := s.service.Top(s.ctx, s.board.ID, MetricPaints, WindowWeek, 10, s.bob.ID)
+	s.NoError(err)
+	s.Equal(s.bob.ID, week.Entries[0].User.ID)
+	s.Equal(1, week.Entries[1].Score)
+	s.Equal(1, week.Me.Rank)
+
+	day, err := s.service.Top(s.ctx, s.board.ID, MetricPaints, WindowDay, 10, s.alice.ID)
+	s.NoError(err)
+	s.Len(day.Entries, 1)
+	s.Nil(day.Me)
+}
+
+func (s *LeaderboardSuite) TestMeOutsideTop() {
+	s.paint(0, s.bob)
+	s.paint(1, s.bob)
+	s.paint(2, s.alice)
+
+	result, err := s.service.Top(s.ctx, 0, MetricOwned, WindowAll, 1, s.alice.ID)
+	s.NoError(err)
+	s.Len(result.Entries, 1)
+	s.Equal(s.alice.ID, result.Me.User.ID)
+	s.Equal(2, result.Me.Rank)
+	s.Equal(1, result.Me.Score)
+}
+
+func (s *LeaderboardSuite) TestRunPublishesDirtyBoardsOnce() {
+	s.paint(0, s.bob)
+	s.service.MarkDirty(s.board.ID)
+	s.service.MarkDirty(s.board.ID)
+
+	ctx, cancel := context.WithCancel(s.ctx)
+	defer cancel()
+
+	updates := make(chan *LeaderboardUpdate, 10)
+	go s.service.Run(ctx, 10*time.Millisecond, func(_ context.Context, update *LeaderboardUpdate) {
+		updates <- update
+	})
+
+	select {
+	case update := <-updates:
+		s.Equal(s.board.ID, update.Owned.Board.ID)
+		s.Equal(s.bob.ID, update.Owned.Entries[0].User.ID)
+	case <-time.After(time.Second):
+		s.Fail("leaderboard update was not published")
+	}
+
+	select {
+	case <-updates:
+		s.Fail("clean board was published again")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
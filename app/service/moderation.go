@@ -0,0 +1,355 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"nevissGo/ent"
+	"nevissGo/ent/pixel"
+	"nevissGo/ent/pixelchange"
+	"nevissGo/ent/user"
+	"nevissGo/framework"
+)
+
+type Moderation struct {
+	app *framework.App
+}
+
+func NewModeration(app *framework.App) *Moderation {
+	return &Moderation{
+		app: app,
+	}
+}
+
+// Ban keeps a user out of every action until they are unbanned. Their pixels
+// stay on the board; use Rollback to remove them.
+func (s *Moderation) Ban(ctx context.Context, userID int64, reason string, actorID int64) (*ent.User, error) {
+	if userID == actorID {
+		return nil, framework.NewValidationError("You can not ban yourself")
+	}
+
+	target, err := s.getUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if target.Role == user.RoleAdmin {
+		return nil, framework.NewForbiddenError("Admins can not be banned")
+	}
+
+	banned, err := target.Update().
+		SetBannedAt(time.Now()).
+		SetBanReason(reason).
+		Save(ctx)
+	if err != nil {
+		logrus.WithError(err).WithField("user_id", userID).Error("Failed to ban user")
+		return nil, framework.NewInternalError("Failed to ban user")
+	}
+	s.app.ChannelsChanged(ctx, userID)
+
+	logrus.WithFields(logrus.Fields{
+		"user_id":  userID,
+		"actor_id": actorID,
+		"reason":   reason,
+	}).Info("User banned")
+	return banned, nil
+}
+
+func (s *Moderation) Unban(ctx context.Context, userID int64, actorID int64) (*ent.User, error) {
+	target, err := s.getUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	unbanned, err := target.Update().
+		ClearBannedAt().
+		ClearBanReason().
+		Save(ctx)
+	if err != nil {
+		logrus.WithError(err).WithField("user_id", userID).Error("Failed to unban user")
+		return nil, framework.NewInternalError("Failed to unban user")
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"user_id":  userID,
+		"actor_id": actorID,
+	}).Info("User unbanned")
+	return unbanned, nil
+}
+
+func (s *Moderation) SetRole(ctx context.Context, userID int64, role user.Role, actorID int64) (*ent.User, error) {
+	if err := user.RoleValidator(role); err != nil {
+		return nil, framework.NewValidationError("Unknown role")
+	}
+	if userID == actorID && role != user.RoleAdmin {
+		return nil, framework.NewValidationError("You can not take your own admin role")
+	}
+
+	target, err := s.getUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := target.Update().SetRole(role).Save(ctx)
+	if err != nil {
+		logrus.WithError(err).WithField("user_id", userID).Error("Failed to set user role")
+		return nil, framework.NewInternalError("Failed to set user role")
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"user_id":  userID,
+		"role":     role,
+		"actor_id": actorID,
+	}).Info("User role changed")
+	return updated, nil
+}
+
+// Rollback undoes the paints a user made on a board since the given time.
+// A pixel is only restored while the user's paints are still the latest ones
+// on it, so later paints by others are kept. The pixel goes back to the color
+// and owner it had before the user's first paint in the window.
+func (s *Moderation) Rollback(ctx context.Context, boardID int, userID int64, since time.Time, actorID int64) (*ent.Board, []*ent.Pixel, error) {
+	var board *ent.Board
+	var restored []*ent.Pixel
+	err := s.app.TX(ctx, func(tx *ent.Tx) error {
+		var err error
+		board, err = findBoard(ctx, tx.Client(), boardID)
+		if err != nil {
+			return err
+		}
+
+		var positions []int
+		err = tx.PixelChange.Query().
+			Where(
+				pixelchange.BoardIDEQ(board.ID),
+				pixelchange.UserIDEQ(userID),
+				pixelchange.CreatedAtGTE(since),
+			).
+			Unique(true).
+			Select(pixelchange.FieldPosition).
+			Scan(ctx, &positions)
+		if err != nil {
+			logrus.WithError(err).WithField("user_id", userID).Error("Failed to retrieve paints to roll back")
+			return framework.NewInternalError("Failed to retrieve paints to roll back")
+		}
+
+		type restoration struct {
+			existing *ent.Pixel
+			color    string
+			previous *ent.PixelChange
+		}
+		var restorations []restoration
+		for _, position := range positions {
+			history, err := tx.PixelChange.Query().
+				Where(pixelchange.BoardIDEQ(board.ID), pixelchange.PositionEQ(position)).
+				Order(ent.Desc(pixelchange.FieldSeq)).
+				All(ctx)
+			if err != nil {
+				logrus.WithError(err).WithField("pixel_id", position).Error("Failed to retrieve pixel history")
+				return framework.NewInternalError("Failed to retrieve pixel history")
+			}
+
+			undone := 0
+			for undone < len(history) && history[undone].UserID == userID && !history[undone].CreatedAt.Before(since) {
+				undone++
+			}
+			if undone == 0 {
+				continue
+			}
+
+			var previous *ent.PixelChange
+			if undone < len(history) {
+				previous = history[undone]
+			}
+
+			existing, err := tx.Pixel.Query().
+				Where(pixel.BoardIDEQ(board.ID), pixel.PositionEQ(position)).
+				Only(ctx)
+			if err != nil {
+				logrus.WithError(err).WithField("pixel_id", position).Error("Failed to retrieve pixel")
+				return framework.NewInternalError("Failed to retrieve pixel")
+			}
+			if existing.Seq != history[0].Seq {
+				// Painted again after the history was read.
+				return framework.NewConflictError("Pixel just changed, please try again")
+			}
+
+			restorations = append(restorations, restoration{existing, history[undone-1].OldColor, previous})
+		}
+		if len(restorations) == 0 {
+			return nil
+		}
+
+		seq, err := reserveSeq(ctx, tx, board, len(restorations))
+		if err != nil {
+			return err
+		}
+		for i, r := range restorations {
+			p, err := s.restore(ctx, tx, board, r.existing, r.color, r.previous, seq+int64(i))
+			if err != nil {
+				return err
+			}
+			restored = append(restored, p)
+		}
+		return s.announce(ctx, tx, restored, actorID)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"board_id": board.ID,
+		"user_id":  userID,
+		"since":    since,
+		"restored": len(restored),
+	}).Info("Paints rolled back")
+	return board, restored, nil
+}
+
+// Wipe paints a rectangle of the board white and clears its owners. Regions
+// and cooldowns do not apply.
+func (s *Moderation) Wipe(ctx context.Context, boardID int, x, y, width, height int, actorID int64) (*ent.Board, []*ent.Pixel, error) {
+	var board *ent.Board
+	var wiped []*ent.Pixel
+	err := s.app.TX(ctx, func(tx *ent.Tx) error {
+		var err error
+		board, err = findBoard(ctx, tx.Client(), boardID)
+		if err != nil {
+			return err
+		}
+		if x < 0 || y < 0 || width <= 0 || height <= 0 || x+width > board.Width || y+height > board.Height {
+			return framework.NewValidationError("Area is out of bounds")
+		}
+
+		pixels, err := tx.Pixel.Query().
+			Where(
+				pixel.BoardIDEQ(board.ID),
+				pixel.PositionGTE(y*board.Width+x),
+				pixel.PositionLT((y+height-1)*board.Width+x+width),
+				pixel.Or(pixel.ColorNEQ("white"), pixel.UserIDNotNil()),
+			).
+			Order(ent.Asc(pixel.FieldPosition)).
+			All(ctx)
+		if err != nil {
+			logrus.WithError(err).WithField("board_id", board.ID).Error("Failed to retrieve pixels")
+			return framework.NewInternalError("Failed to retrieve pixels")
+		}
+
+		var inside []*ent.Pixel
+		for _, p := range pixels {
+			if px := p.Position % board.Width; px >= x && px < x+width {
+				inside = append(inside, p)
+			}
+		}
+		if len(inside) == 0 {
+			return nil
+		}
+
+		seq, err := reserveSeq(ctx, tx, board, len(inside))
+		if err != nil {
+			return err
+		}
+		for i, p := range inside {
+			restored, err := s.restore(ctx, tx, board, p, "white", nil, seq+int64(i))
+			if err != nil {
+				return err
+			}
+			wiped = append(wiped, restored)
+		}
+		return s.announce(ctx, tx, wiped, actorID)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"board_id": board.ID,
+		"x":        x,
+		"y":        y,
+		"width":    width,
+		"height":   height,
+		"wiped":    len(wiped),
+	}).Info("Area wiped")
+	return board, wiped, nil
+}
+
+// announce stores the changed pixels as one event, so clients update them the
+// same way as a batch paint.
+func (s *Moderation) announce(ctx context.Context, tx *ent.Tx, pixels []*ent.Pixel, actorID int64) error {
+	if len(pixels) == 0 {
+		return nil
+	}
+
+	by, err := actor(ctx, tx, actorID)
+	if err != nil {
+		return err
+	}
+	return s.app.Enqueue(ctx, tx, framework.BroadcastEvent("pixels:updated", PixelsEvent{Pixels: pixels, Actor: by}))
+}
+
+// restore sets a pixel to the given color and gives it back to the painter of
+// previous, or to nobody. Like a paint it only applies if the pixel did not
+// change since it was read. The change is recorded without a user so it does
+// not count as anyone's paint.
+func (s *Moderation) restore(ctx context.Context, tx *ent.Tx, board *ent.Board, existing *ent.Pixel, color string, previous *ent.PixelChange, seq int64) (*ent.Pixel, error) {
+	update := tx.Pixel.Update().
+		Where(pixel.IDEQ(existing.ID), pixel.SeqEQ(existing.Seq)).
+		SetColor(color).
+		SetUpdatedAt(time.Now()).
+		SetSeq(seq)
+	if previous != nil && previous.UserID != 0 {
+		update.SetUserID(previous.UserID)
+	} else {
+		update.ClearUser()
+	}
+	if previous != nil && previous.TeamID != nil {
+		update.SetTeamID(*previous.TeamID)
+	} else {
+		update.ClearTeam()
+	}
+
+	n, err := update.Save(ctx)
+	if err != nil {
+		logrus.WithError(err).WithField("pixel_id", existing.Position).Error("Failed to restore pixel")
+		return nil, framework.NewInternalError("Failed to restore pixel")
+	}
+	if n == 0 {
+		return nil, framework.NewConflictError("Pixel just changed, please try again")
+	}
+
+	updated, err := tx.Pixel.Query().
+		Where(pixel.IDEQ(existing.ID)).
+		WithUser().
+		Only(ctx)
+	if err != nil {
+		logrus.WithError(err).WithField("pixel_id", existing.Position).Error("Failed to retrieve pixel")
+		return nil, framework.NewInternalError("Failed to retrieve pixel")
+	}
+	updated.Edges.Board = board
+
+	err = tx.PixelChange.Create().
+		SetBoard(board).
+		SetPixel(updated).
+		SetPosition(updated.Position).
+		SetOldColor(existing.Color).
+		SetNewColor(color).
+		SetSeq(seq).
+		Exec(ctx)
+	if err != nil {
+		logrus.WithError(err).WithField("pixel_id", existing.Position).Error("Failed to record pixel change")
+		return nil, framework.NewInternalError("Failed to record pixel change")
+	}
+	return updated, nil
+}
+
+func (s *Moderation) getUser(ctx context.Context, userID int64) (*ent.User, error) {
+	found, err := s.app.Client().User.Get(ctx, userID)
+	if ent.IsNotFound(err) {
+		return nil, framework.NewNotFoundError("User not found")
+	}
+	if err != nil {
+		logrus.WithError(err).WithField("user_id", userID).Error("Failed to get user")
+		return nil, framework.NewInternalError("Failed to get user")
+	}
+	return found, nil
+}
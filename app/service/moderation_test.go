@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"nevissGo/ent"
+	"nevissGo/ent/pixelchange"
+	"nevissGo/ent/user"
+	"nevissGo/framework"
+)
+
+type ModerationSuite struct {
+	suite.Suite
+	app     *framework.TestingApp
+	service *Moderation
+	pixels  *Pixels
+	ctx     context.Context
+	admin   *ent.User
+	artist  *ent.User
+	griefer *ent.User
+	board   *ent.Board
+}
+
+func TestModerationSuite(t *testing.T) {
+	suite.Run(t, new(ModerationSuite))
+}
+
+func (s *ModerationSuite) SetupTest() {
+	s.app = framework.NewTestingApp(s.T())
+	s.service = NewModeration(s.app.App)
+	bridge := TestBridge(s.T())
+	bridge.Hype.On("UseHypeTX", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	s.pixels = NewPixels(s.app.App, bridge.Bridge)
+	s.ctx = context.Background()
+
+	var err error
+	s.admin, err = s.app.Client().User.Create().
+		SetDisplayName("Admin").
+		SetGameID("admin").
+		SetRole(user.RoleAdmin).
+		Save(s.ctx)
+	s.NoError(err)
+	s.artist, err = s.app.Client().User.Create().
+		SetDisplayName("Artist").
+		SetGameID("artist").
+		Save(s.ctx)
+	s.NoError(err)
+	s.griefer, err = s.app.Client().User.Create().
+		SetDisplayName("Griefer").
+		SetGameID("griefer").
+		Save(s.ctx)
+	s.NoError(err)
+
+	s.board, err = NewBoards(s.app.App).Create(s.ctx, s.admin.ID, BoardSettings{Name: "main", Width: 10, Height: 10})
+	s.NoError(err)
+}
+
+func (s *ModerationSuite) paint(pixelID int, color string, userID int64) {
+	_, err := s.pixels.UpdateColor(s.ctx, s.board.ID, pixelID, color, userID)
+	s.Require().NoError(err)
+}
+
+func (s *ModerationSuite) TestBanAndUnban() {
+	banned, err := s.service.Ban(s.ctx, s.griefer.ID, "spam", s.admin.ID)
+	s.NoError(err)
+	s.NotNil(banned.BannedAt)
+	s.Equal("spam", banned.BanReason)
+
+	_, err = s.service.Ban(s.ctx, s.admin.ID, "", s.artist.ID)
+	s.Equal(403, framework.ExtErrorCode(err))
+
+	_, err = s.service.Ban(s.ctx, s.admin.ID, "", s.admin.ID)
+	s.Equal(400, framework.ExtErrorCode(err))
+
+	unbanned, err := s.service.Unban(s.ctx, s.griefer.ID, s.admin.ID)
+	s.NoError(err)
+	s.Nil(unbanned.BannedAt)
+	s.Empty(unbanned.BanReason)
+}
+
+func (s *ModerationSuite) TestRollbackKeepsLaterPaintsOfOthers() {
+	s.paint(0, "red-dark", s.artist.ID)
+	time.Sleep(10 * time.Millisecond)
+	since := time.Now()
+
+	s.paint(0, "black", s.griefer.ID)
+	s.paint(0, "gray", s.griefer.ID)
+	s.paint(1, "black", s.griefer.ID)
+	s.paint(2, "black", s.griefer.ID)
+	s.paint(2, "blue-light", s.artist.ID)
+
+	board, restored, err := s.service.Rollback(s.ctx, 0, s.griefer.ID, since, 0)
+	s.NoError(err)
+	s.Equal(s.board.ID, board.ID)
+	s.Len(restored, 2)
+
+	current, err := s.pixels.GetBoard(s.ctx, s.board.ID)
+	s.NoError(err)
+	s.Equal("red-dark", current.Pixels[0].Color)
+	s.Equal(s.artist.ID, current.Pixels[0].UserID)
+	s.Equal("white", current.Pixels[1].Color)
+	s.Nil(current.Pixels[1].Edges.User)
+	s.Equal("blue-light", current.Pixels[2].Color)
+	s.Equal(s.artist.ID, current.Pixels[2].UserID)
+
+	// The rollback is in the history but not counted as anyone's paint.
+	unowned, err := s.app.Client().PixelChange.Query().
+		Where(pixelchange.UserIDIsNil()).
+		Count(s.ctx)
+	s.NoError(err)
+	s.Equal(2, unowned)
+
+	_, restored, err = s.service.Rollback(s.ctx, s.board.ID, s.griefer.ID, since, 0)
+	s.NoError(err)
+	s.Empty(restored)
+}
+
+func (s *ModerationSuite) TestWipe() {
+	s.paint(0, "black", s.griefer.ID)
+	s.paint(11, "black", s.griefer.ID)
+	s.paint(13, "black", s.griefer.ID)
+	s.paint(99, "black", s.artist.ID)
+
+	_, wiped, err := s.service.Wipe(s.ctx, s.board.ID, 1, 0, 2, 2, 0)
+	s.NoError(err)
+	s.Require().Len(wiped, 1)
+	s.Equal(11, wiped[0].Position)
+
+	current, err := s.pixels.GetBoard(s.ctx, s.board.ID)
+	s.NoError(err)
+	s.Equal("black", current.Pixels[0].Color)
+	s.Equal("white", current.Pixels[11].Color)
+	s.Equal("black", current.Pixels[13].Color)
+	s.Equal(current.Seq, wiped[0].Seq)
+
+	_, _, err = s.service.Wipe(s.ctx, s.board.ID, 9, 9, 2, 1, 0)
+	s.Equal("Area is out of bounds", framework.ExtErrorMessage(err))
+}
+
+func (s *ModerationSuite) TestAdminsManageRegions() {
+	other, err := NewBoards(s.app.App).Create(s.ctx, s.artist.ID, BoardSettings{Name: "event", Width: 5, Height: 5})
+	s.NoError(err)
+
+	regions := NewRegions(s.app.App)
+	_, err = regions.Create(s.ctx, other.ID, s.admin.ID, RegionSettings{Name: "logo", Width: 1, Height: 1})
+	s.NoError(err)
+	_, err = regions.Create(s.ctx, other.ID, s.griefer.ID, RegionSettings{Name: "logo", Width: 1, Height: 1})
+	s.Equal(403, framework.ExtErrorCode(err))
+}
@@ -0,0 +1,195 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"nevissGo/ent"
+	"nevissGo/ent/outboxevent"
+	"nevissGo/framework"
+)
+
+// recordingCentrifugo records the events it sends and fails the ones listed
+// in fail once each.
+type recordingCentrifugo struct {
+	sent []string
+	fail map[string]bool
+}
+
+func (c *recordingCentrifugo) send(name string) error {
+	if c.fail[name] {
+		delete(c.fail, name)
+		return errors.New("centrifugo unavailable")
+	}
+	c.sent = append(c.sent, name)
+	return nil
+}
+
+func (c *recordingCentrifugo) PersonalMessage(ctx context.Context, userID any, eventName string, data any) error {
+	return c.send(eventName)
+}
+
+func (c *recordingCentrifugo) OnlineWSUsers(ctx context.Context) (int, error) {
+	return 0, nil
+}
+
+func (c *recordingCentrifugo) PersonalMany(ctx context.Context, usersIds []any, eventName string, data any) error {
+	return c.send(eventName)
+}
+
+func (c *recordingCentrifugo) Broadcast(ctx context.Context, eventName string, data any) error {
+	return c.send(eventName)
+}
+
+func (c *recordingCentrifugo) TeamMessage(ctx context.Context, teamID int, eventName string, data any) error {
+	return c.send(eventName)
+}
+
+type OutboxSuite struct {
+	suite.Suite
+	app    *framework.TestingApp
+	events *recordingCentrifugo
+	pixels *Pixels
+	ctx    context.Context
+	user   *ent.User
+	board  *ent.Board
+	bridge TestingBridge
+}
+
+func TestOutboxSuite(t *testing.T) {
+	suite.Run(t, new(OutboxSuite))
+}
+
+func (s *OutboxSuite) SetupTest() {
+	s.app = framework.NewTestingApp(s.T())
+	s.events = &recordingCentrifugo{fail: map[string]bool{}}
+	s.app.Event = s.events
+	s.bridge = TestBridge(s.T())
+	s.pixels = NewPixels(s.app.App, s.bridge.Bridge)
+	s.ctx = context.Background()
+
+	var err error
+	s.user, err = s.app.Client().User.Create().
+		SetDisplayName("TestUser").
+		SetGameID("game123").
+		Save(s.ctx)
+	s.NoError(err)
+
+	s.board, err = NewBoards(s.app.App).Create(s.ctx, s.user.ID, BoardSettings{Name: "test", Width: 10, Height: 10})
+	s.NoError(err)
+}
+
+func (s *OutboxSuite) TestPaintStoresEvent() {
+	s.bridge.Hype.On("UseHypeTX", mock.Anything, mock.Anything, s.user.ID, mock.Anything).Return(nil).Once()
+	s.bridge.Hype.On("UseHypeTX", mock.Anything, mock.Anything, s.user.ID, mock.Anything).Return(framework.NewInternalError("hype usage failed")).Once()
+	defer s.bridge.Hype.AssertExpectations(s.T())
+
+	_, err := s.pixels.UpdateColor(s.ctx, s.board.ID, 1, "red-dark", s.user.ID)
+	s.NoError(err)
+
+	// A paint that is rolled back announces nothing.
+	_, err = s.pixels.UpdateColor(s.ctx, s.board.ID, 2, "red-dark", s.user.ID)
+	s.Error(err)
+
+	events, err := s.app.Client().OutboxEvent.Query().All(s.ctx)
+	s.NoError(err)
+	s.Len(events, 1)
+	s.Equal(outboxevent.KindBroadcast, events[0].Kind)
+	s.Equal("pixel:updated", events[0].Name)
+
+	_, err = s.app.DispatchOutbox(s.ctx)
+	s.NoError(err)
+	s.Equal([]string{"pixel:updated"}, s.events.sent)
+
+	left, err := s.app.Client().OutboxEvent.Query().Count(s.ctx)
+	s.NoError(err)
+	s.Zero(left)
+}
+
+func (s *OutboxSuite) TestDispatchRetriesInOrder() {
+	s.NoError(s.app.Publish(s.ctx,
+		framework.BroadcastEvent("first", nil),
+		framework.TeamEvent(1, "second", nil),
+		framework.BroadcastEvent("third", nil),
+	))
+	s.events.fail["second"] = true
+
+	// The failed event holds back the ones after it until it is retried.
+	wait, err := s.app.DispatchOutbox(s.ctx)
+	s.NoError(err)
+	s.Positive(wait)
+	s.Equal([]string{"first"}, s.events.sent)
+
+	failed, err := s.app.Client().OutboxEvent.Query().Where(outboxevent.Name("second")).Only(s.ctx)
+	s.NoError(err)
+	s.Equal(1, failed.Attempts)
+	s.True(failed.NextAttemptAt.After(time.Now()))
+
+	_, err = s.app.DispatchOutbox(s.ctx)
+	s.NoError(err)
+	s.Equal([]string{"first"}, s.events.sent)
+
+	s.NoError(failed.Update().SetNextAttemptAt(time.Now().Add(-time.Second)).Exec(s.ctx))
+	_, err = s.app.DispatchOutbox(s.ctx)
+	s.NoError(err)
+	s.Equal([]string{"first", "second", "third"}, s.events.sent)
+}
+
+func (s *OutboxSuite) TestDispatchDropsExpiredEvents() {
+	// An event that kept failing since long ago.
+	err := s.app.Client().OutboxEvent.Create().
+		SetKind(outboxevent.KindBroadcast).
+		SetName("stale").
+		SetPayload([]byte("null")).
+		SetAttempts(20).
+		SetCreatedAt(time.Now().Add(-framework.OutboxMaxAge - time.Minute)).
+		Exec(s.ctx)
+	s.NoError(err)
+	s.NoError(s.app.Publish(s.ctx, framework.BroadcastEvent("fresh", nil)))
+
+	_, err = s.app.DispatchOutbox(s.ctx)
+	s.NoError(err)
+	s.Equal([]string{"fresh"}, s.events.sent)
+
+	left, err := s.app.Client().OutboxEvent.Query().Count(s.ctx)
+	s.NoError(err)
+	s.Zero(left)
+}
+
+func (s *OutboxSuite) TestDispatchWaitsForGaps() {
+	s.NoError(s.app.Publish(s.ctx, framework.BroadcastEvent("first", nil)))
+	_, err := s.app.DispatchOutbox(s.ctx)
+	s.NoError(err)
+
+	// The second event's transaction has not committed yet.
+	s.NoError(s.app.Publish(s.ctx,
+		framework.BroadcastEvent("second", nil),
+		framework.BroadcastEvent("third", nil),
+	))
+	_, err = s.app.Client().OutboxEvent.Delete().Where(outboxevent.Name("second")).Exec(s.ctx)
+	s.NoError(err)
+
+	wait, err := s.app.DispatchOutbox(s.ctx)
+	s.NoError(err)
+	s.Positive(wait)
+	s.Equal([]string{"first"}, s.events.sent)
+
+	// A gap that stays open, as rolled back transactions leave, is passed.
+	_, err = s.app.Client().OutboxEvent.Delete().Where(outboxevent.Name("third")).Exec(s.ctx)
+	s.NoError(err)
+	err = s.app.Client().OutboxEvent.Create().
+		SetKind(outboxevent.KindBroadcast).
+		SetName("fourth").
+		SetPayload([]byte("null")).
+		SetCreatedAt(time.Now().Add(-time.Minute)).
+		Exec(s.ctx)
+	s.NoError(err)
+
+	_, err = s.app.DispatchOutbox(s.ctx)
+	s.NoError(err)
+	s.Equal([]string{"first", "fourth"}, s.events.sent)
+}
@@ -2,169 +2,642 @@ package service
 
 import (
 	"context"
-	"fmt"
+	"math"
 	"time"
 
 	"github.com/sirupsen/logrus"
 	"nevissGo/ent"
+	entboard "nevissGo/ent/board"
+	"nevissGo/ent/pixel"
+	"nevissGo/ent/pixelchange"
 	"nevissGo/framework"
+	"nevissGo/pkg/palette"
 )
 
 type Pixels struct {
-	app           *framework.App
-	bridge        Bridge
-	cooldown      time.Duration
-	width, height int
-	drawHypeCost  int
+	app       *framework.App
+	bridge    Bridge
+	cache     *boardCache
+	snapshots *snapshotCache
 }
 
-func NewPixels(
-	app *framework.App,
-	bridge Bridge,
-
-	cooldown time.Duration,
-	width, height int,
-	drawHypeCost int,
-) *Pixels {
+func NewPixels(app *framework.App, bridge Bridge) *Pixels {
 	return &Pixels{
-		app:    app,
-		bridge: bridge,
+		app:       app,
+		bridge:    bridge,
+		cache:     newBoardCache(),
+		snapshots: newSnapshotCache(),
+	}
+}
+
+// MaxBatchPixels is the most pixels a single batch paint may change.
+const MaxBatchPixels = 64
+
+// PixelPaint is one pixel of a batch paint.
+type PixelPaint struct {
+	PixelID int
+	Color   string
+}
 
-		cooldown:     cooldown,
-		width:        width,
-		height:       height,
-		drawHypeCost: drawHypeCost,
+func (s *Pixels) UpdateColor(ctx context.Context, boardID int, pixelID int, newColor string, userID int64) (*ent.Pixel, error) {
+	updated, err := s.paint(ctx, boardID, []PixelPaint{{PixelID: pixelID, Color: newColor}}, userID, func(pixels []*ent.Pixel, painter *ent.User) framework.Event {
+		return framework.BroadcastEvent("pixel:updated", PixelEvent{Pixel: pixels[0], Actor: painter})
+	})
+	if err != nil {
+		return nil, err
 	}
+
+	return updated[0], nil
 }
 
-func (s *Pixels) UpdateColor(ctx context.Context, pixelID int, newColor string, userID int64) error {
-	if pixelID < 0 || pixelID >= s.width*s.height {
-		logrus.WithFields(logrus.Fields{
-			"pixel_id": pixelID,
-			"width":    s.width,
-			"height":   s.height,
-		}).Error("Pixel ID is out of bounds")
-		return framework.NewValidationError("Pixel ID is out of bounds")
+// UpdateColors paints several pixels of a board as one stroke: everything is
+// validated first, hype is charged once for the whole stroke and either all
+// pixels change or none do. Every pixel gets its own sequence number, in the
+// order given.
+func (s *Pixels) UpdateColors(ctx context.Context, boardID int, paints []PixelPaint, userID int64) ([]*ent.Pixel, error) {
+	return s.paint(ctx, boardID, paints, userID, func(pixels []*ent.Pixel, painter *ent.User) framework.Event {
+		return framework.BroadcastEvent("pixels:updated", PixelsEvent{Pixels: pixels, Actor: painter})
+	})
+}
+
+// paint does the work of UpdateColors and stores the event announcing the
+// paint along with it.
+func (s *Pixels) paint(ctx context.Context, boardID int, paints []PixelPaint, userID int64, event func(pixels []*ent.Pixel, painter *ent.User) framework.Event) ([]*ent.Pixel, error) {
+	if len(paints) == 0 {
+		return nil, framework.NewValidationError("Nothing to paint")
+	}
+	if len(paints) > MaxBatchPixels {
+		return nil, framework.NewValidationError("Too many pixels in one batch")
 	}
 
-	return s.app.TX(ctx, func(tx *ent.Tx) error {
-		pixel, err := s.getPixel(tx, ctx, pixelID)
-		if ent.IsNotFound(err) {
-			if err := s.bridge.Hype.UseHypeTX(ctx, tx, userID, s.drawHypeCost); err != nil {
+	var updated []*ent.Pixel
+	err := s.app.TX(ctx, func(tx *ent.Tx) error {
+		board, err := findBoard(ctx, tx.Client(), boardID)
+		if err != nil {
+			return err
+		}
+		if !board.Open {
+			return framework.NewValidationError("Board is closed")
+		}
+
+		seen := make(map[int]struct{}, len(paints))
+		for _, paint := range paints {
+			if err := s.validatePaint(board, paint); err != nil {
 				return err
 			}
-			return s.createPixel(tx, ctx, pixelID, newColor, userID)
+			if _, ok := seen[paint.PixelID]; ok {
+				return framework.NewValidationError("Pixel is painted twice in one batch")
+			}
+			seen[paint.PixelID] = struct{}{}
 		}
+
+		regions, err := tx.Board.QueryRegions(board).All(ctx)
 		if err != nil {
-			logrus.WithError(err).WithField("pixel_id", pixelID).Error("Failed to retrieve pixel")
-			return framework.NewInternalError("Failed to retrieve pixel")
+			logrus.WithError(err).WithField("board_id", board.ID).Error("Failed to retrieve regions")
+			return framework.NewInternalError("Failed to retrieve regions")
 		}
-		if err := s.ensureCooldown(pixel); err != nil {
+		for _, paint := range paints {
+			if r := protectingRegion(regions, board, paint.PixelID); r != nil {
+				return framework.NewForbiddenError("Pixel is in a protected region").WithFields(framework.Fields{
+					"region": r.Name,
+				})
+			}
+		}
+
+		if err := ensureUserCooldown(ctx, tx.Client(), board, userID); err != nil {
+			return err
+		}
+
+		painter, err := tx.User.Get(ctx, userID)
+		if err != nil {
+			logrus.WithError(err).WithField("user_id", userID).Error("Failed to retrieve painter")
+			return framework.NewInternalError("Failed to retrieve painter")
+		}
+
+		existing := make([]*ent.Pixel, len(paints))
+		for i, paint := range paints {
+			pixel, err := s.getPixel(tx, ctx, board, paint.PixelID)
+			if ent.IsNotFound(err) {
+				continue
+			}
+			if err != nil {
+				logrus.WithError(err).WithField("pixel_id", paint.PixelID).Error("Failed to retrieve pixel")
+				return framework.NewInternalError("Failed to retrieve pixel")
+			}
+			if err := s.ensureCooldown(board, pixel); err != nil {
+				return err
+			}
+			existing[i] = pixel
+		}
+
+		if err := s.bridge.Hype.UseHypeTX(ctx, tx, userID, board.HypeCost*len(paints)); err != nil {
 			return err
 		}
-		if err := s.bridge.Hype.UseHypeTX(ctx, tx, userID, s.drawHypeCost); err != nil {
+
+		seq, err := reserveSeq(ctx, tx, board, len(paints))
+		if err != nil {
 			return err
 		}
-		return s.updateExistingPixel(tx, ctx, pixel, newColor, userID)
+
+		updated = make([]*ent.Pixel, len(paints))
+		for i, paint := range paints {
+			if existing[i] == nil {
+				updated[i], err = s.createPixel(tx, ctx, board, paint.PixelID, paint.Color, painter, seq+int64(i))
+			} else {
+				updated[i], err = s.updateExistingPixel(tx, ctx, board, existing[i], paint.Color, painter, seq+int64(i))
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return s.app.Enqueue(ctx, tx, event(updated, painter))
 	})
-}
+	if err != nil {
+		return nil, err
+	}
 
-func (s *Pixels) getPixel(tx *ent.Tx, ctx context.Context, pixelID int) (*ent.Pixel, error) {
-	return tx.Pixel.Get(ctx, pixelID)
+	s.cache.board(updated[0].Edges.Board.ID).apply(updated)
+	return updated, nil
 }
 
-func (s *Pixels) createPixel(tx *ent.Tx, ctx context.Context, pixelID int, newColor string, userID int64) error {
-	fmt.Println(pixelID)
-	if pixelID < 0 || pixelID >= s.width*s.height {
+func (s *Pixels) validatePaint(board *ent.Board, paint PixelPaint) error {
+	if paint.PixelID < 0 || paint.PixelID >= board.Width*board.Height {
 		logrus.WithFields(logrus.Fields{
-			"pixel_id": pixelID,
-			"width":    s.width,
-			"height":   s.height,
+			"board_id": board.ID,
+			"pixel_id": paint.PixelID,
+			"width":    board.Width,
+			"height":   board.Height,
 		}).Error("Pixel ID is out of bounds")
 		return framework.NewValidationError("Pixel ID is out of bounds")
 	}
-	_, err := tx.Pixel.Create().
-		SetID(pixelID).
+
+	if !boardPalette(board).Contains(paint.Color) {
+		return framework.NewValidationError("Color is not in the board palette")
+	}
+	return nil
+}
+
+// reserveSeq takes the next n sequence numbers of the board and returns the
+// first. The update locks the board row until the transaction ends, so
+// changes to a board commit in the order of their sequence numbers and
+// readers catching up with SeqGT never skip one.
+func reserveSeq(ctx context.Context, tx *ent.Tx, board *ent.Board, n int) (int64, error) {
+	updated, err := tx.Board.UpdateOneID(board.ID).AddSeq(int64(n)).Save(ctx)
+	if err != nil {
+		logrus.WithError(err).WithField("board_id", board.ID).Error("Failed to reserve board sequence")
+		return 0, framework.NewInternalError("Failed to reserve board sequence")
+	}
+	return updated.Seq - int64(n) + 1, nil
+}
+
+func (s *Pixels) getPixel(tx *ent.Tx, ctx context.Context, board *ent.Board, pixelID int) (*ent.Pixel, error) {
+	return tx.Pixel.Query().
+		Where(
+			pixel.HasBoardWith(entboard.IDEQ(board.ID)),
+			pixel.PositionEQ(pixelID),
+		).
+		Only(ctx)
+}
+
+// createPixel and updateExistingPixel attribute the pixel to the painter's
+// team at paint time, so switching teams later does not move territory.
+func (s *Pixels) createPixel(tx *ent.Tx, ctx context.Context, board *ent.Board, pixelID int, newColor string, painter *ent.User, seq int64) (*ent.Pixel, error) {
+	created, err := tx.Pixel.Create().
+		SetBoard(board).
+		SetPosition(pixelID).
 		SetColor(newColor).
 		SetUpdatedAt(time.Now()).
-		SetUserID(userID).
+		SetUserID(painter.ID).
+		SetNillableTeamID(painter.TeamID).
+		SetSeq(seq).
 		Save(ctx)
+	if ent.IsConstraintError(err) {
+		// Someone else painted this pixel for the first time concurrently.
+		logrus.WithField("pixel_id", pixelID).Warn("Pixel created concurrently")
+		return nil, framework.NewConflictError("Pixel just changed, please try again")
+	}
 	if err != nil {
 		logrus.WithError(err).WithField("pixel_id", pixelID).Error("Failed to create pixel")
-		return framework.NewInternalError("Failed to create pixel")
+		return nil, framework.NewInternalError("Failed to create pixel")
+	}
+	if err := s.recordChange(tx, ctx, board, created, "white"); err != nil {
+		return nil, err
 	}
 	logrus.WithFields(logrus.Fields{
+		"board_id":  board.ID,
 		"pixel_id":  pixelID,
 		"new_color": newColor,
-		"user_id":   userID,
+		"user_id":   painter.ID,
 	}).Info("Pixel created and assigned to user successfully")
-	return nil
+	return s.withEdges(tx, ctx, board, created)
 }
 
-func (s *Pixels) ensureCooldown(pixel *ent.Pixel) error {
+func (s *Pixels) ensureCooldown(board *ent.Board, pixel *ent.Pixel) error {
 	timeSinceUpdate := time.Since(pixel.UpdatedAt)
-	if timeSinceUpdate < s.cooldown {
+	if timeSinceUpdate < board.Cooldown {
 		logrus.WithFields(logrus.Fields{
-			"pixel_id":          pixel.ID,
+			"board_id":          board.ID,
+			"pixel_id":          pixel.Position,
 			"time_since_update": timeSinceUpdate.Seconds(),
-			"cooldown_secs":     s.cooldown.Seconds(),
+			"cooldown_secs":     board.Cooldown.Seconds(),
 		}).Warn("Attempt to update pixel too soon")
-		return framework.NewValidationError("Pixel can only be updated every " + s.cooldown.String())
+		return framework.NewValidationError("Pixel can only be updated every " + board.Cooldown.String())
 	}
 	return nil
 }
 
-func (s *Pixels) updateExistingPixel(tx *ent.Tx, ctx context.Context, pixel *ent.Pixel, newColor string, userID int64) error {
-	_, err := tx.Pixel.UpdateOne(pixel).
+// userRetryAfter returns how long the user still has to wait before painting
+// on the board again, based on their last recorded paint there.
+func userRetryAfter(ctx context.Context, client *ent.Client, board *ent.Board, userID int64) (time.Duration, error) {
+	if board.UserCooldown <= 0 {
+		return 0, nil
+	}
+
+	last, err := client.PixelChange.Query().
+		Where(pixelchange.BoardIDEQ(board.ID), pixelchange.UserIDEQ(userID)).
+		Order(ent.Desc(pixelchange.FieldCreatedAt)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return 0, nil
+	}
+	if err != nil {
+		logrus.WithError(err).WithField("user_id", userID).Error("Failed to retrieve last paint")
+		return 0, framework.NewInternalError("Failed to retrieve last paint")
+	}
+
+	return max(time.Until(last.CreatedAt.Add(board.UserCooldown)), 0), nil
+}
+
+func ensureUserCooldown(ctx context.Context, client *ent.Client, board *ent.Board, userID int64) error {
+	retryAfter, err := userRetryAfter(ctx, client, board, userID)
+	if err != nil {
+		return err
+	}
+	if retryAfter > 0 {
+		return framework.NewTooManyRequestsError("You can only paint every " + board.UserCooldown.String()).WithFields(framework.Fields{
+			"retry_after": int(math.Ceil(retryAfter.Seconds())),
+		})
+	}
+	return nil
+}
+
+func (s *Pixels) updateExistingPixel(tx *ent.Tx, ctx context.Context, board *ent.Board, existing *ent.Pixel, newColor string, painter *ent.User, seq int64) (*ent.Pixel, error) {
+	// The update only applies if the pixel is still the one we read, so of two
+	// concurrent paints exactly one wins and the other gets a conflict.
+	update := tx.Pixel.Update().
+		Where(pixel.IDEQ(existing.ID), pixel.SeqEQ(existing.Seq)).
 		SetColor(newColor).
 		SetUpdatedAt(time.Now()).
-		SetUserID(userID).
-		Save(ctx)
+		SetUserID(painter.ID).
+		SetSeq(seq)
+	if painter.TeamID != nil {
+		update.SetTeamID(*painter.TeamID)
+	} else {
+		update.ClearTeam()
+	}
+
+	n, err := update.Save(ctx)
 	if err != nil {
-		logrus.WithError(err).WithField("pixel_id", pixel.ID).Error("Failed to update pixel color")
-		return framework.NewInternalError("Failed to update pixel color").WithFields(logrus.Fields{
-			"pixel_id": pixel.ID,
+		logrus.WithError(err).WithField("pixel_id", existing.Position).Error("Failed to update pixel color")
+		return nil, framework.NewInternalError("Failed to update pixel color").WithFields(logrus.Fields{
+			"pixel_id": existing.Position,
 		})
 	}
+	if n == 0 {
+		logrus.WithField("pixel_id", existing.Position).Warn("Pixel updated concurrently")
+		return nil, framework.NewConflictError("Pixel just changed, please try again")
+	}
+
+	updated, err := tx.Pixel.Get(ctx, existing.ID)
+	if err != nil {
+		logrus.WithError(err).WithField("pixel_id", existing.Position).Error("Failed to retrieve pixel")
+		return nil, framework.NewInternalError("Failed to retrieve pixel")
+	}
+	if err := s.recordChange(tx, ctx, board, updated, existing.Color); err != nil {
+		return nil, err
+	}
 	logrus.WithFields(logrus.Fields{
-		"pixel_id":  pixel.ID,
+		"pixel_id":  existing.Position,
 		"new_color": newColor,
-		"user_id":   userID,
+		"user_id":   painter.ID,
 	}).Info("Pixel color updated and reassigned to user successfully")
+	return s.withEdges(tx, ctx, board, updated)
+}
+
+// recordChange appends the paint to the pixel history. It has to run in the
+// same transaction as the paint itself so the history never misses a change.
+func (s *Pixels) recordChange(tx *ent.Tx, ctx context.Context, board *ent.Board, p *ent.Pixel, oldColor string) error {
+	create := tx.PixelChange.Create().
+		SetBoard(board).
+		SetPixel(p).
+		SetPosition(p.Position).
+		SetOldColor(oldColor).
+		SetNewColor(p.Color).
+		SetSeq(p.Seq).
+		SetNillableTeamID(p.TeamID)
+	if p.UserID != 0 {
+		create.SetUserID(p.UserID)
+	}
+
+	if err := create.Exec(ctx); err != nil {
+		logrus.WithError(err).WithField("pixel_id", p.Position).Error("Failed to record pixel change")
+		return framework.NewInternalError("Failed to record pixel change")
+	}
 	return nil
 }
 
+func (s *Pixels) withEdges(tx *ent.Tx, ctx context.Context, board *ent.Board, p *ent.Pixel) (*ent.Pixel, error) {
+	owner, err := p.QueryUser().Only(ctx)
+	if err != nil {
+		logrus.WithError(err).WithField("pixel_id", p.Position).Error("Failed to retrieve pixel owner")
+		return nil, framework.NewInternalError("Failed to retrieve pixel owner")
+	}
+	p.Edges.User = owner
+	p.Edges.Board = board
+	return p, nil
+}
+
 type Board struct {
-	Pixels []*ent.Pixel
-	Width  int
-	Height int
+	ID      int
+	Name    string
+	Pixels  []*ent.Pixel
+	Width   int
+	Height  int
+	Seq     int64
+	Palette palette.Palette
+}
+
+// maxIndexedColors is how many colors one byte can index.
+const maxIndexedColors = 256
+
+// Indexed encodes the board as one byte per pixel, in position order, indexing
+// the returned colors. The colors are the board palette in order, followed by
+// any painted colors that are no longer in it.
+func (b *Board) Indexed() ([]string, []byte, error) {
+	return indexColors(b.ID, b.Palette, b.Pixels)
 }
 
-func (s *Pixels) GetBoard(ctx context.Context) (*Board, error) {
-	board := &Board{
-		Width:  s.width,
-		Height: s.height,
+func indexColors(boardID int, p palette.Palette, pixels []*ent.Pixel) ([]string, []byte, error) {
+	colors := p.Names()
+	index := make(map[string]byte, len(colors))
+	for i, name := range colors {
+		index[name] = byte(i)
+	}
+
+	indices := make([]byte, len(pixels))
+	for i, px := range pixels {
+		c, ok := index[px.Color]
+		if !ok {
+			if len(colors) == maxIndexedColors {
+				logrus.WithField("board_id", boardID).Error("Board has too many colors to index")
+				return nil, nil, framework.NewInternalError("Board has too many colors to index")
+			}
+			c = byte(len(colors))
+			index[px.Color] = c
+			colors = append(colors, px.Color)
+		}
+		indices[i] = c
 	}
 
-	pixels, err := s.app.Client().Pixel.Query().WithUser().All(ctx)
+	return colors, indices, nil
+}
+
+// GetBoard returns every pixel of a board from the cache.
+func (s *Pixels) GetBoard(ctx context.Context, boardID int) (*Board, error) {
+	b, err := findBoard(ctx, s.app.Client(), boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	pixels, seq, err := s.readArea(ctx, b, 0, 0, b.Width, b.Height)
 	if err != nil {
-		return nil, framework.NewInternalError("Failed to retrieve pixels")
+		return nil, err
 	}
 
-	board.Pixels = make([]*ent.Pixel, s.width*s.height)
-	for _, pixel := range pixels {
-		if pixel.ID < 0 || pixel.ID >= s.width*s.height {
+	return &Board{
+		ID:      b.ID,
+		Name:    b.Name,
+		Width:   b.Width,
+		Height:  b.Height,
+		Seq:     seq,
+		Palette: boardPalette(b),
+		Pixels:  pixels,
+	}, nil
+}
+
+// readArea returns the pixels of an area of a board from the cache, with the
+// board sequence number. Only pixels painted since the cache was last updated
+// are read from the database, which catches paints made by moderation or
+// other processes; a resized board is loaded again.
+func (s *Pixels) readArea(ctx context.Context, b *ent.Board, x, y, w, h int) ([]*ent.Pixel, int64, error) {
+	cached := s.cache.board(b.ID)
+	pixels, seq, ok := cached.snapshot(b.Width, b.Height, x, y, w, h)
+	if ok {
+		newer, err := b.QueryPixels().
+			Where(pixel.SeqGT(seq)).
+			WithUser().
+			All(ctx)
+		if err != nil {
+			logrus.WithError(err).WithField("board_id", b.ID).Error("Failed to retrieve pixels")
+			return nil, 0, framework.NewInternalError("Failed to retrieve pixels")
+		}
+		if len(newer) == 0 {
+			return pixels, seq, nil
+		}
+		cached.apply(newer)
+	} else {
+		loaded, seq, err := s.loadPixels(ctx, b)
+		if err != nil {
+			return nil, 0, err
+		}
+		cached.replace(b.Width, b.Height, loaded, seq)
+	}
+
+	pixels, seq, ok = cached.snapshot(b.Width, b.Height, x, y, w, h)
+	if !ok {
+		return nil, 0, framework.NewConflictError("Board just changed, please try again")
+	}
+	return pixels, seq, nil
+}
+
+// loadPixels reads every pixel of a board, filling unpainted positions with
+// white pixels.
+func (s *Pixels) loadPixels(ctx context.Context, b *ent.Board) ([]*ent.Pixel, int64, error) {
+	found, err := b.QueryPixels().WithUser().All(ctx)
+	if err != nil {
+		logrus.WithError(err).WithField("board_id", b.ID).Error("Failed to retrieve pixels")
+		return nil, 0, framework.NewInternalError("Failed to retrieve pixels")
+	}
+
+	var seq int64
+	pixels := make([]*ent.Pixel, b.Width*b.Height)
+	for _, p := range found {
+		if p.Position < 0 || p.Position >= len(pixels) {
 			continue
 		}
 
-		board.Pixels[pixel.ID] = pixel
+		pixels[p.Position] = p
+		seq = max(seq, p.Seq)
+	}
+
+	for i := range pixels {
+		if pixels[i] == nil {
+			pixels[i] = &ent.Pixel{Position: i, Color: "white"}
+		}
+	}
+
+	return pixels, seq, nil
+}
+
+// LoadCache loads every board into the cache, so the first readers after a
+// start do not all load them at once.
+func (s *Pixels) LoadCache(ctx context.Context) error {
+	boards, err := s.app.Client().Board.Query().All(ctx)
+	if err != nil {
+		logrus.WithError(err).Error("Failed to retrieve boards")
+		return framework.NewInternalError("Failed to retrieve boards")
+	}
+
+	for _, b := range boards {
+		pixels, seq, err := s.loadPixels(ctx, b)
+		if err != nil {
+			return err
+		}
+		s.cache.board(b.ID).replace(b.Width, b.Height, pixels, seq)
+	}
+	return nil
+}
+
+// CheckCache compares the cached boards with the database, fixes them and
+// returns how many cached pixels were wrong. Paints are only missed when a
+// pixel changes without a new sequence number, like an edit made directly in
+// the database.
+func (s *Pixels) CheckCache(ctx context.Context) (int, error) {
+	fixed := 0
+	for _, boardID := range s.cache.boardIDs() {
+		b, err := findBoard(ctx, s.app.Client(), boardID)
+		if err != nil {
+			return fixed, err
+		}
+
+		pixels, seq, err := s.loadPixels(ctx, b)
+		if err != nil {
+			return fixed, err
+		}
+
+		if n := s.cache.board(b.ID).merge(b.Width, b.Height, pixels, seq); n > 0 {
+			logrus.WithFields(logrus.Fields{
+				"board_id": b.ID,
+				"pixels":   n,
+			}).Warn("Board cache was out of date")
+			fixed += n
+		}
 	}
+	return fixed, nil
+}
+
+// RunCacheCheck checks the cache once per interval.
+func (s *Pixels) RunCacheCheck(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
 
-	for i := range board.Pixels {
-		if board.Pixels[i] == nil {
-			board.Pixels[i] = &ent.Pixel{ID: i, Color: "white"}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
 		}
+
+		if _, err := s.CheckCache(ctx); err != nil {
+			logrus.WithError(err).Error("Failed to check board cache")
+		}
+	}
+}
+
+// GetBoardSince returns the pixels painted after the given sequence number, so
+// clients that missed some pixel:updated events can catch up without
+// reloading the whole board. The returned sequence number also covers changes
+// without pixels, like resizes, so clients compare the board size with theirs.
+func (s *Pixels) GetBoardSince(ctx context.Context, boardID int, since int64) (*ent.Board, []*ent.Pixel, int64, error) {
+	b, err := findBoard(ctx, s.app.Client(), boardID)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	pixels, err := b.QueryPixels().
+		Where(pixel.SeqGT(since)).
+		Order(ent.Asc(pixel.FieldSeq)).
+		WithUser().
+		All(ctx)
+	if err != nil {
+		return nil, nil, 0, framework.NewInternalError("Failed to retrieve pixels")
+	}
+
+	// Sequence numbers are taken in commit order, so every change up to the
+	// board's, read before the pixels, is among them.
+	seq := max(since, b.Seq)
+	for _, p := range pixels {
+		if p.Seq > seq {
+			seq = p.Seq
+		}
+	}
+
+	return b, pixels, seq, nil
+}
+
+// GetPixel returns a single pixel with its owner. A pixel that was never
+// painted is white and has no owner.
+func (s *Pixels) GetPixel(ctx context.Context, boardID int, pixelID int) (*ent.Board, *ent.Pixel, error) {
+	b, err := findBoard(ctx, s.app.Client(), boardID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if pixelID < 0 || pixelID >= b.Width*b.Height {
+		return nil, nil, framework.NewValidationError("Pixel ID is out of bounds")
+	}
+
+	found, err := b.QueryPixels().
+		Where(pixel.PositionEQ(pixelID)).
+		WithUser().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		found = &ent.Pixel{Position: pixelID, Color: "white"}
+	} else if err != nil {
+		logrus.WithError(err).WithField("pixel_id", pixelID).Error("Failed to retrieve pixel")
+		return nil, nil, framework.NewInternalError("Failed to retrieve pixel")
+	}
+	found.Edges.Board = b
+
+	return b, found, nil
+}
+
+// PixelHistory returns the changes of a single pixel, newest first.
+func (s *Pixels) PixelHistory(ctx context.Context, boardID int, pixelID int, offset, limit int) (*ent.Board, []*ent.PixelChange, error) {
+	b, err := findBoard(ctx, s.app.Client(), boardID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if pixelID < 0 || pixelID >= b.Width*b.Height {
+		return nil, nil, framework.NewValidationError("Pixel ID is out of bounds")
+	}
+
+	changes, err := b.QueryChanges().
+		Where(pixelchange.PositionEQ(pixelID)).
+		Order(ent.Desc(pixelchange.FieldSeq)).
+		Offset(offset).
+		Limit(limit).
+		WithUser().
+		All(ctx)
+	if err != nil {
+		logrus.WithError(err).WithField("pixel_id", pixelID).Error("Failed to retrieve pixel history")
+		return nil, nil, framework.NewInternalError("Failed to retrieve pixel history")
+	}
+
+	for _, change := range changes {
+		change.Edges.Board = b
 	}
 
-	return board, nil
+	return b, changes, nil
 }
@@ -1,8 +1,12 @@
 package service
 
 import (
+	"bytes"
 	"context"
+	"image/png"
 	"nevissGo/ent/pixel"
+	"nevissGo/pkg/palette"
+	"nevissGo/pkg/render"
 	"testing"
 	"time"
 
@@ -19,6 +23,7 @@ type PixelsSuite struct {
 	ctx      context.Context
 	cooldown time.Duration
 	user     *ent.User
+	board    *ent.Board
 	bridge   TestingBridge
 }
 
@@ -30,7 +35,7 @@ func (s *PixelsSuite) SetupTest() {
 	s.app = framework.NewTestingApp(s.T())
 	s.bridge = TestBridge(s.T())
 	s.cooldown = 2 * time.Second
-	s.service = NewPixels(s.app.App, s.bridge.Bridge, s.cooldown, 10, 10, 1)
+	s.service = NewPixels(s.app.App, s.bridge.Bridge)
 	s.ctx = context.Background()
 
 	var err error
@@ -39,22 +44,31 @@ func (s *PixelsSuite) SetupTest() {
 		SetGameID("game123").
 		Save(s.ctx)
 	s.NoError(err)
+
+	s.board, err = NewBoards(s.app.App).Create(s.ctx, s.user.ID, BoardSettings{
+		Name:     "test",
+		Width:    10,
+		Height:   10,
+		Cooldown: s.cooldown,
+		HypeCost: 1,
+	})
+	s.NoError(err)
 }
 
 func (s *PixelsSuite) TestUpdateColorCreatePixel() {
 	validPixelID := 5
-	newColor := "green"
+	newColor := "green-light"
 
 	s.bridge.Hype.On("UseHypeTX", mock.Anything, mock.Anything, s.user.ID, 1).Return(nil)
 	defer s.bridge.Hype.AssertExpectations(s.T())
 
-	err := s.service.UpdateColor(s.ctx, validPixelID, newColor, s.user.ID)
+	_, err := s.service.UpdateColor(s.ctx, s.board.ID, validPixelID, newColor, s.user.ID)
 
 	s.NoError(err)
 
 	createdPixel, err := s.app.Client().Pixel.
 		Query().
-		Where(pixel.IDEQ(validPixelID)).
+		Where(pixel.PositionEQ(validPixelID)).
 		WithUser().
 		Only(s.ctx)
 	s.NoError(err)
@@ -64,12 +78,13 @@ func (s *PixelsSuite) TestUpdateColorCreatePixel() {
 
 func (s *PixelsSuite) TestUpdateColorUpdateExistingPixel() {
 	pixelID := 3
-	existingColor := "red"
-	newColor := "blue"
+	existingColor := "red-dark"
+	newColor := "blue-light"
 
 	err := s.app.TX(s.ctx, func(tx *ent.Tx) error {
 		_, err := tx.Pixel.Create().
-			SetID(pixelID).
+			SetBoard(s.board).
+			SetPosition(pixelID).
 			SetColor(existingColor).
 			SetUpdatedAt(time.Now().Add(-3 * time.Second)).
 			SetUserID(s.user.ID).
@@ -81,13 +96,13 @@ func (s *PixelsSuite) TestUpdateColorUpdateExistingPixel() {
 	s.bridge.Hype.On("UseHypeTX", mock.Anything, mock.Anything, s.user.ID, 1).Return(nil)
 	defer s.bridge.Hype.AssertExpectations(s.T())
 
-	err = s.service.UpdateColor(s.ctx, pixelID, newColor, s.user.ID)
+	_, err = s.service.UpdateColor(s.ctx, s.board.ID, pixelID, newColor, s.user.ID)
 
 	s.NoError(err)
 
 	updatedPixel, err := s.app.Client().Pixel.
 		Query().
-		Where(pixel.IDEQ(pixelID)).
+		Where(pixel.PositionEQ(pixelID)).
 		WithUser().
 		Only(s.ctx)
 	s.NoError(err)
@@ -97,11 +112,12 @@ func (s *PixelsSuite) TestUpdateColorUpdateExistingPixel() {
 
 func (s *PixelsSuite) TestUpdateColorCooldownNotExpired() {
 	pixelID := 2
-	existingColor := "yellow"
+	existingColor := "yellow-dark"
 
 	err := s.app.TX(s.ctx, func(tx *ent.Tx) error {
 		_, err := tx.Pixel.Create().
-			SetID(pixelID).
+			SetBoard(s.board).
+			SetPosition(pixelID).
 			SetColor(existingColor).
 			SetUpdatedAt(time.Now()).
 			SetUserID(s.user.ID).
@@ -110,7 +126,7 @@ func (s *PixelsSuite) TestUpdateColorCooldownNotExpired() {
 	})
 	s.NoError(err)
 
-	err = s.service.UpdateColor(s.ctx, pixelID, "purple", s.user.ID)
+	_, err = s.service.UpdateColor(s.ctx, s.board.ID, pixelID, "purple-light", s.user.ID)
 
 	s.Error(err)
 	s.Equal(400, framework.ExtErrorCode(err))
@@ -121,7 +137,7 @@ func (s *PixelsSuite) TestUpdateColorInvalidPixelID() {
 	invalidPixelID := 100
 	newColor := "black"
 
-	err := s.service.UpdateColor(s.ctx, invalidPixelID, newColor, s.user.ID)
+	_, err := s.service.UpdateColor(s.ctx, s.board.ID, invalidPixelID, newColor, s.user.ID)
 
 	s.Error(err)
 	s.Equal(400, framework.ExtErrorCode(err))
@@ -130,12 +146,12 @@ func (s *PixelsSuite) TestUpdateColorInvalidPixelID() {
 
 func (s *PixelsSuite) TestUpdateColorUseHypeFailure() {
 	pixelID := 4
-	newColor := "orange"
+	newColor := "orange-dark"
 
 	s.bridge.Hype.On("UseHypeTX", mock.Anything, mock.Anything, s.user.ID, 1).Return(framework.NewInternalError("hype usage failed"))
 	defer s.bridge.Hype.AssertExpectations(s.T())
 
-	err := s.service.UpdateColor(s.ctx, pixelID, newColor, s.user.ID)
+	_, err := s.service.UpdateColor(s.ctx, s.board.ID, pixelID, newColor, s.user.ID)
 
 	s.Error(err)
 	s.Equal(500, framework.ExtErrorCode(err))
@@ -145,8 +161,9 @@ func (s *PixelsSuite) TestUpdateColorUseHypeFailure() {
 func (s *PixelsSuite) TestGetBoard() {
 	err := s.app.TX(s.ctx, func(tx *ent.Tx) error {
 		_, err := tx.Pixel.Create().
-			SetID(1).
-			SetColor("red").
+			SetBoard(s.board).
+			SetPosition(1).
+			SetColor("red-dark").
 			SetUpdatedAt(time.Now()).
 			SetUserID(s.user.ID).
 			Save(s.ctx)
@@ -154,11 +171,575 @@ func (s *PixelsSuite) TestGetBoard() {
 	})
 	s.NoError(err)
 
-	board, err := s.service.GetBoard(s.ctx)
+	board, err := s.service.GetBoard(s.ctx, s.board.ID)
 	s.NoError(err)
 	s.Equal(10, board.Width)
 	s.Equal(10, board.Height)
 	s.Len(board.Pixels, 100)
-	s.Equal("red", board.Pixels[1].Color)
+	s.Equal("red-dark", board.Pixels[1].Color)
 	s.Equal("white", board.Pixels[0].Color)
 }
+
+func (s *PixelsSuite) TestGetBoardIsCached() {
+	s.bridge.Hype.On("UseHypeTX", mock.Anything, mock.Anything, s.user.ID, 1).Return(nil)
+	defer s.bridge.Hype.AssertExpectations(s.T())
+
+	_, err := s.service.GetBoard(s.ctx, s.board.ID)
+	s.NoError(err)
+
+	painted, err := s.service.UpdateColor(s.ctx, s.board.ID, 1, "red-dark", s.user.ID)
+	s.NoError(err)
+
+	board, err := s.service.GetBoard(s.ctx, s.board.ID)
+	s.NoError(err)
+	s.Same(painted, board.Pixels[1])
+	s.Equal(painted.Seq, board.Seq)
+
+	// Changes made outside the service show up once they have a newer sequence.
+	s.NoError(s.app.Client().Pixel.UpdateOne(painted).SetColor("blue-light").SetSeq(painted.Seq + 1).Exec(s.ctx))
+	board, err = s.service.GetBoard(s.ctx, s.board.ID)
+	s.NoError(err)
+	s.Equal("blue-light", board.Pixels[1].Color)
+	s.Equal(s.user.ID, board.Pixels[1].Edges.User.ID)
+	s.Equal(painted.Seq+1, board.Seq)
+
+	// A resized board is loaded again.
+	_, err = NewBoards(s.app.App).Resize(s.ctx, s.board.ID, 12, 10)
+	s.NoError(err)
+	board, err = s.service.GetBoard(s.ctx, s.board.ID)
+	s.NoError(err)
+	s.Len(board.Pixels, 120)
+	s.Equal("blue-light", board.Pixels[1].Color)
+}
+
+func (s *PixelsSuite) TestCheckCache() {
+	s.bridge.Hype.On("UseHypeTX", mock.Anything, mock.Anything, s.user.ID, 1).Return(nil)
+	defer s.bridge.Hype.AssertExpectations(s.T())
+
+	painted, err := s.service.UpdateColor(s.ctx, s.board.ID, 1, "red-dark", s.user.ID)
+	s.NoError(err)
+	s.NoError(s.service.LoadCache(s.ctx))
+
+	fixed, err := s.service.CheckCache(s.ctx)
+	s.NoError(err)
+	s.Equal(0, fixed)
+
+	// A change without a new sequence is only found by the check.
+	s.NoError(s.app.Client().Pixel.UpdateOne(painted).SetColor("blue-light").Exec(s.ctx))
+	board, err := s.service.GetBoard(s.ctx, s.board.ID)
+	s.NoError(err)
+	s.Equal("red-dark", board.Pixels[1].Color)
+
+	fixed, err = s.service.CheckCache(s.ctx)
+	s.NoError(err)
+	s.Equal(1, fixed)
+
+	board, err = s.service.GetBoard(s.ctx, s.board.ID)
+	s.NoError(err)
+	s.Equal("blue-light", board.Pixels[1].Color)
+}
+
+func (s *PixelsSuite) TestBoardIndexed() {
+	s.bridge.Hype.On("UseHypeTX", mock.Anything, mock.Anything, s.user.ID, 1).Return(nil)
+	defer s.bridge.Hype.AssertExpectations(s.T())
+
+	_, err := s.service.UpdateColor(s.ctx, s.board.ID, 1, "blue-light", s.user.ID)
+	s.NoError(err)
+	_, err = s.app.Client().Pixel.Create().
+		SetBoard(s.board).
+		SetPosition(2).
+		SetColor("magenta").
+		SetSeq(2).
+		Save(s.ctx)
+	s.NoError(err)
+
+	board, err := s.service.GetBoard(s.ctx, s.board.ID)
+	s.NoError(err)
+
+	colors, indices, err := board.Indexed()
+	s.NoError(err)
+	s.Len(indices, 100)
+
+	white := palette.Default.Index("white")
+	s.Equal(palette.Default.Names(), colors[:len(palette.Default.Colors)])
+	s.Equal("magenta", colors[len(colors)-1])
+	s.Equal(byte(white), indices[0])
+	s.Equal(byte(palette.Default.Index("blue-light")), indices[1])
+	s.Equal(byte(len(colors)-1), indices[2])
+}
+
+func (s *PixelsSuite) TestGetPixel() {
+	s.bridge.Hype.On("UseHypeTX", mock.Anything, mock.Anything, s.user.ID, 1).Return(nil)
+	defer s.bridge.Hype.AssertExpectations(s.T())
+
+	_, err := s.service.UpdateColor(s.ctx, s.board.ID, 1, "blue-light", s.user.ID)
+	s.NoError(err)
+
+	_, painted, err := s.service.GetPixel(s.ctx, s.board.ID, 1)
+	s.NoError(err)
+	s.Equal("blue-light", painted.Color)
+	s.Equal(s.user.ID, painted.Edges.User.ID)
+
+	_, blank, err := s.service.GetPixel(s.ctx, s.board.ID, 2)
+	s.NoError(err)
+	s.Equal("white", blank.Color)
+	s.Nil(blank.Edges.User)
+
+	_, _, err = s.service.GetPixel(s.ctx, s.board.ID, 100)
+	s.Equal(400, framework.ExtErrorCode(err))
+}
+
+func (s *PixelsSuite) TestGetChunk() {
+	s.bridge.Hype.On("UseHypeTX", mock.Anything, mock.Anything, s.user.ID, 1).Return(nil)
+	defer s.bridge.Hype.AssertExpectations(s.T())
+
+	large, err := NewBoards(s.app.App).Create(s.ctx, s.user.ID, BoardSettings{
+		Name:     "large",
+		Width:    100,
+		Height:   70,
+		HypeCost: 1,
+	})
+	s.NoError(err)
+
+	pixelID := 65*100 + 70
+	painted, err := s.service.UpdateColor(s.ctx, large.ID, pixelID, "red-dark", s.user.ID)
+	s.NoError(err)
+
+	chunk, err := s.service.GetChunk(s.ctx, large.ID, 1, 1)
+	s.NoError(err)
+	s.Equal(36, chunk.Width)
+	s.Equal(6, chunk.Height)
+	s.Len(chunk.Pixels, 36*6)
+	s.Equal(64*100+64, chunk.Pixels[0].Position)
+	s.Equal(pixelID, chunk.Pixels[1*36+6].Position)
+	s.Equal("red-dark", chunk.Pixels[1*36+6].Color)
+	s.Equal(painted.Seq, chunk.Seq)
+	s.Equal(painted.Seq, chunk.BoardSeq)
+
+	untouched, err := s.service.GetChunk(s.ctx, large.ID, 0, 0)
+	s.NoError(err)
+	s.Equal(64, untouched.Width)
+	s.Equal(64, untouched.Height)
+	s.Equal(int64(0), untouched.Seq)
+	s.Equal(painted.Seq, untouched.BoardSeq)
+
+	_, err = s.service.GetChunk(s.ctx, large.ID, 2, 0)
+	s.Equal(400, framework.ExtErrorCode(err))
+	_, err = s.service.GetChunk(s.ctx, large.ID, 0, 2)
+	s.Equal(400, framework.ExtErrorCode(err))
+}
+
+func (s *PixelsSuite) TestUpdateColorAssignsSequence() {
+	s.bridge.Hype.On("UseHypeTX", mock.Anything, mock.Anything, s.user.ID, 1).Return(nil)
+	defer s.bridge.Hype.AssertExpectations(s.T())
+
+	first, err := s.service.UpdateColor(s.ctx, s.board.ID, 1, "red-dark", s.user.ID)
+	s.NoError(err)
+	s.Equal(int64(1), first.Seq)
+	s.Equal(s.user.ID, first.Edges.User.ID)
+
+	second, err := s.service.UpdateColor(s.ctx, s.board.ID, 2, "blue-light", s.user.ID)
+	s.NoError(err)
+	s.Equal(int64(2), second.Seq)
+
+	board, err := s.service.GetBoard(s.ctx, s.board.ID)
+	s.NoError(err)
+	s.Equal(int64(2), board.Seq)
+}
+
+func (s *PixelsSuite) TestGetBoardSince() {
+	s.bridge.Hype.On("UseHypeTX", mock.Anything, mock.Anything, s.user.ID, 1).Return(nil)
+	defer s.bridge.Hype.AssertExpectations(s.T())
+
+	for _, id := range []int{4, 5, 6} {
+		_, err := s.service.UpdateColor(s.ctx, s.board.ID, id, "red-dark", s.user.ID)
+		s.NoError(err)
+	}
+
+	_, pixels, seq, err := s.service.GetBoardSince(s.ctx, s.board.ID, 1)
+	s.NoError(err)
+	s.Equal(int64(3), seq)
+	s.Len(pixels, 2)
+	s.Equal(5, pixels[0].Position)
+	s.Equal(6, pixels[1].Position)
+	s.NotNil(pixels[0].Edges.User)
+
+	_, pixels, seq, err = s.service.GetBoardSince(s.ctx, s.board.ID, 3)
+	s.NoError(err)
+	s.Equal(int64(3), seq)
+	s.Empty(pixels)
+}
+
+func (s *PixelsSuite) TestUpdateColorClosedBoard() {
+	err := s.app.Client().Board.UpdateOne(s.board).SetOpen(false).Exec(s.ctx)
+	s.NoError(err)
+
+	_, err = s.service.UpdateColor(s.ctx, s.board.ID, 1, "red-dark", s.user.ID)
+
+	s.Error(err)
+	s.Equal(400, framework.ExtErrorCode(err))
+	s.Equal("Board is closed", framework.ExtErrorMessage(err))
+}
+
+func (s *PixelsSuite) TestBoardsAreIndependent() {
+	other, err := NewBoards(s.app.App).Create(s.ctx, s.user.ID, BoardSettings{
+		Name:     "other",
+		Width:    20,
+		Height:   20,
+		HypeCost: 3,
+	})
+	s.NoError(err)
+
+	s.bridge.Hype.On("UseHypeTX", mock.Anything, mock.Anything, s.user.ID, 1).Return(nil)
+	s.bridge.Hype.On("UseHypeTX", mock.Anything, mock.Anything, s.user.ID, 3).Return(nil)
+	defer s.bridge.Hype.AssertExpectations(s.T())
+
+	_, err = s.service.UpdateColor(s.ctx, s.board.ID, 1, "red-dark", s.user.ID)
+	s.NoError(err)
+	painted, err := s.service.UpdateColor(s.ctx, other.ID, 1, "blue-light", s.user.ID)
+	s.NoError(err)
+	s.Equal(int64(1), painted.Seq)
+
+	_, err = s.service.UpdateColor(s.ctx, other.ID, 150, "blue-light", s.user.ID)
+	s.NoError(err)
+
+	board, err := s.service.GetBoard(s.ctx, s.board.ID)
+	s.NoError(err)
+	s.Equal("red-dark", board.Pixels[1].Color)
+
+	board, err = s.service.GetBoard(s.ctx, other.ID)
+	s.NoError(err)
+	s.Len(board.Pixels, 400)
+	s.Equal("blue-light", board.Pixels[1].Color)
+	s.Equal("blue-light", board.Pixels[150].Color)
+}
+
+func (s *PixelsSuite) TestUpdateColorOutsidePalette() {
+	_, err := s.service.UpdateColor(s.ctx, s.board.ID, 1, "dark-purple", s.user.ID)
+
+	s.Error(err)
+	s.Equal(400, framework.ExtErrorCode(err))
+	s.Equal("Color is not in the board palette", framework.ExtErrorMessage(err))
+
+	classic, err := NewBoards(s.app.App).Create(s.ctx, s.user.ID, BoardSettings{
+		Name:     "classic",
+		Width:    10,
+		Height:   10,
+		HypeCost: 1,
+		Palette:  "classic",
+	})
+	s.NoError(err)
+
+	s.bridge.Hype.On("UseHypeTX", mock.Anything, mock.Anything, s.user.ID, 1).Return(nil)
+	defer s.bridge.Hype.AssertExpectations(s.T())
+
+	_, err = s.service.UpdateColor(s.ctx, classic.ID, 1, "dark-purple", s.user.ID)
+	s.NoError(err)
+}
+
+func (s *PixelsSuite) TestPixelHistory() {
+	s.bridge.Hype.On("UseHypeTX", mock.Anything, mock.Anything, s.user.ID, 1).Return(nil)
+	defer s.bridge.Hype.AssertExpectations(s.T())
+
+	_, err := s.service.UpdateColor(s.ctx, s.board.ID, 7, "red-dark", s.user.ID)
+	s.NoError(err)
+	_, err = s.app.Client().Pixel.Update().
+		SetUpdatedAt(time.Now().Add(-time.Minute)).
+		Save(s.ctx)
+	s.NoError(err)
+	_, err = s.service.UpdateColor(s.ctx, s.board.ID, 7, "blue-light", s.user.ID)
+	s.NoError(err)
+	_, err = s.service.UpdateColor(s.ctx, s.board.ID, 8, "gray", s.user.ID)
+	s.NoError(err)
+
+	_, changes, err := s.service.PixelHistory(s.ctx, s.board.ID, 7, 0, 10)
+	s.NoError(err)
+	s.Require().Len(changes, 2)
+	s.Equal("red-dark", changes[0].OldColor)
+	s.Equal("blue-light", changes[0].NewColor)
+	s.Equal("white", changes[1].OldColor)
+	s.Equal("red-dark", changes[1].NewColor)
+	s.Equal(s.user.ID, changes[0].Edges.User.ID)
+
+	_, changes, err = s.service.PixelHistory(s.ctx, s.board.ID, 7, 1, 10)
+	s.NoError(err)
+	s.Len(changes, 1)
+	s.Equal(int64(1), changes[0].Seq)
+}
+
+func (s *PixelsSuite) TestFailedPaintLeavesNoHistory() {
+	s.bridge.Hype.On("UseHypeTX", mock.Anything, mock.Anything, s.user.ID, 1).Return(framework.NewValidationError("not enough hype remaining"))
+	defer s.bridge.Hype.AssertExpectations(s.T())
+
+	_, err := s.service.UpdateColor(s.ctx, s.board.ID, 7, "red-dark", s.user.ID)
+	s.Error(err)
+
+	count, err := s.app.Client().PixelChange.Query().Count(s.ctx)
+	s.NoError(err)
+	s.Equal(0, count)
+}
+
+func (s *PixelsSuite) TestSnapshot() {
+	_, updatedAt, err := s.service.LastUpdatedAt(s.ctx, s.board.ID)
+	s.NoError(err)
+	s.Equal(s.board.CreatedAt.Unix(), updatedAt.Unix())
+
+	paintedAt := time.Now().Add(-time.Minute).Truncate(time.Second)
+	_, err = s.app.Client().Pixel.Create().
+		SetBoard(s.board).
+		SetPosition(11).
+		SetColor("black").
+		SetUpdatedAt(paintedAt).
+		SetUserID(s.user.ID).
+		SetSeq(1).
+		Save(s.ctx)
+	s.NoError(err)
+	s.NoError(s.app.Client().Board.UpdateOne(s.board).SetSeq(1).Exec(s.ctx))
+
+	board, updatedAt, err := s.service.LastUpdatedAt(s.ctx, s.board.ID)
+	s.NoError(err)
+	s.Equal(int64(1), board.Seq)
+	s.NoError(err)
+	s.True(paintedAt.Equal(updatedAt))
+
+	image, err := s.service.Snapshot(s.ctx, s.board.ID, render.SnapshotOptions{Scale: 2})
+	s.NoError(err)
+
+	decoded, err := png.Decode(bytes.NewReader(image))
+	s.NoError(err)
+	s.Equal(20, decoded.Bounds().Dx())
+	r, g, b, _ := decoded.At(2, 2).RGBA()
+	s.Equal([]uint32{0x4c4c, 0x4c4c, 0x4c4c}, []uint32{r, g, b})
+
+	// The image is only rendered again once the board sequence moves.
+	s.NoError(s.app.Client().Pixel.Create().
+		SetBoard(s.board).
+		SetPosition(2).
+		SetColor("black").
+		SetSeq(2).
+		Exec(s.ctx))
+	cached, err := s.service.Snapshot(s.ctx, s.board.ID, render.SnapshotOptions{Scale: 2})
+	s.NoError(err)
+	s.Equal(image, cached)
+	s.NoError(s.app.Client().Board.UpdateOne(s.board).SetSeq(2).Exec(s.ctx))
+	rendered, err := s.service.Snapshot(s.ctx, s.board.ID, render.SnapshotOptions{Scale: 2})
+	s.NoError(err)
+	s.NotEqual(image, rendered)
+
+	_, err = s.service.Snapshot(s.ctx, s.board.ID, render.SnapshotOptions{Scale: 0})
+	s.Equal(400, framework.ExtErrorCode(err))
+
+	huge, err := NewBoards(s.app.App).Create(s.ctx, s.user.ID, BoardSettings{Name: "huge", Width: 512, Height: 512})
+	s.NoError(err)
+	_, err = s.service.Snapshot(s.ctx, huge.ID, render.SnapshotOptions{Scale: 9})
+	s.Equal("Image would be too large, use a smaller scale", framework.ExtErrorMessage(err))
+}
+
+// racingHype lets a competing paint land inside the transaction right before
+// hype is spent, the latest point at which a real race can still interleave.
+type racingHype struct {
+	hype *Hype
+	race func(ctx context.Context, tx *ent.Tx)
+}
+
+func (r *racingHype) UseHypeTX(ctx context.Context, tx *ent.Tx, userID int64, amount int) error {
+	if r.race != nil {
+		r.race(ctx, tx)
+		r.race = nil
+	}
+	return r.hype.UseHypeTX(ctx, tx, userID, amount)
+}
+
+func (s *PixelsSuite) racingService(race func(ctx context.Context, tx *ent.Tx)) (*Pixels, *Hype) {
+	hype := NewHype(s.app.App, DefaultHypeConfig())
+	_, err := hype.Adjust(s.ctx, s.user.ID, "grant", -5, "start below max", 0)
+	s.NoError(err)
+
+	return NewPixels(s.app.App, Bridge{Hype: &racingHype{hype: hype, race: race}}), hype
+}
+
+func (s *PixelsSuite) TestUpdateColorConcurrentCreateConflicts() {
+	rival, err := s.app.Client().User.Create().
+		SetDisplayName("Rival").
+		SetGameID("rival").
+		Save(s.ctx)
+	s.NoError(err)
+
+	service, hype := s.racingService(func(ctx context.Context, tx *ent.Tx) {
+		s.NoError(tx.Pixel.Create().
+			SetBoard(s.board).
+			SetPosition(3).
+			SetColor("blue-dark").
+			SetUser(rival).
+			SetSeq(1).
+			Exec(ctx))
+	})
+
+	_, err = service.UpdateColor(s.ctx, s.board.ID, 3, "red-dark", s.user.ID)
+	s.Error(err)
+	s.Equal(409, framework.ExtErrorCode(err))
+
+	remaining, err := hype.GetHype(s.ctx, s.user.ID)
+	s.NoError(err)
+	s.Equal(5, remaining.AmountRemaining)
+}
+
+func (s *PixelsSuite) TestUpdateColorConcurrentUpdateConflicts() {
+	existing, err := s.app.Client().Pixel.Create().
+		SetBoard(s.board).
+		SetPosition(4).
+		SetColor("green-dark").
+		SetUser(s.user).
+		SetSeq(1).
+		SetUpdatedAt(time.Now().Add(-time.Hour)).
+		Save(s.ctx)
+	s.NoError(err)
+
+	service, hype := s.racingService(func(ctx context.Context, tx *ent.Tx) {
+		s.NoError(tx.Pixel.UpdateOne(existing).
+			SetColor("blue-dark").
+			SetSeq(2).
+			Exec(ctx))
+	})
+
+	_, err = service.UpdateColor(s.ctx, s.board.ID, 4, "red-dark", s.user.ID)
+	s.Error(err)
+	s.Equal(409, framework.ExtErrorCode(err))
+
+	remaining, err := hype.GetHype(s.ctx, s.user.ID)
+	s.NoError(err)
+	s.Equal(5, remaining.AmountRemaining)
+
+	unchanged, err := s.app.Client().Pixel.Get(s.ctx, existing.ID)
+	s.NoError(err)
+	s.Equal("green-dark", unchanged.Color)
+}
+
+func (s *PixelsSuite) TestUpdateColorUserCooldown() {
+	err := s.app.Client().Board.UpdateOne(s.board).SetUserCooldown(time.Minute).Exec(s.ctx)
+	s.NoError(err)
+
+	s.bridge.Hype.On("UseHypeTX", mock.Anything, mock.Anything, s.user.ID, 1).Return(nil).Once()
+
+	_, err = s.service.UpdateColor(s.ctx, s.board.ID, 1, "red-dark", s.user.ID)
+	s.NoError(err)
+
+	_, err = s.service.UpdateColor(s.ctx, s.board.ID, 2, "red-dark", s.user.ID)
+	s.Error(err)
+	s.Equal(429, framework.ExtErrorCode(err))
+	retryAfter := framework.ExtErrorFields(err)["retry_after"]
+	s.Greater(retryAfter, 0)
+	s.LessOrEqual(retryAfter, 60)
+
+	status, err := NewUsers(s.app.App).Status(s.ctx, s.board.ID, s.user.ID)
+	s.NoError(err)
+	s.Greater(status.RetryAfter, 59*time.Second)
+
+	other, err := NewBoards(s.app.App).Create(s.ctx, s.user.ID, BoardSettings{Name: "other", Width: 2, Height: 2})
+	s.NoError(err)
+	status, err = NewUsers(s.app.App).Status(s.ctx, other.ID, s.user.ID)
+	s.NoError(err)
+	s.Zero(status.RetryAfter)
+}
+
+func (s *PixelsSuite) TestUpdateColorsBatch() {
+	s.bridge.Hype.On("UseHypeTX", mock.Anything, mock.Anything, s.user.ID, 3).Return(nil).Once()
+
+	updated, err := s.service.UpdateColors(s.ctx, s.board.ID, []PixelPaint{
+		{PixelID: 7, Color: "red-dark"},
+		{PixelID: 2, Color: "blue-dark"},
+		{PixelID: 9, Color: "green-dark"},
+	}, s.user.ID)
+	s.NoError(err)
+	s.Require().Len(updated, 3)
+	s.Equal(7, updated[0].Position)
+	s.Equal(int64(1), updated[0].Seq)
+	s.Equal(int64(3), updated[2].Seq)
+
+	changes, err := s.app.Client().PixelChange.Query().Count(s.ctx)
+	s.NoError(err)
+	s.Equal(3, changes)
+}
+
+func (s *PixelsSuite) TestPaintsReserveBoardSeq() {
+	s.bridge.Hype.On("UseHypeTX", mock.Anything, mock.Anything, s.user.ID, 2).Return(nil).Once()
+	s.bridge.Hype.On("UseHypeTX", mock.Anything, mock.Anything, s.user.ID, 1).
+		Return(framework.NewValidationError("not enough hype remaining")).Once()
+	s.bridge.Hype.On("UseHypeTX", mock.Anything, mock.Anything, s.user.ID, 1).Return(nil).Once()
+	defer s.bridge.Hype.AssertExpectations(s.T())
+
+	_, err := s.service.UpdateColors(s.ctx, s.board.ID, []PixelPaint{
+		{PixelID: 1, Color: "red-dark"},
+		{PixelID: 2, Color: "red-dark"},
+	}, s.user.ID)
+	s.NoError(err)
+
+	// A paint that fails gives its numbers back with its transaction.
+	_, err = s.service.UpdateColor(s.ctx, s.board.ID, 3, "red-dark", s.user.ID)
+	s.Error(err)
+	painted, err := s.service.UpdateColor(s.ctx, s.board.ID, 3, "red-dark", s.user.ID)
+	s.NoError(err)
+	s.Equal(int64(3), painted.Seq)
+
+	_, wiped, err := NewModeration(s.app.App).Wipe(s.ctx, s.board.ID, 0, 0, 10, 1, 0)
+	s.NoError(err)
+	s.Require().Len(wiped, 3)
+	s.Equal([]int64{4, 5, 6}, []int64{wiped[0].Seq, wiped[1].Seq, wiped[2].Seq})
+
+	board, err := s.app.Client().Board.Get(s.ctx, s.board.ID)
+	s.NoError(err)
+	s.Equal(int64(6), board.Seq)
+}
+
+func (s *PixelsSuite) TestUpdateColorsBatchIsAllOrNothing() {
+	_, err := s.app.Client().Pixel.Create().
+		SetBoard(s.board).
+		SetPosition(4).
+		SetColor("green-dark").
+		SetUser(s.user).
+		SetSeq(1).
+		Save(s.ctx)
+	s.NoError(err)
+
+	// Pixel 4 is still on cooldown, so pixel 3 must not be painted either and
+	// no hype is spent.
+	_, err = s.service.UpdateColors(s.ctx, s.board.ID, []PixelPaint{
+		{PixelID: 3, Color: "red-dark"},
+		{PixelID: 4, Color: "red-dark"},
+	}, s.user.ID)
+	s.Error(err)
+	s.Equal(400, framework.ExtErrorCode(err))
+
+	_, err = s.service.UpdateColors(s.ctx, s.board.ID, []PixelPaint{
+		{PixelID: 3, Color: "red-dark"},
+		{PixelID: 5, Color: "dark-purple"},
+	}, s.user.ID)
+	s.Equal("Color is not in the board palette", framework.ExtErrorMessage(err))
+
+	s.bridge.Hype.On("UseHypeTX", mock.Anything, mock.Anything, s.user.ID, 2).
+		Return(framework.NewValidationError("not enough hype remaining")).Once()
+	_, err = s.service.UpdateColors(s.ctx, s.board.ID, []PixelPaint{
+		{PixelID: 3, Color: "red-dark"},
+		{PixelID: 5, Color: "red-dark"},
+	}, s.user.ID)
+	s.Equal("not enough hype remaining", framework.ExtErrorMessage(err))
+
+	count, err := s.app.Client().Pixel.Query().Count(s.ctx)
+	s.NoError(err)
+	s.Equal(1, count)
+}
+
+func (s *PixelsSuite) TestUpdateColorsBatchRejectsDuplicatesAndOversize() {
+	_, err := s.service.UpdateColors(s.ctx, s.board.ID, []PixelPaint{
+		{PixelID: 3, Color: "red-dark"},
+		{PixelID: 3, Color: "blue-dark"},
+	}, s.user.ID)
+	s.Equal("Pixel is painted twice in one batch", framework.ExtErrorMessage(err))
+
+	paints := make([]PixelPaint, MaxBatchPixels+1)
+	for i := range paints {
+		paints[i] = PixelPaint{PixelID: i, Color: "red-dark"}
+	}
+	_, err = s.service.UpdateColors(s.ctx, s.board.ID, paints, s.user.ID)
+	s.Equal(400, framework.ExtErrorCode(err))
+}
@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"nevissGo/ent"
+	"nevissGo/ent/region"
+	"nevissGo/ent/user"
+	"nevissGo/framework"
+)
+
+type RegionSettings struct {
+	Name   string
+	X      int
+	Y      int
+	Width  int
+	Height int
+	Mask   string
+}
+
+type Regions struct {
+	app *framework.App
+}
+
+func NewRegions(app *framework.App) *Regions {
+	return &Regions{
+		app: app,
+	}
+}
+
+// Create protects a rectangle of the board, optionally narrowed down by a
+// mask. Only the owner of the board and admins may protect parts of it.
+func (s *Regions) Create(ctx context.Context, boardID int, userID int64, settings RegionSettings) (*ent.Region, error) {
+	b, err := findBoard(ctx, s.app.Client(), boardID)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureBoardManager(ctx, s.app.Client(), b, userID); err != nil {
+		return nil, err
+	}
+
+	if settings.X < 0 || settings.Y < 0 || settings.Width <= 0 || settings.Height <= 0 ||
+		settings.X+settings.Width > b.Width || settings.Y+settings.Height > b.Height {
+		return nil, framework.NewValidationError("Region is out of bounds")
+	}
+	if settings.Mask != "" {
+		if len(settings.Mask) != settings.Width*settings.Height || strings.Trim(settings.Mask, "01") != "" {
+			return nil, framework.NewValidationError("Mask needs one 0 or 1 for every pixel of the region")
+		}
+	}
+
+	created, err := s.app.Client().Region.Create().
+		SetBoard(b).
+		SetCreatorID(userID).
+		SetName(settings.Name).
+		SetX(settings.X).
+		SetY(settings.Y).
+		SetWidth(settings.Width).
+		SetHeight(settings.Height).
+		SetMask(settings.Mask).
+		Save(ctx)
+	if err != nil {
+		logrus.WithError(err).WithField("board_id", b.ID).Error("Failed to create region")
+		return nil, framework.NewInternalError("Failed to create region")
+	}
+
+	return created, nil
+}
+
+func (s *Regions) List(ctx context.Context, boardID int) (*ent.Board, []*ent.Region, error) {
+	b, err := findBoard(ctx, s.app.Client(), boardID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	regions, err := b.QueryRegions().
+		Order(ent.Asc(region.FieldID)).
+		All(ctx)
+	if err != nil {
+		logrus.WithError(err).WithField("board_id", b.ID).Error("Failed to retrieve regions")
+		return nil, nil, framework.NewInternalError("Failed to retrieve regions")
+	}
+
+	return b, regions, nil
+}
+
+func (s *Regions) Delete(ctx context.Context, regionID int, userID int64) error {
+	r, err := s.app.Client().Region.Query().
+		Where(region.IDEQ(regionID)).
+		WithBoard(func(q *ent.BoardQuery) {
+			q.WithOwner()
+		}).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return framework.NewNotFoundError("Region not found")
+	}
+	if err != nil {
+		logrus.WithError(err).WithField("region_id", regionID).Error("Failed to retrieve region")
+		return framework.NewInternalError("Failed to retrieve region")
+	}
+
+	if err := ensureBoardManager(ctx, s.app.Client(), r.Edges.Board, userID); err != nil {
+		return err
+	}
+
+	if err := s.app.Client().Region.DeleteOne(r).Exec(ctx); err != nil {
+		logrus.WithError(err).WithField("region_id", regionID).Error("Failed to delete region")
+		return framework.NewInternalError("Failed to delete region")
+	}
+	return nil
+}
+
+// ensureBoardManager allows the owner of the board and admins.
+func ensureBoardManager(ctx context.Context, client *ent.Client, b *ent.Board, userID int64) error {
+	if b.Edges.Owner != nil && b.Edges.Owner.ID == userID {
+		return nil
+	}
+
+	isAdmin, err := client.User.Query().
+		Where(user.IDEQ(userID), user.RoleEQ(user.RoleAdmin)).
+		Exist(ctx)
+	if err != nil {
+		logrus.WithError(err).WithField("user_id", userID).Error("Failed to get user")
+		return framework.NewInternalError("Failed to get user")
+	}
+	if !isAdmin {
+		return framework.NewForbiddenError("Only the board owner or an admin can manage regions")
+	}
+	return nil
+}
+
+// protectingRegion returns the region that protects the pixel, if any.
+func protectingRegion(regions []*ent.Region, board *ent.Board, position int) *ent.Region {
+	x, y := position%board.Width, position/board.Width
+	for _, r := range regions {
+		if x < r.X || x >= r.X+r.Width || y < r.Y || y >= r.Y+r.Height {
+			continue
+		}
+		if r.Mask == "" || r.Mask[(y-r.Y)*r.Width+(x-r.X)] == '1' {
+			return r
+		}
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"nevissGo/ent"
+	"nevissGo/framework"
+)
+
+type RegionsSuite struct {
+	suite.Suite
+	app     *framework.TestingApp
+	service *Regions
+	pixels  *Pixels
+	bridge  TestingBridge
+	ctx     context.Context
+	owner   *ent.User
+	painter *ent.User
+	board   *ent.Board
+}
+
+func TestRegionsSuite(t *testing.T) {
+	suite.Run(t, new(RegionsSuite))
+}
+
+func (s *RegionsSuite) SetupTest() {
+	s.app = framework.NewTestingApp(s.T())
+	s.service = NewRegions(s.app.App)
+	s.bridge = TestBridge(s.T())
+	s.pixels = NewPixels(s.app.App, s.bridge.Bridge)
+	s.ctx = context.Background()
+
+	var err error
+	s.owner, err = s.app.Client().User.Create().
+		SetDisplayName("Owner").
+		SetGameID("owner").
+		Save(s.ctx)
+	s.NoError(err)
+	s.painter, err = s.app.Client().User.Create().
+		SetDisplayName("Painter").
+		SetGameID("painter").
+		Save(s.ctx)
+	s.NoError(err)
+
+	s.board, err = NewBoards(s.app.App).Create(s.ctx, s.owner.ID, BoardSettings{Name: "main", Width: 10, Height: 10})
+	s.NoError(err)
+}
+
+func (s *RegionsSuite) TestProtectedRectangleBlocksPaintBeforeHype() {
+	_, err := s.service.Create(s.ctx, s.board.ID, s.owner.ID, RegionSettings{Name: "logo", X: 2, Y: 1, Width: 3, Height: 2})
+	s.NoError(err)
+
+	// (4, 2) is the bottom right corner of the region. No hype expectation is
+	// set, so the mock fails the test if hype is charged.
+	_, err = s.pixels.UpdateColor(s.ctx, s.board.ID, 2*10+4, "red-dark", s.painter.ID)
+	s.Error(err)
+	s.Equal(403, framework.ExtErrorCode(err))
+	s.Equal("logo", framework.ExtErrorFields(err)["region"])
+
+	_, err = s.pixels.UpdateColors(s.ctx, s.board.ID, []PixelPaint{
+		{PixelID: 0, Color: "red-dark"},
+		{PixelID: 1*10 + 2, Color: "red-dark"},
+	}, s.painter.ID)
+	s.Equal(403, framework.ExtErrorCode(err))
+}
+
+func (s *RegionsSuite) TestMaskOnlyProtectsMarkedPixels() {
+	_, err := s.service.Create(s.ctx, s.board.ID, s.owner.ID, RegionSettings{
+		Name: "dot", X: 0, Y: 0, Width: 2, Height: 2, Mask: "1000",
+	})
+	s.NoError(err)
+
+	_, regions, err := s.service.List(s.ctx, s.board.ID)
+	s.NoError(err)
+	s.Require().Len(regions, 1)
+
+	s.NotNil(protectingRegion(regions, s.board, 0))
+	s.Nil(protectingRegion(regions, s.board, 1))
+	s.Nil(protectingRegion(regions, s.board, 10))
+	s.Nil(protectingRegion(regions, s.board, 11))
+}
+
+func (s *RegionsSuite) TestOnlyOwnerManagesRegions() {
+	_, err := s.service.Create(s.ctx, s.board.ID, s.painter.ID, RegionSettings{Name: "mine", Width: 1, Height: 1})
+	s.Equal(403, framework.ExtErrorCode(err))
+
+	created, err := s.service.Create(s.ctx, s.board.ID, s.owner.ID, RegionSettings{Name: "logo", Width: 1, Height: 1})
+	s.NoError(err)
+
+	s.Equal(403, framework.ExtErrorCode(s.service.Delete(s.ctx, created.ID, s.painter.ID)))
+	s.NoError(s.service.Delete(s.ctx, created.ID, s.owner.ID))
+	s.Equal(404, framework.ExtErrorCode(s.service.Delete(s.ctx, created.ID, s.owner.ID)))
+}
+
+func (s *RegionsSuite) TestInvalidRegions() {
+	_, err := s.service.Create(s.ctx, s.board.ID, s.owner.ID, RegionSettings{Name: "wide", X: 8, Width: 3, Height: 1})
+	s.Equal("Region is out of bounds", framework.ExtErrorMessage(err))
+
+	_, err = s.service.Create(s.ctx, s.board.ID, s.owner.ID, RegionSettings{Name: "mask", Width: 2, Height: 1, Mask: "1"})
+	s.Equal(400, framework.ExtErrorCode(err))
+
+	_, err = s.service.Create(s.ctx, s.board.ID, s.owner.ID, RegionSettings{Name: "mask", Width: 2, Height: 1, Mask: "1x"})
+	s.Equal(400, framework.ExtErrorCode(err))
+}
@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"nevissGo/ent"
+	"nevissGo/ent/pixel"
+	"nevissGo/framework"
+	"nevissGo/pkg/render"
+)
+
+const (
+	maxSnapshotScale = 32
+	// maxSnapshotPixels caps the size of a rendered image, as the route is
+	// public.
+	maxSnapshotPixels = 4096 * 4096
+	// maxCachedSnapshots bounds how many rendered images are kept.
+	maxCachedSnapshots = 64
+)
+
+// snapshotCache keeps rendered images by board, scale and grid, along with
+// the board sequence number they were rendered at, so a board is only encoded
+// again once it changed.
+type snapshotCache struct {
+	mu     sync.Mutex
+	images map[snapshotKey]*cachedSnapshot
+}
+
+type snapshotKey struct {
+	boardID int
+	options render.SnapshotOptions
+}
+
+type cachedSnapshot struct {
+	seq      int64
+	width    int
+	height   int
+	image    []byte
+	lastUsed time.Time
+}
+
+func newSnapshotCache() *snapshotCache {
+	return &snapshotCache{
+		images: map[snapshotKey]*cachedSnapshot{},
+	}
+}
+
+func (c *snapshotCache) get(b *ent.Board, options render.SnapshotOptions) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cached, ok := c.images[snapshotKey{b.ID, options}]
+	if !ok || cached.seq != b.Seq || cached.width != b.Width || cached.height != b.Height {
+		return nil, false
+	}
+	cached.lastUsed = time.Now()
+	return cached.image, true
+}
+
+// put stores an image, making room by dropping the least recently used one.
+func (c *snapshotCache) put(b *ent.Board, options render.SnapshotOptions, image []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := snapshotKey{b.ID, options}
+	if _, ok := c.images[key]; !ok && len(c.images) >= maxCachedSnapshots {
+		var oldest snapshotKey
+		var oldestUse time.Time
+		for k, cached := range c.images {
+			if oldestUse.IsZero() || cached.lastUsed.Before(oldestUse) {
+				oldest, oldestUse = k, cached.lastUsed
+			}
+		}
+		delete(c.images, oldest)
+	}
+	c.images[key] = &cachedSnapshot{
+		seq:      b.Seq,
+		width:    b.Width,
+		height:   b.Height,
+		image:    image,
+		lastUsed: time.Now(),
+	}
+}
+
+// LastUpdatedAt returns when the board last changed, which is cheap enough to
+// answer conditional requests before rendering anything: the latest change
+// is the pixel holding the board sequence number. A board that was never
+// painted reports its creation time.
+func (s *Pixels) LastUpdatedAt(ctx context.Context, boardID int) (*ent.Board, time.Time, error) {
+	b, err := findBoard(ctx, s.app.Client(), boardID)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	if b.Seq == 0 {
+		return b, b.CreatedAt, nil
+	}
+
+	latest, err := b.QueryPixels().
+		Where(pixel.SeqEQ(b.Seq)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return b, b.CreatedAt, nil
+	}
+	if err != nil {
+		logrus.WithError(err).WithField("board_id", b.ID).Error("Failed to retrieve latest pixel")
+		return nil, time.Time{}, framework.NewInternalError("Failed to retrieve latest pixel")
+	}
+
+	return b, latest.UpdatedAt, nil
+}
+
+// Snapshot renders the current board as a PNG image. Images are cached until
+// the board sequence number moves, so repeated requests do not encode the
+// board again.
+func (s *Pixels) Snapshot(ctx context.Context, boardID int, options render.SnapshotOptions) ([]byte, error) {
+	if options.Scale < 1 || options.Scale > maxSnapshotScale {
+		return nil, framework.NewValidationError("Scale is out of range")
+	}
+
+	b, err := findBoard(ctx, s.app.Client(), boardID)
+	if err != nil {
+		return nil, err
+	}
+	if b.Width*options.Scale*b.Height*options.Scale > maxSnapshotPixels {
+		return nil, framework.NewValidationError("Image would be too large, use a smaller scale")
+	}
+	if image, ok := s.snapshots.get(b, options); ok {
+		return image, nil
+	}
+
+	// The pixels may be newer than b.Seq, which only makes the cached image
+	// fresher than its key.
+	board, err := s.GetBoard(ctx, b.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	canvas := render.NewCanvas(board.Width, board.Height, board.Palette)
+	for _, p := range board.Pixels {
+		canvas.Set(p.Position, p.Color)
+	}
+
+	image, err := canvas.PNG(options)
+	if err != nil {
+		logrus.WithError(err).WithField("board_id", board.ID).Error("Failed to encode board snapshot")
+		return nil, framework.NewInternalError("Failed to encode board snapshot")
+	}
+	s.snapshots.put(b, options, image)
+
+	return image, nil
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"nevissGo/ent"
+	"nevissGo/ent/pixelchange"
+	"nevissGo/ent/user"
+	"nevissGo/framework"
+)
+
+type GameStats struct {
+	Users         int
+	Admins        int
+	BannedUsers   int
+	Teams         int
+	Boards        int
+	Paints        int
+	PaintsToday   int
+	PaintersToday int
+	BoardStats    []BoardStats
+}
+
+type BoardStats struct {
+	Board         *ent.Board
+	PaintedPixels int
+	Paints        int
+}
+
+type Stats struct {
+	app *framework.App
+}
+
+func NewStats(app *framework.App) *Stats {
+	return &Stats{
+		app: app,
+	}
+}
+
+// Collect counts users, teams and paints across the whole game. "Today" is
+// the last 24 hours.
+func (s *Stats) Collect(ctx context.Context) (*GameStats, error) {
+	client := s.app.Client()
+	since := time.Now().Add(-24 * time.Hour)
+	stats := &GameStats{}
+
+	counts := []struct {
+		target *int
+		count  func() (int, error)
+	}{
+		{&stats.Users, func() (int, error) { return client.User.Query().Count(ctx) }},
+		{&stats.Admins, func() (int, error) { return client.User.Query().Where(user.RoleEQ(user.RoleAdmin)).Count(ctx) }},
+		{&stats.BannedUsers, func() (int, error) { return client.User.Query().Where(user.BannedAtNotNil()).Count(ctx) }},
+		{&stats.Teams, func() (int, error) { return client.Team.Query().Count(ctx) }},
+		{&stats.Paints, func() (int, error) { return client.PixelChange.Query().Where(pixelchange.UserIDNotNil()).Count(ctx) }},
+		{&stats.PaintsToday, func() (int, error) {
+			return client.PixelChange.Query().
+				Where(pixelchange.UserIDNotNil(), pixelchange.CreatedAtGTE(since)).
+				Count(ctx)
+		}},
+	}
+	for _, c := range counts {
+		n, err := c.count()
+		if err != nil {
+			logrus.WithError(err).Error("Failed to collect stats")
+			return nil, framework.NewInternalError("Failed to collect stats")
+		}
+		*c.target = n
+	}
+
+	painters, err := client.PixelChange.Query().
+		Where(pixelchange.UserIDNotNil(), pixelchange.CreatedAtGTE(since)).
+		Unique(true).
+		Select(pixelchange.FieldUserID).
+		Ints(ctx)
+	if err != nil {
+		logrus.WithError(err).Error("Failed to collect stats")
+		return nil, framework.NewInternalError("Failed to collect stats")
+	}
+	stats.PaintersToday = len(painters)
+
+	boards, err := NewBoards(s.app).List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	stats.Boards = len(boards)
+
+	for _, b := range boards {
+		painted, err := b.QueryPixels().Count(ctx)
+		if err != nil {
+			logrus.WithError(err).WithField("board_id", b.ID).Error("Failed to collect stats")
+			return nil, framework.NewInternalError("Failed to collect stats")
+		}
+		paints, err := b.QueryChanges().Where(pixelchange.UserIDNotNil()).Count(ctx)
+		if err != nil {
+			logrus.WithError(err).WithField("board_id", b.ID).Error("Failed to collect stats")
+			return nil, framework.NewInternalError("Failed to collect stats")
+		}
+
+		stats.BoardStats = append(stats.BoardStats, BoardStats{
+			Board:         b,
+			PaintedPixels: painted,
+			Paints:        paints,
+		})
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"github.com/teris-io/shortid"
+	"nevissGo/ent"
+	"nevissGo/ent/team"
+	"nevissGo/framework"
+)
+
+type Teams struct {
+	app *framework.App
+}
+
+func NewTeams(app *framework.App) *Teams {
+	return &Teams{
+		app: app,
+	}
+}
+
+// Create makes a new team with the user as its first member. Players can only
+// be in one team at a time.
+func (s *Teams) Create(ctx context.Context, userID int64, name string) (*ent.Team, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, framework.NewValidationError("Team name is required")
+	}
+
+	var created *ent.Team
+	err := s.app.TX(ctx, func(tx *ent.Tx) error {
+		member, err := tx.User.Get(ctx, userID)
+		if err != nil {
+			logrus.WithError(err).WithField("user_id", userID).Error("Failed to get user")
+			return framework.NewInternalError("Failed to get user")
+		}
+		if member.TeamID != nil {
+			return framework.NewValidationError("Leave your current team first")
+		}
+
+		created, err = tx.Team.Create().
+			SetName(name).
+			SetInviteCode(shortid.MustGenerate()).
+			AddMemberIDs(userID).
+			Save(ctx)
+		if err != nil {
+			logrus.WithError(err).WithField("name", name).Error("Failed to create team")
+			return framework.NewInternalError("Failed to create team")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.app.ChannelsChanged(ctx, userID)
+
+	return s.Get(ctx, created.ID)
+}
+
+// Join adds the user to the team with the given invite code.
+func (s *Teams) Join(ctx context.Context, userID int64, inviteCode string) (*ent.Team, error) {
+	var joined *ent.Team
+	err := s.app.TX(ctx, func(tx *ent.Tx) error {
+		member, err := tx.User.Get(ctx, userID)
+		if err != nil {
+			logrus.WithError(err).WithField("user_id", userID).Error("Failed to get user")
+			return framework.NewInternalError("Failed to get user")
+		}
+
+		joined, err = tx.Team.Query().
+			Where(team.InviteCodeEQ(inviteCode)).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			return framework.NewNotFoundError("Team not found")
+		}
+		if err != nil {
+			logrus.WithError(err).Error("Failed to retrieve team")
+			return framework.NewInternalError("Failed to retrieve team")
+		}
+
+		if member.TeamID != nil {
+			if *member.TeamID == joined.ID {
+				return nil
+			}
+			return framework.NewValidationError("Leave your current team first")
+		}
+
+		if err := member.Update().SetTeam(joined).Exec(ctx); err != nil {
+			logrus.WithError(err).WithField("user_id", userID).Error("Failed to join team")
+			return framework.NewInternalError("Failed to join team")
+		}
+		return s.app.Enqueue(ctx, tx, framework.TeamEvent(joined.ID, "team:member_joined", TeamMemberEvent{TeamID: joined.ID, User: member}))
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.app.ChannelsChanged(ctx, userID)
+
+	return s.Get(ctx, joined.ID)
+}
+
+// Leave removes the user from their team and returns the team they left.
+// Pixels already painted for the team keep counting for it.
+func (s *Teams) Leave(ctx context.Context, userID int64) (*ent.Team, error) {
+	var left *ent.Team
+	err := s.app.TX(ctx, func(tx *ent.Tx) error {
+		member, err := tx.User.Get(ctx, userID)
+		if err != nil {
+			logrus.WithError(err).WithField("user_id", userID).Error("Failed to get user")
+			return framework.NewInternalError("Failed to get user")
+		}
+		if member.TeamID == nil {
+			return framework.NewValidationError("You are not in a team")
+		}
+
+		left, err = member.QueryTeam().Only(ctx)
+		if err != nil {
+			logrus.WithError(err).WithField("user_id", userID).Error("Failed to retrieve team")
+			return framework.NewInternalError("Failed to retrieve team")
+		}
+
+		if err := member.Update().ClearTeam().Exec(ctx); err != nil {
+			logrus.WithError(err).WithField("user_id", userID).Error("Failed to leave team")
+			return framework.NewInternalError("Failed to leave team")
+		}
+		return s.app.Enqueue(ctx, tx, framework.TeamEvent(left.ID, "team:member_left", TeamMemberEvent{TeamID: left.ID, User: member}))
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.app.ChannelsChanged(ctx, userID)
+
+	return left, nil
+}
+
+// Get loads a team with its members.
+func (s *Teams) Get(ctx context.Context, teamID int) (*ent.Team, error) {
+	t, err := s.app.Client().Team.Query().
+		Where(team.IDEQ(teamID)).
+		WithMembers().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, framework.NewNotFoundError("Team not found")
+	}
+	if err != nil {
+		logrus.WithError(err).WithField("team_id", teamID).Error("Failed to retrieve team")
+		return nil, framework.NewInternalError("Failed to retrieve team")
+	}
+
+	return t, nil
+}
+
+// ForUser returns the team the user is currently in.
+func (s *Teams) ForUser(ctx context.Context, userID int64) (*ent.Team, error) {
+	member, err := s.app.Client().User.Get(ctx, userID)
+	if err != nil {
+		logrus.WithError(err).WithField("user_id", userID).Error("Failed to get user")
+		return nil, framework.NewInternalError("Failed to get user")
+	}
+	if member.TeamID == nil {
+		return nil, framework.NewNotFoundError("You are not in a team")
+	}
+
+	return s.Get(ctx, *member.TeamID)
+}
@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"nevissGo/ent"
+	"nevissGo/framework"
+)
+
+type TeamsSuite struct {
+	suite.Suite
+	app     *framework.TestingApp
+	service *Teams
+	ctx     context.Context
+	alice   *ent.User
+	bob     *ent.User
+}
+
+func TestTeamsSuite(t *testing.T) {
+	suite.Run(t, new(TeamsSuite))
+}
+
+func (s *TeamsSuite) SetupTest() {
+	s.app = framework.NewTestingApp(s.T())
+	s.service = NewTeams(s.app.App)
+	s.ctx = context.Background()
+
+	var err error
+	s.alice, err = s.app.Client().User.Create().
+		SetDisplayName("Alice").
+		SetGameID("alice").
+		Save(s.ctx)
+	s.NoError(err)
+	s.bob, err = s.app.Client().User.Create().
+		SetDisplayName("Bob").
+		SetGameID("bob").
+		Save(s.ctx)
+	s.NoError(err)
+}
+
+func (s *TeamsSuite) TestCreateAndJoin() {
+	_, err := s.service.Create(s.ctx, s.alice.ID, "   ")
+	s.Equal("Team name is required", framework.ExtErrorMessage(err))
+
+	team, err := s.service.Create(s.ctx, s.alice.ID, " Reds ")
+	s.NoError(err)
+	s.Equal("Reds", team.Name)
+	s.NotEmpty(team.InviteCode)
+	s.Len(team.Edges.Members, 1)
+
+	joined, err := s.service.Join(s.ctx, s.bob.ID, team.InviteCode)
+	s.NoError(err)
+	s.Equal(team.ID, joined.ID)
+	s.Len(joined.Edges.Members, 2)
+
+	again, err := s.service.Join(s.ctx, s.bob.ID, team.InviteCode)
+	s.NoError(err)
+	s.Equal(team.ID, again.ID)
+
+	mine, err := s.service.ForUser(s.ctx, s.bob.ID)
+	s.NoError(err)
+	s.Equal(team.ID, mine.ID)
+}
+
+func (s *TeamsSuite) TestOneTeamAtATime() {
+	reds, err := s.service.Create(s.ctx, s.alice.ID, "Reds")
+	s.NoError(err)
+	blues, err := s.service.Create(s.ctx, s.bob.ID, "Blues")
+	s.NoError(err)
+
+	_, err = s.service.Create(s.ctx, s.alice.ID, "Greens")
+	s.Error(err)
+	s.Equal(400, framework.ExtErrorCode(err))
+
+	_, err = s.service.Join(s.ctx, s.alice.ID, blues.InviteCode)
+	s.Error(err)
+	s.Equal(400, framework.ExtErrorCode(err))
+
+	left, err := s.service.Leave(s.ctx, s.alice.ID)
+	s.NoError(err)
+	s.Equal(reds.ID, left.ID)
+
+	_, err = s.service.ForUser(s.ctx, s.alice.ID)
+	s.Equal(404, framework.ExtErrorCode(err))
+
+	_, err = s.service.Leave(s.ctx, s.alice.ID)
+	s.Equal(400, framework.ExtErrorCode(err))
+
+	_, err = s.service.Join(s.ctx, s.alice.ID, blues.InviteCode)
+	s.NoError(err)
+}
+
+func (s *TeamsSuite) TestJoinUnknownCode() {
+	_, err := s.service.Join(s.ctx, s.alice.ID, "nope")
+	s.Error(err)
+	s.Equal(404, framework.ExtErrorCode(err))
+}
+
+func (s *TeamsSuite) TestPixelsAttributedToTeamAtPaintTime() {
+	bridge := TestBridge(s.T())
+	bridge.Hype.On("UseHypeTX", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	pixels := NewPixels(s.app.App, bridge.Bridge)
+	leaderboard := NewLeaderboard(s.app.App)
+
+	board, err := NewBoards(s.app.App).Create(s.ctx, s.alice.ID, BoardSettings{Name: "main", Width: 4, Height: 4})
+	s.NoError(err)
+
+	reds, err := s.service.Create(s.ctx, s.alice.ID, "Reds")
+	s.NoError(err)
+
+	painted, err := pixels.UpdateColor(s.ctx, board.ID, 0, "red-dark", s.alice.ID)
+	s.NoError(err)
+	s.Require().NotNil(painted.TeamID)
+	s.Equal(reds.ID, *painted.TeamID)
+	_, err = pixels.UpdateColor(s.ctx, board.ID, 1, "red-dark", s.alice.ID)
+	s.NoError(err)
+
+	_, err = s.service.Leave(s.ctx, s.alice.ID)
+	s.NoError(err)
+
+	result, err := leaderboard.TopTeams(s.ctx, board.ID, MetricOwned, WindowAll, 10, reds.ID)
+	s.NoError(err)
+	s.Len(result.Entries, 1)
+	s.Equal(2, result.Mine.Score)
+
+	repainted, err := pixels.UpdateColor(s.ctx, board.ID, 1, "blue-dark", s.bob.ID)
+	s.NoError(err)
+	s.Nil(repainted.TeamID)
+
+	result, err = leaderboard.TopTeams(s.ctx, board.ID, MetricOwned, WindowAll, 10, reds.ID)
+	s.NoError(err)
+	s.Equal(1, result.Mine.Score)
+
+	paints, err := leaderboard.TopTeams(s.ctx, board.ID, MetricPaints, WindowDay, 10, reds.ID)
+	s.NoError(err)
+	s.Equal(2, paints.Mine.Score)
+}
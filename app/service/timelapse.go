@@ -0,0 +1,101 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"nevissGo/ent"
+	"nevissGo/ent/pixelchange"
+	"nevissGo/framework"
+	"nevissGo/pkg/render"
+)
+
+const (
+	maxTimelapseFrames = 1000
+	// maxTimelapsePixels caps the pixels of all frames together, which is
+	// what encoding a timelapse costs.
+	maxTimelapsePixels    = 1 << 28
+	defaultTimelapseDelay = 100 * time.Millisecond
+	defaultTimelapseScale = 8
+)
+
+type TimelapseOptions struct {
+	Interval   time.Duration
+	FrameDelay time.Duration
+	Scale      int
+}
+
+type Timelapse struct {
+	Board  *ent.Board
+	Frames int
+	GIF    []byte
+}
+
+// Timelapse replays the paint history of a board into an animated GIF.
+func (s *Boards) Timelapse(ctx context.Context, boardID int, options TimelapseOptions) (*Timelapse, error) {
+	if options.Interval <= 0 {
+		return nil, framework.NewValidationError("Timelapse interval must be positive")
+	}
+	if options.FrameDelay <= 0 {
+		options.FrameDelay = defaultTimelapseDelay
+	}
+	if options.Scale <= 0 {
+		options.Scale = defaultTimelapseScale
+	}
+
+	b, err := findBoard(ctx, s.app.Client(), boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := b.QueryChanges().
+		Order(ent.Asc(pixelchange.FieldSeq), ent.Asc(pixelchange.FieldID)).
+		All(ctx)
+	if err != nil {
+		logrus.WithError(err).WithField("board_id", b.ID).Error("Failed to retrieve board history")
+		return nil, framework.NewInternalError("Failed to retrieve board history")
+	}
+
+	changes := make([]render.Change, len(history))
+	for i, change := range history {
+		changes[i] = render.Change{
+			Position: change.Position,
+			Color:    change.NewColor,
+			At:       change.CreatedAt,
+		}
+	}
+
+	frames := render.FrameCount(changes, options.Interval)
+	if frames > maxTimelapseFrames {
+		return nil, framework.NewValidationError("Timelapse interval is too short for this board").WithFields(framework.Fields{
+			"frames":     frames,
+			"max_frames": maxTimelapseFrames,
+		})
+	}
+
+	if pixels := frames * b.Width * b.Height * options.Scale * options.Scale; pixels > maxTimelapsePixels {
+		return nil, framework.NewValidationError("Timelapse is too large, use a longer interval or a smaller scale").WithFields(framework.Fields{
+			"pixels":     pixels,
+			"max_pixels": maxTimelapsePixels,
+		})
+	}
+
+	var buf bytes.Buffer
+	rendered, err := render.Timelapse(&buf, b.Width, b.Height, boardPalette(b), changes, render.TimelapseOptions{
+		Interval:   options.Interval,
+		FrameDelay: options.FrameDelay,
+		Scale:      options.Scale,
+	})
+	if err != nil {
+		logrus.WithError(err).WithField("board_id", b.ID).Error("Failed to encode timelapse")
+		return nil, framework.NewInternalError("Failed to encode timelapse")
+	}
+
+	return &Timelapse{
+		Board:  b,
+		Frames: rendered,
+		GIF:    buf.Bytes(),
+	}, nil
+}
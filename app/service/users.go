@@ -2,10 +2,13 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/teris-io/shortid"
 	"nevissGo/ent"
+	"nevissGo/ent/pixelchange"
+	"nevissGo/ent/user"
 	"nevissGo/framework"
 )
 
@@ -23,7 +26,7 @@ func (s *Users) GetOrRegister(ctx context.Context, user *ent.User) error {
 	return s.app.TX(ctx, func(tx *ent.Tx) error {
 		existingUser, err := tx.User.Get(ctx, user.ID)
 		if ent.IsNotFound(err) {
-			_, err = tx.User.Create().
+			created, err := tx.User.Create().
 				SetID(user.ID).
 				SetDisplayName(user.DisplayName).
 				SetGameID(shortid.MustGenerate()).
@@ -32,6 +35,7 @@ func (s *Users) GetOrRegister(ctx context.Context, user *ent.User) error {
 				logrus.WithError(err).Error("Failed to create user")
 				return framework.NewInternalError("Failed to create user")
 			}
+			*user = *created
 			return nil
 		}
 
@@ -40,10 +44,67 @@ func (s *Users) GetOrRegister(ctx context.Context, user *ent.User) error {
 			return framework.NewInternalError("Failed to get user")
 		}
 
-		user = existingUser
+		*user = *existingUser
 		return nil
 	})
 }
 func (s *Users) Get(ctx context.Context, userID int64) (*ent.User, error) {
 	return s.app.Client().User.Get(ctx, userID)
 }
+
+func (s *Users) GetByGameID(ctx context.Context, gameID string) (*ent.User, error) {
+	found, err := s.app.Client().User.Query().
+		Where(user.GameIDEQ(gameID)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, framework.NewNotFoundError("User not found")
+	}
+	if err != nil {
+		logrus.WithError(err).WithField("game_id", gameID).Error("Failed to get user")
+		return nil, framework.NewInternalError("Failed to get user")
+	}
+
+	return found, nil
+}
+
+// History returns the paints of a user across all boards, newest first.
+func (s *Users) History(ctx context.Context, userID int64, offset, limit int) ([]*ent.PixelChange, error) {
+	changes, err := s.app.Client().PixelChange.Query().
+		Where(pixelchange.HasUserWith(user.IDEQ(userID))).
+		Order(ent.Desc(pixelchange.FieldCreatedAt), ent.Desc(pixelchange.FieldID)).
+		Offset(offset).
+		Limit(limit).
+		WithUser().
+		WithBoard().
+		All(ctx)
+	if err != nil {
+		logrus.WithError(err).WithField("user_id", userID).Error("Failed to retrieve user history")
+		return nil, framework.NewInternalError("Failed to retrieve user history")
+	}
+
+	return changes, nil
+}
+
+type UserStatus struct {
+	Board      *ent.Board
+	RetryAfter time.Duration
+}
+
+// Status tells whether the user may paint on the board right now and, if not,
+// how long they have to wait.
+func (s *Users) Status(ctx context.Context, boardID int, userID int64) (*UserStatus, error) {
+	b, err := findBoard(ctx, s.app.Client(), boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	retryAfter, err := userRetryAfter(ctx, s.app.Client(), b, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UserStatus{
+		Board:      b,
+		RetryAfter: retryAfter,
+	}, nil
+}
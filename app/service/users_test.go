@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	"nevissGo/ent"
@@ -39,6 +40,7 @@ func (s *UsersSuite) TestGetOrRegister() {
 	s.Equal(user.ID, createdUser.ID)
 	s.Equal(user.DisplayName, createdUser.DisplayName)
 	s.Require().NotEmpty(createdUser.GameID)
+	s.Equal(createdUser.GameID, user.GameID)
 
 	user.DisplayName = "3"
 	err = s.service.GetOrRegister(s.ctx, user)
@@ -49,3 +51,50 @@ func (s *UsersSuite) TestGetOrRegister() {
 	s.Equal(user.ID, updatedUser.ID)
 	s.Equal("1", updatedUser.DisplayName)
 }
+
+func (s *UsersSuite) TestHistory() {
+	user, err := s.app.Client().User.Create().
+		SetDisplayName("Painter").
+		SetGameID("game123").
+		Save(s.ctx)
+	s.NoError(err)
+
+	board, err := s.app.Client().Board.Create().
+		SetName("main").
+		SetWidth(10).
+		SetHeight(10).
+		Save(s.ctx)
+	s.NoError(err)
+
+	for i, color := range []string{"red-dark", "blue-light", "gray"} {
+		err := s.app.Client().PixelChange.Create().
+			SetBoard(board).
+			SetUser(user).
+			SetPosition(i).
+			SetOldColor("white").
+			SetNewColor(color).
+			SetSeq(int64(i + 1)).
+			SetCreatedAt(time.Now().Add(time.Duration(i) * time.Second)).
+			Exec(s.ctx)
+		s.NoError(err)
+	}
+
+	changes, err := s.service.History(s.ctx, user.ID, 0, 2)
+	s.NoError(err)
+	s.Require().Len(changes, 2)
+	s.Equal("gray", changes[0].NewColor)
+	s.Equal("blue-light", changes[1].NewColor)
+	s.Equal(board.ID, changes[0].Edges.Board.ID)
+
+	changes, err = s.service.History(s.ctx, user.ID, 2, 2)
+	s.NoError(err)
+	s.Require().Len(changes, 1)
+	s.Equal("red-dark", changes[0].NewColor)
+
+	found, err := s.service.GetByGameID(s.ctx, "game123")
+	s.NoError(err)
+	s.Equal(user.ID, found.ID)
+
+	_, err = s.service.GetByGameID(s.ctx, "missing")
+	s.Equal(404, framework.ExtErrorCode(err))
+}
@@ -0,0 +1,170 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"strconv"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"nevissGo/pkg/boardfile"
+)
+
+var boardCmd = &cobra.Command{
+	Use:   "board",
+	Short: "Manage boards from the shell",
+}
+
+var boardResizeCmd = &cobra.Command{
+	Use:   "resize <board-id> <width> <height>",
+	Short: "Grow a board, keeping every painted pixel in place",
+	Long: `Grow a board, keeping every painted pixel in place. Use 0 for the main
+board. A running server announces the new size, so connected players reload
+the board.`,
+	Args: cobra.ExactArgs(3),
+	Run: func(cmd *cobra.Command, args []string) {
+		boardID, width, height := parseInt(args[0]), parseInt(args[1]), parseInt(args[2])
+
+		cfg := loadConfig()
+		client := openClient(cfg)
+		defer client.Close()
+
+		board, err := newCommandServices(cfg, client).boards.Resize(context.Background(), boardID, width, height)
+		if err != nil {
+			logrus.WithError(err).Fatal("failed resizing board")
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"board_id": board.ID,
+			"width":    board.Width,
+			"height":   board.Height,
+		}).Info("board resized")
+	},
+}
+
+var boardClearCmd = &cobra.Command{
+	Use:   "clear <board-id>",
+	Short: "Paint a whole board white and clear its owners",
+	Long: `Paint a whole board white and clear its owners. Use 0 for the main board.
+The history is kept, so the board can still be rendered as a timelapse.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		boardID := parseInt(args[0])
+
+		cfg := loadConfig()
+		client := openClient(cfg)
+		defer client.Close()
+
+		services := newCommandServices(cfg, client)
+		board, err := services.boards.Get(context.Background(), boardID)
+		if err != nil {
+			logrus.WithError(err).Fatal("failed finding board")
+		}
+
+		_, wiped, err := services.moderation.Wipe(context.Background(), board.ID, 0, 0, board.Width, board.Height, 0)
+		if err != nil {
+			logrus.WithError(err).Fatal("failed clearing board")
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"board_id": board.ID,
+			"cleared":  len(wiped),
+		}).Info("board cleared")
+	},
+}
+
+var boardExportCmd = &cobra.Command{
+	Use:   "export <board-id>",
+	Short: "Write a board to a portable JSON or binary file",
+	Long: `Write a board's size, palette and painted pixels with their owners to a
+portable file. Use 0 for the main board. The file is written to stdout unless
+--out is given.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		boardID := parseInt(args[0])
+		format, _ := cmd.Flags().GetString("format")
+		out, _ := cmd.Flags().GetString("out")
+
+		cfg := loadConfig()
+		client := openClient(cfg)
+		defer client.Close()
+
+		board, file, err := newCommandServices(cfg, client).boards.Export(context.Background(), boardID)
+		if err != nil {
+			logrus.WithError(err).Fatal("failed exporting board")
+		}
+
+		w := os.Stdout
+		if out != "" {
+			w, err = os.Create(out)
+			if err != nil {
+				logrus.WithError(err).Fatal("failed creating export file")
+			}
+			defer w.Close()
+		}
+
+		if err := boardfile.Write(w, file, format); err != nil {
+			logrus.WithError(err).Fatal("failed writing board file")
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"board_id": board.ID,
+			"pixels":   len(file.Pixels),
+			"format":   format,
+		}).Info("board exported")
+	},
+}
+
+var boardImportCmd = &cobra.Command{
+	Use:   "import <file>",
+	Short: "Create a new board from an exported board file",
+	Long: `Create a new board from a file written by board export, in either format.
+Owners that are not users of this database are dropped.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		name, _ := cmd.Flags().GetString("name")
+
+		f, err := os.Open(args[0])
+		if err != nil {
+			logrus.WithError(err).Fatal("failed opening board file")
+		}
+		defer f.Close()
+
+		file, err := boardfile.Read(f)
+		if err != nil {
+			logrus.WithError(err).Fatal("failed reading board file")
+		}
+
+		cfg := loadConfig()
+		client := openClient(cfg)
+		defer client.Close()
+
+		board, err := newCommandServices(cfg, client).boards.Import(context.Background(), 0, file, name)
+		if err != nil {
+			logrus.WithError(err).Fatal("failed importing board")
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"board_id": board.ID,
+			"name":     board.Name,
+			"pixels":   len(file.Pixels),
+		}).Info("board imported")
+	},
+}
+
+func parseInt(arg string) int {
+	n, err := strconv.Atoi(arg)
+	if err != nil {
+		logrus.WithError(err).WithField("arg", arg).Fatal("expected a number")
+	}
+	return n
+}
+
+func init() {
+	rootCmd.AddCommand(boardCmd)
+	boardCmd.AddCommand(boardResizeCmd, boardClearCmd, boardExportCmd, boardImportCmd)
+
+	boardExportCmd.Flags().String("format", boardfile.FormatJSON, "json or binary")
+	boardExportCmd.Flags().String("out", "", "file to write the board to")
+	boardImportCmd.Flags().String("name", "", "name of the new board, defaults to the name in the file")
+}
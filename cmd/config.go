@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/joho/godotenv"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"nevissGo/config"
+)
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Inspect the configuration",
+}
+
+var configPrintCmd = &cobra.Command{
+	Use:   "print",
+	Short: "Print the effective configuration with secrets redacted",
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg := loadConfig()
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "KEY\tVALUE\tENV")
+		for _, f := range cfg.Fields() {
+			fmt.Fprintf(w, "%s\t%s\t%s\n", f.Key, f.String(), f.Env)
+		}
+		w.Flush()
+
+		if err := cfg.ValidateServe(); err != nil {
+			logrus.WithError(err).Warn("config is not complete enough to serve")
+		}
+	},
+}
+
+// loadConfig loads the .env file and builds the config from it, the config
+// file and the flags. Every command goes through it.
+func loadConfig() *config.Config {
+	_ = godotenv.Load()
+
+	path, _ := rootCmd.PersistentFlags().GetString("config")
+	if path == "" {
+		path = os.Getenv("CONFIG_FILE")
+	}
+
+	cfg, err := config.Load(path, rootCmd.PersistentFlags())
+	if err != nil {
+		logrus.WithError(err).Fatal("failed loading config")
+	}
+	return cfg
+}
+
+func init() {
+	rootCmd.AddCommand(configCmd)
+	configCmd.AddCommand(configPrintCmd)
+
+	rootCmd.PersistentFlags().String("config", "", "YAML config file, defaults to $CONFIG_FILE")
+	config.RegisterFlags(rootCmd.PersistentFlags())
+}
@@ -0,0 +1,217 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/sirupsen/logrus"
+	"nevissGo/app/serializer"
+	"nevissGo/app/service"
+	"nevissGo/config"
+	"nevissGo/ent"
+	"nevissGo/framework"
+
+	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/lib/pq"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// openClient connects to the database and migrates the schema. Every command
+// talking to the database goes through it.
+func openClient(cfg *config.Config) *ent.Client {
+	db := cfg.Database
+
+	driver, err := openDriver(db)
+	if err != nil {
+		logrus.WithError(err).WithField("driver", db.Driver).Fatal("failed opening connection to database")
+	}
+	client := ent.NewClient(ent.Driver(driver))
+
+	if err := renumberDuplicateSeqs(context.Background(), driver); err != nil {
+		logrus.WithError(err).Fatal("failed renumbering pixels")
+	}
+	if err := client.Schema.Create(context.Background()); err != nil {
+		logrus.WithError(err).Fatal("failed creating schema resources")
+	}
+	if err := syncBoardSeqs(context.Background(), driver); err != nil {
+		logrus.WithError(err).Fatal("failed updating board sequence numbers")
+	}
+
+	logrus.WithField("driver", db.Driver).Info("database connection established")
+
+	return client
+}
+
+// renumberDuplicateSeqs prepares older databases for the unique sequence
+// numbers of a board's pixels: pixels painted before sequence numbers existed
+// all share zero, and racing paints could share one. They get new numbers
+// after the last one of their board before the migration adds the index. A
+// database without the pixels table or its seq column has nothing to fix.
+func renumberDuplicateSeqs(ctx context.Context, driver *entsql.Driver) error {
+	rows, err := driver.DB().QueryContext(ctx, `SELECT p.id, p.board_pixels FROM pixels p
+		JOIN (SELECT board_pixels, seq FROM pixels GROUP BY board_pixels, seq HAVING COUNT(*) > 1) d
+		ON p.board_pixels = d.board_pixels AND p.seq = d.seq
+		ORDER BY p.board_pixels, p.seq, p.id`)
+	if err != nil {
+		logrus.WithError(err).Debug("skipping pixel renumbering")
+		return nil
+	}
+
+	duplicates := map[int][]int{}
+	var boards []int
+	for rows.Next() {
+		var id, boardID int
+		if err := rows.Scan(&id, &boardID); err != nil {
+			rows.Close()
+			return err
+		}
+		if duplicates[boardID] == nil {
+			boards = append(boards, boardID)
+		}
+		duplicates[boardID] = append(duplicates[boardID], id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	builder := entsql.Dialect(driver.Dialect())
+	for _, boardID := range boards {
+		query, args := builder.Select(entsql.Max("seq")).
+			From(entsql.Table("pixels")).
+			Where(entsql.EQ("board_pixels", boardID)).
+			Query()
+		var last int64
+		if err := driver.DB().QueryRowContext(ctx, query, args...).Scan(&last); err != nil {
+			return err
+		}
+
+		for _, id := range duplicates[boardID] {
+			last++
+			query, args := builder.Update("pixels").
+				Set("seq", last).
+				Where(entsql.EQ("id", id)).
+				Query()
+			if _, err := driver.DB().ExecContext(ctx, query, args...); err != nil {
+				return err
+			}
+		}
+		logrus.WithFields(logrus.Fields{
+			"board_id": boardID,
+			"pixels":   len(duplicates[boardID]),
+		}).Warn("Renumbered pixels sharing a sequence number")
+	}
+	return nil
+}
+
+// syncBoardSeqs moves board sequence numbers past the last one of their
+// pixels, for boards painted before boards kept their own.
+func syncBoardSeqs(ctx context.Context, driver *entsql.Driver) error {
+	_, err := driver.DB().ExecContext(ctx, `UPDATE boards
+		SET seq = (SELECT MAX(seq) FROM pixels WHERE pixels.board_pixels = boards.id)
+		WHERE seq < (SELECT COALESCE(MAX(seq), 0) FROM pixels WHERE pixels.board_pixels = boards.id)`)
+	return err
+}
+
+func openDriver(db config.Database) (*entsql.Driver, error) {
+	dsn := db.DSN
+	if db.Driver == "sqlite3" {
+		var err error
+		if dsn, err = sqliteDSN(dsn, db.BusyTimeout); err != nil {
+			return nil, err
+		}
+	}
+
+	driver, err := entsql.Open(db.Driver, dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	// SQLite has no server to protect, so it keeps the pool defaults and
+	// relies on the busy timeout when writers meet.
+	if db.Driver != "sqlite3" {
+		pool := driver.DB()
+		pool.SetMaxOpenConns(db.MaxOpenConns)
+		pool.SetMaxIdleConns(db.MaxIdleConns)
+		pool.SetConnMaxLifetime(db.ConnMaxLifetime)
+	}
+
+	if err := driver.DB().Ping(); err != nil {
+		driver.Close()
+		return nil, err
+	}
+	return driver, nil
+}
+
+// sqliteDSN adds the settings a shared SQLite file needs to a DSN, keeping
+// any the DSN sets itself: WAL so readers do not block the writer, a busy
+// timeout, foreign keys, and transactions that take the write lock when they
+// begin, as a transaction upgrading its lock later fails at once instead of
+// waiting.
+func sqliteDSN(dsn string, busyTimeout time.Duration) (string, error) {
+	path, rawQuery, _ := strings.Cut(dsn, "?")
+	if path == "" {
+		return "", fmt.Errorf("sqlite3 needs a database file")
+	}
+
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return "", fmt.Errorf("parsing sqlite3 dsn: %w", err)
+	}
+
+	defaults := map[string]string{
+		"_journal_mode": "WAL",
+		"_synchronous":  "NORMAL",
+		"_busy_timeout": fmt.Sprint(busyTimeout.Milliseconds()),
+		"_fk":           "1",
+		"_txlock":       "immediate",
+	}
+	for key, value := range defaults {
+		if !query.Has(key) {
+			query.Set(key, value)
+		}
+	}
+
+	return path + "?" + query.Encode(), nil
+}
+
+// newCommandApp builds an app for commands that use the services directly
+// without serving endpoints. Their events are stored in the outbox and sent
+// by the server.
+func newCommandApp(client *ent.Client) *framework.App {
+	app := framework.NewApp(client, framework.NewCentrifugoClient(nil), framework.Config{})
+	serializer.RegisterEvents(app)
+	return app
+}
+
+// commandServices are the services the operator commands work with.
+type commandServices struct {
+	users      *service.Users
+	boards     *service.Boards
+	hype       *service.Hype
+	moderation *service.Moderation
+	stats      *service.Stats
+}
+
+func newCommandServices(cfg *config.Config, client *ent.Client) *commandServices {
+	app := newCommandApp(client)
+
+	return &commandServices{
+		users:      service.NewUsers(app),
+		boards:     service.NewBoards(app),
+		hype:       service.NewHype(app, hypeConfig(cfg)),
+		moderation: service.NewModeration(app),
+		stats:      service.NewStats(app),
+	}
+}
+
+func hypeConfig(cfg *config.Config) service.HypeConfig {
+	return service.HypeConfig{
+		MaxHype:       cfg.Hype.Max,
+		HypePerMinute: cfg.Hype.PerMinute,
+	}
+}
@@ -0,0 +1,82 @@
+package cmd
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqliteDSN(t *testing.T) {
+	tests := []struct {
+		name     string
+		dsn      string
+		path     string
+		expected map[string]string
+	}{
+		{
+			name: "No parameters",
+			dsn:  "data/place.db",
+			path: "data/place.db",
+			expected: map[string]string{
+				"_journal_mode": "WAL",
+				"_synchronous":  "NORMAL",
+				"_busy_timeout": "5000",
+				"_fk":           "1",
+				"_txlock":       "immediate",
+			},
+		},
+		{
+			name: "Other parameters are kept",
+			dsn:  "file:data/place.db?cache=shared&mode=rwc",
+			path: "file:data/place.db",
+			expected: map[string]string{
+				"cache":         "shared",
+				"mode":          "rwc",
+				"_journal_mode": "WAL",
+				"_synchronous":  "NORMAL",
+				"_busy_timeout": "5000",
+				"_fk":           "1",
+				"_txlock":       "immediate",
+			},
+		},
+		{
+			name: "Operator settings win",
+			dsn:  "data/place.db?_txlock=deferred&_busy_timeout=100&_journal_mode=DELETE",
+			path: "data/place.db",
+			expected: map[string]string{
+				"_journal_mode": "DELETE",
+				"_synchronous":  "NORMAL",
+				"_busy_timeout": "100",
+				"_fk":           "1",
+				"_txlock":       "deferred",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dsn, err := sqliteDSN(tt.dsn, 5*time.Second)
+			require.NoError(t, err)
+
+			path, rawQuery, found := strings.Cut(dsn, "?")
+			require.True(t, found)
+			assert.Equal(t, tt.path, path)
+
+			query, err := url.ParseQuery(rawQuery)
+			require.NoError(t, err)
+			assert.Len(t, query, len(tt.expected))
+			for key, value := range tt.expected {
+				assert.Equal(t, []string{value}, query[key], key)
+			}
+		})
+	}
+}
+
+func TestSqliteDSNNeedsFile(t *testing.T) {
+	_, err := sqliteDSN("?_fk=1", time.Second)
+	assert.Error(t, err)
+}
@@ -6,18 +6,15 @@ package cmd
 import (
 	"context"
 	"github.com/centrifugal/gocent/v3"
-	"github.com/joho/godotenv"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"nevissGo/app/endpoint"
+	"nevissGo/app/serializer"
 	"nevissGo/app/service"
-	"nevissGo/ent"
+	"nevissGo/config"
 	"nevissGo/framework"
 	"nevissGo/telegram"
-	"os"
 	"time"
-
-	_ "github.com/go-sql-driver/mysql"
 )
 
 // serveCmd represents the serve command
@@ -25,50 +22,99 @@ var serveCmd = &cobra.Command{
 	Use:   "serve",
 	Short: "Serve",
 	Run: func(cmd *cobra.Command, args []string) {
-		_ = godotenv.Load()
-
-		client, err := ent.Open("mysql", os.Getenv("MYSQL_DSN"))
-		if err != nil {
-			logrus.WithError(err).Fatal("failed opening connection to mysql")
+		cfg := loadConfig()
+		if err := cfg.ValidateServe(); err != nil {
+			logrus.WithError(err).Fatal("failed loading config")
 		}
 
+		client := openClient(cfg)
 		defer client.Close()
 
-		if err := client.Schema.Create(context.Background()); err != nil {
-			logrus.WithError(err).Fatal("failed creating schema resources")
+		// SETUP APP
+		var events framework.Centrifugo
+		var hub *framework.Hub
+		if cfg.Events.Transport == config.EventsBuiltin {
+			hub = framework.NewHub(client, cfg.Auth.SecretKey)
+			events = hub
+		} else {
+			events = framework.NewCentrifugoClient(
+				gocent.New(gocent.Config{
+					Addr: cfg.Centrifugo.APIAddr,
+					Key:  cfg.Centrifugo.SecretKey,
+				}),
+			)
 		}
 
-		logrus.Info("mysql connection established")
-
-		// SETUP APP
 		app := framework.NewApp(
 			client,
-			framework.NewCentrifugoClient(
-				gocent.New(gocent.Config{
-					Addr: os.Getenv("CENTRIFUGO_ADDR_API"),
-					Key:  os.Getenv("CENTRIFUGO_SECRET_KEY"),
-				}),
-			),
+			events,
 			framework.Config{
-				Addr: ":8001",
+				Addr: cfg.Addr,
 			},
 		)
+		serializer.RegisterEvents(app)
+
+		boardsService := service.NewBoards(app)
+		_, err := boardsService.EnsureDefault(context.Background(), service.BoardSettings{
+			Name:         cfg.Board.Name,
+			Width:        cfg.Board.Width,
+			Height:       cfg.Board.Height,
+			Cooldown:     cfg.Board.Cooldown,
+			UserCooldown: cfg.Board.UserCooldown,
+			HypeCost:     cfg.Board.HypeCost,
+			Palette:      cfg.Board.Palette,
+		})
+		if err != nil {
+			logrus.WithError(err).Fatal("failed preparing default board")
+		}
 
-		hypeService := service.NewHype(app)
+		hypeService := service.NewHype(app, hypeConfig(cfg))
 
 		bridge := service.Bridge{
 			Hype: hypeService,
 		}
 
+		usersService := service.NewUsers(app)
+		pixelsService := service.NewPixels(app, bridge)
+		if err := pixelsService.LoadCache(context.Background()); err != nil {
+			logrus.WithError(err).Fatal("failed loading boards")
+		}
+		leaderboardService := service.NewLeaderboard(app)
+
 		app.RegisterEndpoints(
-			endpoint.NewUsers(service.NewUsers(app)),
-			endpoint.NewPixels(service.NewPixels(app, bridge, time.Microsecond, 40, 40, 1)),
+			endpoint.NewUsers(usersService, cfg.Auth, cfg.Telegram),
+			endpoint.NewBoards(boardsService),
+			endpoint.NewPixels(pixelsService, leaderboardService),
 			endpoint.NewHype(hypeService),
+			endpoint.NewPalette(),
 			endpoint.NewOnlineUsers(service.NewOnlineUsers(app)),
+			endpoint.NewLeaderboard(leaderboardService),
+			endpoint.NewTeams(service.NewTeams(app), leaderboardService, cfg.Auth),
+			endpoint.NewRegions(service.NewRegions(app)),
+			endpoint.NewModeration(service.NewModeration(app), usersService, leaderboardService),
 		)
+		if hub != nil {
+			app.RegisterEndpoints(hub)
+		}
+
+		go leaderboardService.Run(context.Background(), 5*time.Second, func(ctx context.Context, update *service.LeaderboardUpdate) {
+			err := app.Publish(ctx, framework.BroadcastEvent("leaderboard:updated", serializer.NewLeaderboardUpdated(update)))
+			if err != nil {
+				logrus.WithError(err).Error("failed publishing leaderboard")
+			}
+		})
+
+		go app.RunOutbox(context.Background(), time.Second)
+
+		go pixelsService.RunCacheCheck(context.Background(), time.Minute)
 
 		// SETUP BOT
-		bot, err := telegram.NewTelegram()
+		bot, err := telegram.NewTelegram(cfg.Telegram, telegram.Services{
+			Users:       usersService,
+			Pixels:      pixelsService,
+			Hype:        hypeService,
+			Leaderboard: leaderboardService,
+		})
 		if err != nil {
 			logrus.WithError(err).Fatal("failed creating telegram bot")
 		}
@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var statsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Print user, team and paint counts",
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg := loadConfig()
+		client := openClient(cfg)
+		defer client.Close()
+
+		stats, err := newCommandServices(cfg, client).stats.Collect(context.Background())
+		if err != nil {
+			logrus.WithError(err).Fatal("failed collecting stats")
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintf(w, "users\t%d\n", stats.Users)
+		fmt.Fprintf(w, "admins\t%d\n", stats.Admins)
+		fmt.Fprintf(w, "banned users\t%d\n", stats.BannedUsers)
+		fmt.Fprintf(w, "teams\t%d\n", stats.Teams)
+		fmt.Fprintf(w, "paints\t%d\n", stats.Paints)
+		fmt.Fprintf(w, "paints in the last 24h\t%d\n", stats.PaintsToday)
+		fmt.Fprintf(w, "painters in the last 24h\t%d\n", stats.PaintersToday)
+		fmt.Fprintln(w)
+
+		fmt.Fprintln(w, "board\tname\tsize\topen\tpainted pixels\tpaints")
+		for _, b := range stats.BoardStats {
+			fmt.Fprintf(w, "%d\t%s\t%dx%d\t%t\t%d\t%d\n",
+				b.Board.ID, b.Board.Name, b.Board.Width, b.Board.Height, b.Board.Open, b.PaintedPixels, b.Paints)
+		}
+		w.Flush()
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(statsCmd)
+}
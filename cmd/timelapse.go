@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"nevissGo/app/service"
+)
+
+var timelapseCmd = &cobra.Command{
+	Use:   "timelapse",
+	Short: "Render the paint history of a board into an animated GIF",
+	Run: func(cmd *cobra.Command, args []string) {
+		boardID, _ := cmd.Flags().GetInt("board")
+		interval, _ := cmd.Flags().GetDuration("interval")
+		delay, _ := cmd.Flags().GetDuration("delay")
+		scale, _ := cmd.Flags().GetInt("scale")
+		out, _ := cmd.Flags().GetString("out")
+
+		cfg := loadConfig()
+		client := openClient(cfg)
+		defer client.Close()
+
+		boards := service.NewBoards(newCommandApp(client))
+		timelapse, err := boards.Timelapse(context.Background(), boardID, service.TimelapseOptions{
+			Interval:   interval,
+			FrameDelay: delay,
+			Scale:      scale,
+		})
+		if err != nil {
+			logrus.WithError(err).Fatal("failed rendering timelapse")
+		}
+
+		if err := os.WriteFile(out, timelapse.GIF, 0o644); err != nil {
+			logrus.WithError(err).Fatal("failed writing timelapse")
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"board_id": timelapse.Board.ID,
+			"frames":   timelapse.Frames,
+			"out":      out,
+		}).Info("timelapse rendered")
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(timelapseCmd)
+
+	timelapseCmd.Flags().Int("board", 0, "board to render, defaults to the main board")
+	timelapseCmd.Flags().Duration("interval", time.Minute, "board time covered by each frame")
+	timelapseCmd.Flags().Duration("delay", 100*time.Millisecond, "how long each frame is shown")
+	timelapseCmd.Flags().Int("scale", 8, "size of a pixel in the rendered image")
+	timelapseCmd.Flags().String("out", "timelapse.gif", "file to write the GIF to")
+}
@@ -1,9 +1,13 @@
 package cmd
 
 import (
+	"os"
+	"text/template"
+
 	"github.com/spf13/cobra"
 	"github.com/tkrajina/typescriptify-golang-structs/typescriptify"
 	"nevissGo/app/serializer"
+	"nevissGo/pkg/palette"
 )
 
 var tsCmd = &cobra.Command{
@@ -16,16 +20,84 @@ var tsCmd = &cobra.Command{
 			Add(serializer.PixelSerializer{}).
 			Add(serializer.PixelWithUserSerializer{}).
 			Add(serializer.BoardSerializer{}).
+			Add(serializer.CompactBoardSerializer{}).
+			Add(serializer.ChunkSerializer{}).
+			Add(serializer.CompactChunkSerializer{}).
 			Add(serializer.HypeSerializer{}).
-			Add(serializer.UpdatedBoardSerializer{}).
+			Add(serializer.PixelUpdatedSerializer{}).
+			Add(serializer.PixelsUpdatedSerializer{}).
+			Add(serializer.BoardSinceSerializer{}).
+			Add(serializer.BoardResizedSerializer{}).
+			Add(serializer.BoardInfoSerializer{}).
+			Add(serializer.PaletteSerializer{}).
+			Add(serializer.PixelChangesSerializer{}).
+			Add(serializer.TimelapseSerializer{}).
+			Add(serializer.BoardExportSerializer{}).
+			Add(serializer.LeaderboardSerializer{}).
+			Add(serializer.LeaderboardUpdatedSerializer{}).
+			Add(serializer.TeamMembershipSerializer{}).
+			Add(serializer.TeamEventSerializer{}).
+			Add(serializer.TeamLeaderboardSerializer{}).
+			Add(serializer.HypeLedgerSerializer{}).
+			Add(serializer.UserStatusSerializer{}).
+			Add(serializer.RegionsSerializer{}).
+			Add(serializer.ModeratedUserSerializer{}).
+			Add(serializer.ModerationResultSerializer{}).
 			WithInterface(true)
 		err := converter.ConvertToFile("./ui/src/types/serializer.ts")
 		if err != nil {
 			panic(err.Error())
 		}
+
+		if err := writePalettes("./ui/src/types/colors.ts"); err != nil {
+			panic(err.Error())
+		}
 	},
 }
 
+var colorsTemplate = template.Must(template.New("colors").Parse(`/* Do not change, this code is generated from Golang palettes */
+
+export const Colors = [{{range $i, $c := .Default.Colors}}{{if $i}}, {{end}}'{{$c.Name}}'{{end}}] as const;
+
+export type Color = typeof Colors[number];
+
+export const Palettes: Record<string, { name: string, hex: string }[]> = {
+{{- range .All}}
+    '{{.Name}}': [
+{{- range .Colors}}
+        {name: '{{.Name}}', hex: '{{.Hex}}'},
+{{- end}}
+    ],
+{{- end}}
+};
+
+export function colorToHex(color: Color): string {
+    switch (color) {
+{{- range .Default.Colors}}
+        case '{{.Name}}':
+            return '{{.Hex}}';
+{{- end}}
+    }
+
+    return '#FFFFFF';
+}
+`))
+
+// writePalettes emits the registered palettes so the UI never drifts from the
+// colors the server accepts.
+func writePalettes(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return colorsTemplate.Execute(f, map[string]any{
+		"Default": palette.Default,
+		"All":     palette.All(),
+	})
+}
+
 func init() {
 	rootCmd.AddCommand(tsCmd)
 }
@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"nevissGo/ent"
+	"nevissGo/ent/hypeledger"
+	"nevissGo/ent/user"
+)
+
+var userCmd = &cobra.Command{
+	Use:   "user",
+	Short: "Manage users from the shell",
+}
+
+var userBanCmd = &cobra.Command{
+	Use:   "ban <game-id>",
+	Short: "Ban a user from every action",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		reason, _ := cmd.Flags().GetString("reason")
+
+		withUser(args[0], func(app *commandServices, target *ent.User) {
+			if _, err := app.moderation.Ban(context.Background(), target.ID, reason, 0); err != nil {
+				logrus.WithError(err).Fatal("failed banning user")
+			}
+			logrus.WithField("game_id", target.GameID).Info("user banned")
+		})
+	},
+}
+
+var userUnbanCmd = &cobra.Command{
+	Use:   "unban <game-id>",
+	Short: "Lift the ban of a user",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		withUser(args[0], func(app *commandServices, target *ent.User) {
+			if _, err := app.moderation.Unban(context.Background(), target.ID, 0); err != nil {
+				logrus.WithError(err).Fatal("failed unbanning user")
+			}
+			logrus.WithField("game_id", target.GameID).Info("user unbanned")
+		})
+	},
+}
+
+var userSetRoleCmd = &cobra.Command{
+	Use:   "set-role <game-id> <user|admin>",
+	Short: "Change the role of a user",
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		withUser(args[0], func(app *commandServices, target *ent.User) {
+			if _, err := app.moderation.SetRole(context.Background(), target.ID, user.Role(args[1]), 0); err != nil {
+				logrus.WithError(err).Fatal("failed setting user role")
+			}
+			logrus.WithFields(logrus.Fields{
+				"game_id": target.GameID,
+				"role":    args[1],
+			}).Info("user role changed")
+		})
+	},
+}
+
+var userGrantHypeCmd = &cobra.Command{
+	Use:   "grant-hype <game-id> <amount>",
+	Short: "Give hype to a user, or take it away with a negative amount",
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		amount, err := strconv.Atoi(args[1])
+		if err != nil {
+			logrus.WithError(err).Fatal("invalid amount")
+		}
+		reason, _ := cmd.Flags().GetString("reason")
+
+		withUser(args[0], func(app *commandServices, target *ent.User) {
+			entry, err := app.hype.Adjust(context.Background(), target.ID, hypeledger.KindGrant, amount, reason, 0)
+			if err != nil {
+				logrus.WithError(err).Fatal("failed granting hype")
+			}
+			logrus.WithFields(logrus.Fields{
+				"game_id": target.GameID,
+				"amount":  amount,
+				"balance": entry.Balance,
+			}).Info("hype granted")
+		})
+	},
+}
+
+// withUser opens the database and runs fn with the user of the given game ID.
+func withUser(gameID string, fn func(app *commandServices, target *ent.User)) {
+	cfg := loadConfig()
+	client := openClient(cfg)
+	defer client.Close()
+
+	app := newCommandServices(cfg, client)
+	target, err := app.users.GetByGameID(context.Background(), gameID)
+	if err != nil {
+		logrus.WithError(err).WithField("game_id", gameID).Fatal("failed finding user")
+	}
+
+	fn(app, target)
+}
+
+func init() {
+	rootCmd.AddCommand(userCmd)
+	userCmd.AddCommand(userBanCmd, userUnbanCmd, userSetRoleCmd, userGrantHypeCmd)
+
+	userBanCmd.Flags().String("reason", "", "reason shown to the banned user")
+	userGrantHypeCmd.Flags().String("reason", "", "reason recorded in the hype ledger")
+}
@@ -0,0 +1,205 @@
+// Package config holds the settings of the server and the operator commands.
+// Values are taken from the defaults, then an optional YAML file, then the
+// environment and finally command line flags, each overriding the one before.
+package config
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/spf13/pflag"
+	"gopkg.in/yaml.v3"
+	"nevissGo/pkg/palette"
+)
+
+// Every leaf field has a key made of its yaml names, like board.width, which
+// is also its flag, --board-width. Fields tagged env can be set from that
+// environment variable, or from the ones listed after it, which are kept for
+// older setups. Secret fields are redacted when printed and serve fields are
+// only required to run the server.
+type Config struct {
+	Addr       string     `yaml:"addr" env:"ADDR" usage:"address the API listens on" validate:"required"`
+	Database   Database   `yaml:"database"`
+	Auth       Auth       `yaml:"auth"`
+	Telegram   Telegram   `yaml:"telegram"`
+	Events     Events     `yaml:"events"`
+	Centrifugo Centrifugo `yaml:"centrifugo"`
+	Board      Board      `yaml:"board"`
+	Hype       Hype       `yaml:"hype"`
+}
+
+// Database selects the database the server and the commands use. The pool
+// settings apply to MySQL and PostgreSQL, the busy timeout to SQLite.
+type Database struct {
+	Driver          string        `yaml:"driver" env:"DB_DRIVER" usage:"database driver: mysql, postgres or sqlite3" validate:"oneof=mysql postgres sqlite3"`
+	DSN             string        `yaml:"dsn" env:"DB_DSN,MYSQL_DSN" secret:"true" usage:"data source name, a file path for sqlite3" validate:"required"`
+	MaxOpenConns    int           `yaml:"max_open_conns" env:"DB_MAX_OPEN_CONNS" usage:"connections open at most, zero for no limit" validate:"min=0"`
+	MaxIdleConns    int           `yaml:"max_idle_conns" env:"DB_MAX_IDLE_CONNS" usage:"idle connections kept open" validate:"min=0"`
+	ConnMaxLifetime time.Duration `yaml:"conn_max_lifetime" env:"DB_CONN_MAX_LIFETIME" usage:"how long a connection is reused, zero for ever" validate:"min=0"`
+	BusyTimeout     time.Duration `yaml:"busy_timeout" env:"DB_BUSY_TIMEOUT" usage:"how long SQLite waits for a locked database" validate:"min=0"`
+}
+
+type Auth struct {
+	SecretKey string `yaml:"secret_key" env:"SECRET_KEY" secret:"true" serve:"required" usage:"key the user tokens are signed with"`
+	// TestInitData replaces the TEST_TOKEN init data during development.
+	TestInitData string `yaml:"test_init_data" env:"TEST_TOKEN_REPLACE" secret:"true" usage:"init data used for the TEST_TOKEN login"`
+}
+
+type Telegram struct {
+	Token     string `yaml:"token" env:"TELEGRAM_TOKEN" secret:"true" serve:"required" usage:"Telegram bot token"`
+	WebAppURL string `yaml:"webapp_url" env:"WEBAPP_URL" usage:"URL of the web app opened from the bot" validate:"omitempty,url"`
+}
+
+const (
+	EventsCentrifugo = "centrifugo"
+	EventsBuiltin    = "builtin"
+)
+
+// Events selects how clients get live updates: through a separate Centrifugo
+// server or from the server itself over WebSocket or Server-Sent Events.
+type Events struct {
+	Transport string `yaml:"transport" env:"EVENTS_TRANSPORT" usage:"live updates transport: centrifugo or builtin" validate:"oneof=centrifugo builtin"`
+}
+
+type Centrifugo struct {
+	APIAddr   string `yaml:"api_addr" env:"CENTRIFUGO_ADDR_API" usage:"Centrifugo HTTP API address, required with the centrifugo transport"`
+	SecretKey string `yaml:"secret_key" env:"CENTRIFUGO_SECRET_KEY" secret:"true" usage:"Centrifugo API key"`
+}
+
+// Board holds the settings of the default board. They are only used when the
+// default board is created; existing boards are changed with the board
+// commands.
+type Board struct {
+	Name         string        `yaml:"name" env:"BOARD_NAME" usage:"name of the default board" validate:"required,max=64"`
+	Width        int           `yaml:"width" env:"BOARD_WIDTH" usage:"width of the default board" validate:"min=1,max=512"`
+	Height       int           `yaml:"height" env:"BOARD_HEIGHT" usage:"height of the default board" validate:"min=1,max=512"`
+	Cooldown     time.Duration `yaml:"cooldown" env:"BOARD_COOLDOWN" usage:"how long a pixel stays before it can be painted again" validate:"min=0"`
+	UserCooldown time.Duration `yaml:"user_cooldown" env:"BOARD_USER_COOLDOWN" usage:"how long a user waits between paints, zero to disable" validate:"min=0"`
+	HypeCost     int           `yaml:"hype_cost" env:"BOARD_HYPE_COST" usage:"hype spent on every painted pixel" validate:"min=0"`
+	Palette      string        `yaml:"palette" env:"BOARD_PALETTE" usage:"palette of the default board" validate:"palette"`
+}
+
+type Hype struct {
+	Max       int `yaml:"max" env:"HYPE_MAX" usage:"hype users without custom limits can hold" validate:"min=1"`
+	PerMinute int `yaml:"per_minute" env:"HYPE_PER_MINUTE" usage:"hype refilled every minute" validate:"min=1"`
+}
+
+func Default() *Config {
+	return &Config{
+		Addr: ":8001",
+		Database: Database{
+			Driver:          "mysql",
+			MaxOpenConns:    25,
+			MaxIdleConns:    10,
+			ConnMaxLifetime: 5 * time.Minute,
+			BusyTimeout:     5 * time.Second,
+		},
+		Events: Events{
+			Transport: EventsCentrifugo,
+		},
+		Board: Board{
+			Name:     "main",
+			Width:    40,
+			Height:   40,
+			Cooldown: time.Microsecond,
+			HypeCost: 1,
+			Palette:  palette.DefaultName,
+		},
+		Hype: Hype{
+			Max:       10,
+			PerMinute: 2,
+		},
+	}
+}
+
+// Load builds the effective config from the defaults, the YAML file at path
+// if it is not empty, the environment and the flags that were set, then
+// validates it.
+func Load(path string, flags *pflag.FlagSet) (*Config, error) {
+	c := Default()
+
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading config file: %w", err)
+		}
+		if err := yaml.Unmarshal(data, c); err != nil {
+			return nil, fmt.Errorf("parsing config file %s: %w", path, err)
+		}
+	}
+
+	// Empty variables count as unset, like in the .env file.
+	for _, f := range c.fields() {
+		for _, env := range f.envs {
+			if value := os.Getenv(env); value != "" {
+				if err := f.set(value); err != nil {
+					return nil, fmt.Errorf("%s: %w", env, err)
+				}
+				break
+			}
+		}
+	}
+
+	if flags != nil {
+		for _, f := range c.fields() {
+			if flag := flags.Lookup(f.Flag()); flag != nil && flag.Changed {
+				if err := f.set(flag.Value.String()); err != nil {
+					return nil, fmt.Errorf("--%s: %w", f.Flag(), err)
+				}
+			}
+		}
+	}
+
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+var validate = validator.New()
+
+func init() {
+	if err := validate.RegisterValidation("palette", func(fl validator.FieldLevel) bool {
+		_, ok := palette.Get(fl.Field().String())
+		return ok
+	}); err != nil {
+		panic(err)
+	}
+}
+
+// Validate checks the values every command relies on.
+func (c *Config) Validate() error {
+	if err := validate.Struct(c); err != nil {
+		return fmt.Errorf("invalid config: %w", err)
+	}
+	return nil
+}
+
+// ValidateServe also checks the values that only the server and the bot need.
+func (c *Config) ValidateServe() error {
+	for _, f := range c.fields() {
+		if f.Serve == "required" && f.Value.IsZero() {
+			return fmt.Errorf("invalid config: %s is required to serve (set %s or --%s)", f.Key, f.Env, f.Flag())
+		}
+	}
+	if c.Events.Transport == EventsCentrifugo && c.Centrifugo.APIAddr == "" {
+		return fmt.Errorf("invalid config: centrifugo.api_addr is required to serve with the centrifugo transport (set CENTRIFUGO_ADDR_API or --centrifugo-api-addr, or EVENTS_TRANSPORT=builtin)")
+	}
+	return nil
+}
+
+// RegisterFlags adds a flag for every setting, defaulting to the defaults.
+func RegisterFlags(flags *pflag.FlagSet) {
+	for _, f := range Default().fields() {
+		switch v := f.Value.Interface().(type) {
+		case time.Duration:
+			flags.Duration(f.Flag(), v, f.Usage)
+		case int:
+			flags.Int(f.Flag(), v, f.Usage)
+		case string:
+			flags.String(f.Flag(), v, f.Usage)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testFlags(t *testing.T, args ...string) *pflag.FlagSet {
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	RegisterFlags(flags)
+	require.NoError(t, flags.Parse(args))
+	return flags
+}
+
+func TestLoadPrecedence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+database:
+  dsn: file-dsn
+board:
+  width: 64
+  height: 32
+  cooldown: 5s
+hype:
+  max: 20
+`), 0o644))
+
+	t.Setenv("BOARD_HEIGHT", "48")
+	t.Setenv("HYPE_MAX", "30")
+	t.Setenv("HYPE_PER_MINUTE", "")
+
+	cfg, err := Load(path, testFlags(t, "--hype-max", "40", "--board-user-cooldown", "1m"))
+	require.NoError(t, err)
+
+	assert.Equal(t, "file-dsn", cfg.Database.DSN)
+	assert.Equal(t, 64, cfg.Board.Width)
+	assert.Equal(t, 48, cfg.Board.Height)
+	assert.Equal(t, 5*time.Second, cfg.Board.Cooldown)
+	assert.Equal(t, time.Minute, cfg.Board.UserCooldown)
+	assert.Equal(t, 40, cfg.Hype.Max)
+	assert.Equal(t, 2, cfg.Hype.PerMinute)
+	assert.Equal(t, ":8001", cfg.Addr)
+}
+
+func TestLoadValidates(t *testing.T) {
+	t.Setenv("MYSQL_DSN", "dsn")
+
+	t.Setenv("BOARD_WIDTH", "1000")
+	_, err := Load("", nil)
+	assert.ErrorContains(t, err, "Width")
+
+	t.Setenv("BOARD_WIDTH", "wide")
+	_, err = Load("", nil)
+	assert.ErrorContains(t, err, "BOARD_WIDTH")
+
+	t.Setenv("BOARD_WIDTH", "")
+	t.Setenv("BOARD_PALETTE", "neon")
+	_, err = Load("", nil)
+	assert.ErrorContains(t, err, "Palette")
+}
+
+func TestServeRequirementsAndRedaction(t *testing.T) {
+	t.Setenv("MYSQL_DSN", "user:password@tcp(db)/pixel")
+	t.Setenv("CENTRIFUGO_ADDR_API", "http://localhost/ws/api")
+	t.Setenv("SECRET_KEY", "")
+
+	cfg, err := Load("", nil)
+	require.NoError(t, err)
+	assert.ErrorContains(t, cfg.ValidateServe(), "auth.secret_key")
+
+	cfg.Auth.SecretKey = "secret"
+	cfg.Telegram.Token = "token"
+	assert.NoError(t, cfg.ValidateServe())
+
+	// Only the Centrifugo transport needs its API.
+	cfg.Centrifugo.APIAddr = ""
+	assert.ErrorContains(t, cfg.ValidateServe(), "centrifugo.api_addr")
+	cfg.Events.Transport = EventsBuiltin
+	assert.NoError(t, cfg.ValidateServe())
+
+	printed := map[string]string{}
+	for _, f := range cfg.Fields() {
+		printed[f.Key] = f.String()
+	}
+	assert.Equal(t, redacted, printed["database.dsn"])
+	assert.Equal(t, redacted, printed["auth.secret_key"])
+	assert.Equal(t, "", printed["centrifugo.secret_key"])
+	assert.Equal(t, "40", printed["board.width"])
+	assert.Equal(t, "1µs", printed["board.cooldown"])
+}
+
+func TestLoadEnvAliases(t *testing.T) {
+	t.Setenv("MYSQL_DSN", "legacy")
+	cfg, err := Load("", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "mysql", cfg.Database.Driver)
+	assert.Equal(t, "legacy", cfg.Database.DSN)
+
+	t.Setenv("DB_DRIVER", "sqlite3")
+	t.Setenv("DB_DSN", "pixel.db")
+	cfg, err = Load("", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "sqlite3", cfg.Database.Driver)
+	assert.Equal(t, "pixel.db", cfg.Database.DSN)
+
+	t.Setenv("DB_DRIVER", "oracle")
+	_, err = Load("", nil)
+	assert.ErrorContains(t, err, "Driver")
+}
@@ -0,0 +1,102 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const redacted = "<redacted>"
+
+// Field is a single setting of a config. Env is its main environment
+// variable.
+type Field struct {
+	Key    string
+	Env    string
+	Usage  string
+	Secret bool
+	Serve  string
+	Value  reflect.Value
+
+	// envs are all the variables the field is read from, in order.
+	envs []string
+}
+
+// Flag is the name of the command line flag of the field.
+func (f Field) Flag() string {
+	return strings.NewReplacer(".", "-", "_", "-").Replace(f.Key)
+}
+
+// String formats the value, redacting secrets that are set.
+func (f Field) String() string {
+	if f.Secret && !f.Value.IsZero() {
+		return redacted
+	}
+	return fmt.Sprint(f.Value.Interface())
+}
+
+func (f Field) set(value string) error {
+	switch f.Value.Interface().(type) {
+	case time.Duration:
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return err
+		}
+		f.Value.SetInt(int64(d))
+	case int:
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return err
+		}
+		f.Value.SetInt(int64(n))
+	case string:
+		f.Value.SetString(value)
+	default:
+		return fmt.Errorf("unsupported setting type %s", f.Value.Type())
+	}
+	return nil
+}
+
+// Fields lists every setting of the config in declaration order.
+func (c *Config) Fields() []Field {
+	return c.fields()
+}
+
+func (c *Config) fields() []Field {
+	var result []Field
+	collectFields(reflect.ValueOf(c).Elem(), "", &result)
+	return result
+}
+
+func collectFields(v reflect.Value, prefix string, result *[]Field) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		key := prefix + strings.Split(sf.Tag.Get("yaml"), ",")[0]
+
+		if sf.Type.Kind() == reflect.Struct {
+			collectFields(v.Field(i), key+".", result)
+			continue
+		}
+
+		var envs []string
+		if env := sf.Tag.Get("env"); env != "" {
+			envs = strings.Split(env, ",")
+		}
+
+		f := Field{
+			Key:    key,
+			Usage:  sf.Tag.Get("usage"),
+			Secret: sf.Tag.Get("secret") == "true",
+			Serve:  sf.Tag.Get("serve"),
+			Value:  v.Field(i),
+			envs:   envs,
+		}
+		if len(envs) > 0 {
+			f.Env = envs[0]
+		}
+		*result = append(*result, f)
+	}
+}
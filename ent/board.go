@@ -0,0 +1,294 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"nevissGo/ent/board"
+	"nevissGo/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Board is the model entity for the Board schema.
+type Board struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Width holds the value of the "width" field.
+	Width int `json:"width,omitempty"`
+	// Height holds the value of the "height" field.
+	Height int `json:"height,omitempty"`
+	// Cooldown holds the value of the "cooldown" field.
+	Cooldown time.Duration `json:"cooldown,omitempty"`
+	// UserCooldown holds the value of the "user_cooldown" field.
+	UserCooldown time.Duration `json:"user_cooldown,omitempty"`
+	// HypeCost holds the value of the "hype_cost" field.
+	HypeCost int `json:"hype_cost,omitempty"`
+	// Palette holds the value of the "palette" field.
+	Palette string `json:"palette,omitempty"`
+	// Open holds the value of the "open" field.
+	Open bool `json:"open,omitempty"`
+	// Seq holds the value of the "seq" field.
+	Seq int64 `json:"seq,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BoardQuery when eager-loading is set.
+	Edges        BoardEdges `json:"edges"`
+	user_boards  *int64
+	selectValues sql.SelectValues
+}
+
+// BoardEdges holds the relations/edges for other nodes in the graph.
+type BoardEdges struct {
+	// Pixels holds the value of the pixels edge.
+	Pixels []*Pixel `json:"pixels,omitempty"`
+	// Changes holds the value of the changes edge.
+	Changes []*PixelChange `json:"changes,omitempty"`
+	// Regions holds the value of the regions edge.
+	Regions []*Region `json:"regions,omitempty"`
+	// Owner holds the value of the owner edge.
+	Owner *User `json:"owner,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// PixelsOrErr returns the Pixels value or an error if the edge
+// was not loaded in eager-loading.
+func (e BoardEdges) PixelsOrErr() ([]*Pixel, error) {
+	if e.loadedTypes[0] {
+		return e.Pixels, nil
+	}
+	return nil, &NotLoadedError{edge: "pixels"}
+}
+
+// ChangesOrErr returns the Changes value or an error if the edge
+// was not loaded in eager-loading.
+func (e BoardEdges) ChangesOrErr() ([]*PixelChange, error) {
+	if e.loadedTypes[1] {
+		return e.Changes, nil
+	}
+	return nil, &NotLoadedError{edge: "changes"}
+}
+
+// RegionsOrErr returns the Regions value or an error if the edge
+// was not loaded in eager-loading.
+func (e BoardEdges) RegionsOrErr() ([]*Region, error) {
+	if e.loadedTypes[2] {
+		return e.Regions, nil
+	}
+	return nil, &NotLoadedError{edge: "regions"}
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BoardEdges) OwnerOrErr() (*User, error) {
+	if e.Owner != nil {
+		return e.Owner, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Board) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case board.FieldOpen:
+			values[i] = new(sql.NullBool)
+		case board.FieldID, board.FieldWidth, board.FieldHeight, board.FieldCooldown, board.FieldUserCooldown, board.FieldHypeCost, board.FieldSeq:
+			values[i] = new(sql.NullInt64)
+		case board.FieldName, board.FieldPalette:
+			values[i] = new(sql.NullString)
+		case board.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case board.ForeignKeys[0]: // user_boards
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Board fields.
+func (b *Board) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case board.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			b.ID = int(value.Int64)
+		case board.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				b.Name = value.String
+			}
+		case board.FieldWidth:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field width", values[i])
+			} else if value.Valid {
+				b.Width = int(value.Int64)
+			}
+		case board.FieldHeight:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field height", values[i])
+			} else if value.Valid {
+				b.Height = int(value.Int64)
+			}
+		case board.FieldCooldown:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field cooldown", values[i])
+			} else if value.Valid {
+				b.Cooldown = time.Duration(value.Int64)
+			}
+		case board.FieldUserCooldown:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_cooldown", values[i])
+			} else if value.Valid {
+				b.UserCooldown = time.Duration(value.Int64)
+			}
+		case board.FieldHypeCost:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field hype_cost", values[i])
+			} else if value.Valid {
+				b.HypeCost = int(value.Int64)
+			}
+		case board.FieldPalette:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field palette", values[i])
+			} else if value.Valid {
+				b.Palette = value.String
+			}
+		case board.FieldOpen:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field open", values[i])
+			} else if value.Valid {
+				b.Open = value.Bool
+			}
+		case board.FieldSeq:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field seq", values[i])
+			} else if value.Valid {
+				b.Seq = value.Int64
+			}
+		case board.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				b.CreatedAt = value.Time
+			}
+		case board.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_boards", value)
+			} else if value.Valid {
+				b.user_boards = new(int64)
+				*b.user_boards = int64(value.Int64)
+			}
+		default:
+			b.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Board.
+// This includes values selected through modifiers, order, etc.
+func (b *Board) Value(name string) (ent.Value, error) {
+	return b.selectValues.Get(name)
+}
+
+// QueryPixels queries the "pixels" edge of the Board entity.
+func (b *Board) QueryPixels() *PixelQuery {
+	return NewBoardClient(b.config).QueryPixels(b)
+}
+
+// QueryChanges queries the "changes" edge of the Board entity.
+func (b *Board) QueryChanges() *PixelChangeQuery {
+	return NewBoardClient(b.config).QueryChanges(b)
+}
+
+// QueryRegions queries the "regions" edge of the Board entity.
+func (b *Board) QueryRegions() *RegionQuery {
+	return NewBoardClient(b.config).QueryRegions(b)
+}
+
+// QueryOwner queries the "owner" edge of the Board entity.
+func (b *Board) QueryOwner() *UserQuery {
+	return NewBoardClient(b.config).QueryOwner(b)
+}
+
+// Update returns a builder for updating this Board.
+// Note that you need to call Board.Unwrap() before calling this method if this Board
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (b *Board) Update() *BoardUpdateOne {
+	return NewBoardClient(b.config).UpdateOne(b)
+}
+
+// Unwrap unwraps the Board entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (b *Board) Unwrap() *Board {
+	_tx, ok := b.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Board is not a transactional entity")
+	}
+	b.config.driver = _tx.drv
+	return b
+}
+
+// String implements the fmt.Stringer.
+func (b *Board) String() string {
+	var builder strings.Builder
+	builder.WriteString("Board(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", b.ID))
+	builder.WriteString("name=")
+	builder.WriteString(b.Name)
+	builder.WriteString(", ")
+	builder.WriteString("width=")
+	builder.WriteString(fmt.Sprintf("%v", b.Width))
+	builder.WriteString(", ")
+	builder.WriteString("height=")
+	builder.WriteString(fmt.Sprintf("%v", b.Height))
+	builder.WriteString(", ")
+	builder.WriteString("cooldown=")
+	builder.WriteString(fmt.Sprintf("%v", b.Cooldown))
+	builder.WriteString(", ")
+	builder.WriteString("user_cooldown=")
+	builder.WriteString(fmt.Sprintf("%v", b.UserCooldown))
+	builder.WriteString(", ")
+	builder.WriteString("hype_cost=")
+	builder.WriteString(fmt.Sprintf("%v", b.HypeCost))
+	builder.WriteString(", ")
+	builder.WriteString("palette=")
+	builder.WriteString(b.Palette)
+	builder.WriteString(", ")
+	builder.WriteString("open=")
+	builder.WriteString(fmt.Sprintf("%v", b.Open))
+	builder.WriteString(", ")
+	builder.WriteString("seq=")
+	builder.WriteString(fmt.Sprintf("%v", b.Seq))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(b.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Boards is a parsable slice of Board.
+type Boards []*Board
@@ -0,0 +1,273 @@
+// Code generated by ent, DO NOT EDIT.
+
+package board
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the board type in the database.
+	Label = "board"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldWidth holds the string denoting the width field in the database.
+	FieldWidth = "width"
+	// FieldHeight holds the string denoting the height field in the database.
+	FieldHeight = "height"
+	// FieldCooldown holds the string denoting the cooldown field in the database.
+	FieldCooldown = "cooldown"
+	// FieldUserCooldown holds the string denoting the user_cooldown field in the database.
+	FieldUserCooldown = "user_cooldown"
+	// FieldHypeCost holds the string denoting the hype_cost field in the database.
+	FieldHypeCost = "hype_cost"
+	// FieldPalette holds the string denoting the palette field in the database.
+	FieldPalette = "palette"
+	// FieldOpen holds the string denoting the open field in the database.
+	FieldOpen = "open"
+	// FieldSeq holds the string denoting the seq field in the database.
+	FieldSeq = "seq"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgePixels holds the string denoting the pixels edge name in mutations.
+	EdgePixels = "pixels"
+	// EdgeChanges holds the string denoting the changes edge name in mutations.
+	EdgeChanges = "changes"
+	// EdgeRegions holds the string denoting the regions edge name in mutations.
+	EdgeRegions = "regions"
+	// EdgeOwner holds the string denoting the owner edge name in mutations.
+	EdgeOwner = "owner"
+	// Table holds the table name of the board in the database.
+	Table = "boards"
+	// PixelsTable is the table that holds the pixels relation/edge.
+	PixelsTable = "pixels"
+	// PixelsInverseTable is the table name for the Pixel entity.
+	// It exists in this package in order to avoid circular dependency with the "pixel" package.
+	PixelsInverseTable = "pixels"
+	// PixelsColumn is the table column denoting the pixels relation/edge.
+	PixelsColumn = "board_pixels"
+	// ChangesTable is the table that holds the changes relation/edge.
+	ChangesTable = "pixel_changes"
+	// ChangesInverseTable is the table name for the PixelChange entity.
+	// It exists in this package in order to avoid circular dependency with the "pixelchange" package.
+	ChangesInverseTable = "pixel_changes"
+	// ChangesColumn is the table column denoting the changes relation/edge.
+	ChangesColumn = "board_changes"
+	// RegionsTable is the table that holds the regions relation/edge.
+	RegionsTable = "regions"
+	// RegionsInverseTable is the table name for the Region entity.
+	// It exists in this package in order to avoid circular dependency with the "region" package.
+	RegionsInverseTable = "regions"
+	// RegionsColumn is the table column denoting the regions relation/edge.
+	RegionsColumn = "board_id"
+	// OwnerTable is the table that holds the owner relation/edge.
+	OwnerTable = "boards"
+	// OwnerInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	OwnerInverseTable = "users"
+	// OwnerColumn is the table column denoting the owner relation/edge.
+	OwnerColumn = "user_boards"
+)
+
+// Columns holds all SQL columns for board fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldWidth,
+	FieldHeight,
+	FieldCooldown,
+	FieldUserCooldown,
+	FieldHypeCost,
+	FieldPalette,
+	FieldOpen,
+	FieldSeq,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "boards"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"user_boards",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// WidthValidator is a validator for the "width" field. It is called by the builders before save.
+	WidthValidator func(int) error
+	// HeightValidator is a validator for the "height" field. It is called by the builders before save.
+	HeightValidator func(int) error
+	// DefaultCooldown holds the default value on creation for the "cooldown" field.
+	DefaultCooldown time.Duration
+	// DefaultUserCooldown holds the default value on creation for the "user_cooldown" field.
+	DefaultUserCooldown time.Duration
+	// DefaultHypeCost holds the default value on creation for the "hype_cost" field.
+	DefaultHypeCost int
+	// HypeCostValidator is a validator for the "hype_cost" field. It is called by the builders before save.
+	HypeCostValidator func(int) error
+	// DefaultPalette holds the default value on creation for the "palette" field.
+	DefaultPalette string
+	// DefaultOpen holds the default value on creation for the "open" field.
+	DefaultOpen bool
+	// DefaultSeq holds the default value on creation for the "seq" field.
+	DefaultSeq int64
+	// SeqValidator is a validator for the "seq" field. It is called by the builders before save.
+	SeqValidator func(int64) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the Board queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByWidth orders the results by the width field.
+func ByWidth(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWidth, opts...).ToFunc()
+}
+
+// ByHeight orders the results by the height field.
+func ByHeight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHeight, opts...).ToFunc()
+}
+
+// ByCooldown orders the results by the cooldown field.
+func ByCooldown(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCooldown, opts...).ToFunc()
+}
+
+// ByUserCooldown orders the results by the user_cooldown field.
+func ByUserCooldown(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserCooldown, opts...).ToFunc()
+}
+
+// ByHypeCost orders the results by the hype_cost field.
+func ByHypeCost(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHypeCost, opts...).ToFunc()
+}
+
+// ByPalette orders the results by the palette field.
+func ByPalette(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPalette, opts...).ToFunc()
+}
+
+// ByOpen orders the results by the open field.
+func ByOpen(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOpen, opts...).ToFunc()
+}
+
+// BySeq orders the results by the seq field.
+func BySeq(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeq, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByPixelsCount orders the results by pixels count.
+func ByPixelsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPixelsStep(), opts...)
+	}
+}
+
+// ByPixels orders the results by pixels terms.
+func ByPixels(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPixelsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByChangesCount orders the results by changes count.
+func ByChangesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newChangesStep(), opts...)
+	}
+}
+
+// ByChanges orders the results by changes terms.
+func ByChanges(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newChangesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRegionsCount orders the results by regions count.
+func ByRegionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRegionsStep(), opts...)
+	}
+}
+
+// ByRegions orders the results by regions terms.
+func ByRegions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRegionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByOwnerField orders the results by owner field.
+func ByOwnerField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOwnerStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newPixelsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PixelsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PixelsTable, PixelsColumn),
+	)
+}
+func newChangesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ChangesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ChangesTable, ChangesColumn),
+	)
+}
+func newRegionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RegionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RegionsTable, RegionsColumn),
+	)
+}
+func newOwnerStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OwnerInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, OwnerTable, OwnerColumn),
+	)
+}
@@ -0,0 +1,663 @@
+// Code generated by ent, DO NOT EDIT.
+
+package board
+
+import (
+	"nevissGo/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Board {
+	return predicate.Board(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Board {
+	return predicate.Board(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Board {
+	return predicate.Board(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Board {
+	return predicate.Board(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Board {
+	return predicate.Board(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Board {
+	return predicate.Board(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Board {
+	return predicate.Board(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Board {
+	return predicate.Board(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Board {
+	return predicate.Board(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Board {
+	return predicate.Board(sql.FieldEQ(FieldName, v))
+}
+
+// Width applies equality check predicate on the "width" field. It's identical to WidthEQ.
+func Width(v int) predicate.Board {
+	return predicate.Board(sql.FieldEQ(FieldWidth, v))
+}
+
+// Height applies equality check predicate on the "height" field. It's identical to HeightEQ.
+func Height(v int) predicate.Board {
+	return predicate.Board(sql.FieldEQ(FieldHeight, v))
+}
+
+// Cooldown applies equality check predicate on the "cooldown" field. It's identical to CooldownEQ.
+func Cooldown(v time.Duration) predicate.Board {
+	vc := int64(v)
+	return predicate.Board(sql.FieldEQ(FieldCooldown, vc))
+}
+
+// UserCooldown applies equality check predicate on the "user_cooldown" field. It's identical to UserCooldownEQ.
+func UserCooldown(v time.Duration) predicate.Board {
+	vc := int64(v)
+	return predicate.Board(sql.FieldEQ(FieldUserCooldown, vc))
+}
+
+// HypeCost applies equality check predicate on the "hype_cost" field. It's identical to HypeCostEQ.
+func HypeCost(v int) predicate.Board {
+	return predicate.Board(sql.FieldEQ(FieldHypeCost, v))
+}
+
+// Palette applies equality check predicate on the "palette" field. It's identical to PaletteEQ.
+func Palette(v string) predicate.Board {
+	return predicate.Board(sql.FieldEQ(FieldPalette, v))
+}
+
+// Open applies equality check predicate on the "open" field. It's identical to OpenEQ.
+func Open(v bool) predicate.Board {
+	return predicate.Board(sql.FieldEQ(FieldOpen, v))
+}
+
+// Seq applies equality check predicate on the "seq" field. It's identical to SeqEQ.
+func Seq(v int64) predicate.Board {
+	return predicate.Board(sql.FieldEQ(FieldSeq, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Board {
+	return predicate.Board(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Board {
+	return predicate.Board(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Board {
+	return predicate.Board(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Board {
+	return predicate.Board(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Board {
+	return predicate.Board(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Board {
+	return predicate.Board(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Board {
+	return predicate.Board(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Board {
+	return predicate.Board(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Board {
+	return predicate.Board(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Board {
+	return predicate.Board(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Board {
+	return predicate.Board(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Board {
+	return predicate.Board(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Board {
+	return predicate.Board(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Board {
+	return predicate.Board(sql.FieldContainsFold(FieldName, v))
+}
+
+// WidthEQ applies the EQ predicate on the "width" field.
+func WidthEQ(v int) predicate.Board {
+	return predicate.Board(sql.FieldEQ(FieldWidth, v))
+}
+
+// WidthNEQ applies the NEQ predicate on the "width" field.
+func WidthNEQ(v int) predicate.Board {
+	return predicate.Board(sql.FieldNEQ(FieldWidth, v))
+}
+
+// WidthIn applies the In predicate on the "width" field.
+func WidthIn(vs ...int) predicate.Board {
+	return predicate.Board(sql.FieldIn(FieldWidth, vs...))
+}
+
+// WidthNotIn applies the NotIn predicate on the "width" field.
+func WidthNotIn(vs ...int) predicate.Board {
+	return predicate.Board(sql.FieldNotIn(FieldWidth, vs...))
+}
+
+// WidthGT applies the GT predicate on the "width" field.
+func WidthGT(v int) predicate.Board {
+	return predicate.Board(sql.FieldGT(FieldWidth, v))
+}
+
+// WidthGTE applies the GTE predicate on the "width" field.
+func WidthGTE(v int) predicate.Board {
+	return predicate.Board(sql.FieldGTE(FieldWidth, v))
+}
+
+// WidthLT applies the LT predicate on the "width" field.
+func WidthLT(v int) predicate.Board {
+	return predicate.Board(sql.FieldLT(FieldWidth, v))
+}
+
+// WidthLTE applies the LTE predicate on the "width" field.
+func WidthLTE(v int) predicate.Board {
+	return predicate.Board(sql.FieldLTE(FieldWidth, v))
+}
+
+// HeightEQ applies the EQ predicate on the "height" field.
+func HeightEQ(v int) predicate.Board {
+	return predicate.Board(sql.FieldEQ(FieldHeight, v))
+}
+
+// HeightNEQ applies the NEQ predicate on the "height" field.
+func HeightNEQ(v int) predicate.Board {
+	return predicate.Board(sql.FieldNEQ(FieldHeight, v))
+}
+
+// HeightIn applies the In predicate on the "height" field.
+func HeightIn(vs ...int) predicate.Board {
+	return predicate.Board(sql.FieldIn(FieldHeight, vs...))
+}
+
+// HeightNotIn applies the NotIn predicate on the "height" field.
+func HeightNotIn(vs ...int) predicate.Board {
+	return predicate.Board(sql.FieldNotIn(FieldHeight, vs...))
+}
+
+// HeightGT applies the GT predicate on the "height" field.
+func HeightGT(v int) predicate.Board {
+	return predicate.Board(sql.FieldGT(FieldHeight, v))
+}
+
+// HeightGTE applies the GTE predicate on the "height" field.
+func HeightGTE(v int) predicate.Board {
+	return predicate.Board(sql.FieldGTE(FieldHeight, v))
+}
+
+// HeightLT applies the LT predicate on the "height" field.
+func HeightLT(v int) predicate.Board {
+	return predicate.Board(sql.FieldLT(FieldHeight, v))
+}
+
+// HeightLTE applies the LTE predicate on the "height" field.
+func HeightLTE(v int) predicate.Board {
+	return predicate.Board(sql.FieldLTE(FieldHeight, v))
+}
+
+// CooldownEQ applies the EQ predicate on the "cooldown" field.
+func CooldownEQ(v time.Duration) predicate.Board {
+	vc := int64(v)
+	return predicate.Board(sql.FieldEQ(FieldCooldown, vc))
+}
+
+// CooldownNEQ applies the NEQ predicate on the "cooldown" field.
+func CooldownNEQ(v time.Duration) predicate.Board {
+	vc := int64(v)
+	return predicate.Board(sql.FieldNEQ(FieldCooldown, vc))
+}
+
+// CooldownIn applies the In predicate on the "cooldown" field.
+func CooldownIn(vs ...time.Duration) predicate.Board {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.Board(sql.FieldIn(FieldCooldown, v...))
+}
+
+// CooldownNotIn applies the NotIn predicate on the "cooldown" field.
+func CooldownNotIn(vs ...time.Duration) predicate.Board {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.Board(sql.FieldNotIn(FieldCooldown, v...))
+}
+
+// CooldownGT applies the GT predicate on the "cooldown" field.
+func CooldownGT(v time.Duration) predicate.Board {
+	vc := int64(v)
+	return predicate.Board(sql.FieldGT(FieldCooldown, vc))
+}
+
+// CooldownGTE applies the GTE predicate on the "cooldown" field.
+func CooldownGTE(v time.Duration) predicate.Board {
+	vc := int64(v)
+	return predicate.Board(sql.FieldGTE(FieldCooldown, vc))
+}
+
+// CooldownLT applies the LT predicate on the "cooldown" field.
+func CooldownLT(v time.Duration) predicate.Board {
+	vc := int64(v)
+	return predicate.Board(sql.FieldLT(FieldCooldown, vc))
+}
+
+// CooldownLTE applies the LTE predicate on the "cooldown" field.
+func CooldownLTE(v time.Duration) predicate.Board {
+	vc := int64(v)
+	return predicate.Board(sql.FieldLTE(FieldCooldown, vc))
+}
+
+// UserCooldownEQ applies the EQ predicate on the "user_cooldown" field.
+func UserCooldownEQ(v time.Duration) predicate.Board {
+	vc := int64(v)
+	return predicate.Board(sql.FieldEQ(FieldUserCooldown, vc))
+}
+
+// UserCooldownNEQ applies the NEQ predicate on the "user_cooldown" field.
+func UserCooldownNEQ(v time.Duration) predicate.Board {
+	vc := int64(v)
+	return predicate.Board(sql.FieldNEQ(FieldUserCooldown, vc))
+}
+
+// UserCooldownIn applies the In predicate on the "user_cooldown" field.
+func UserCooldownIn(vs ...time.Duration) predicate.Board {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.Board(sql.FieldIn(FieldUserCooldown, v...))
+}
+
+// UserCooldownNotIn applies the NotIn predicate on the "user_cooldown" field.
+func UserCooldownNotIn(vs ...time.Duration) predicate.Board {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.Board(sql.FieldNotIn(FieldUserCooldown, v...))
+}
+
+// UserCooldownGT applies the GT predicate on the "user_cooldown" field.
+func UserCooldownGT(v time.Duration) predicate.Board {
+	vc := int64(v)
+	return predicate.Board(sql.FieldGT(FieldUserCooldown, vc))
+}
+
+// UserCooldownGTE applies the GTE predicate on the "user_cooldown" field.
+func UserCooldownGTE(v time.Duration) predicate.Board {
+	vc := int64(v)
+	return predicate.Board(sql.FieldGTE(FieldUserCooldown, vc))
+}
+
+// UserCooldownLT applies the LT predicate on the "user_cooldown" field.
+func UserCooldownLT(v time.Duration) predicate.Board {
+	vc := int64(v)
+	return predicate.Board(sql.FieldLT(FieldUserCooldown, vc))
+}
+
+// UserCooldownLTE applies the LTE predicate on the "user_cooldown" field.
+func UserCooldownLTE(v time.Duration) predicate.Board {
+	vc := int64(v)
+	return predicate.Board(sql.FieldLTE(FieldUserCooldown, vc))
+}
+
+// HypeCostEQ applies the EQ predicate on the "hype_cost" field.
+func HypeCostEQ(v int) predicate.Board {
+	return predicate.Board(sql.FieldEQ(FieldHypeCost, v))
+}
+
+// HypeCostNEQ applies the NEQ predicate on the "hype_cost" field.
+func HypeCostNEQ(v int) predicate.Board {
+	return predicate.Board(sql.FieldNEQ(FieldHypeCost, v))
+}
+
+// HypeCostIn applies the In predicate on the "hype_cost" field.
+func HypeCostIn(vs ...int) predicate.Board {
+	return predicate.Board(sql.FieldIn(FieldHypeCost, vs...))
+}
+
+// HypeCostNotIn applies the NotIn predicate on the "hype_cost" field.
+func HypeCostNotIn(vs ...int) predicate.Board {
+	return predicate.Board(sql.FieldNotIn(FieldHypeCost, vs...))
+}
+
+// HypeCostGT applies the GT predicate on the "hype_cost" field.
+func HypeCostGT(v int) predicate.Board {
+	return predicate.Board(sql.FieldGT(FieldHypeCost, v))
+}
+
+// HypeCostGTE applies the GTE predicate on the "hype_cost" field.
+func HypeCostGTE(v int) predicate.Board {
+	return predicate.Board(sql.FieldGTE(FieldHypeCost, v))
+}
+
+// HypeCostLT applies the LT predicate on the "hype_cost" field.
+func HypeCostLT(v int) predicate.Board {
+	return predicate.Board(sql.FieldLT(FieldHypeCost, v))
+}
+
+// HypeCostLTE applies the LTE predicate on the "hype_cost" field.
+func HypeCostLTE(v int) predicate.Board {
+	return predicate.Board(sql.FieldLTE(FieldHypeCost, v))
+}
+
+// PaletteEQ applies the EQ predicate on the "palette" field.
+func PaletteEQ(v string) predicate.Board {
+	return predicate.Board(sql.FieldEQ(FieldPalette, v))
+}
+
+// PaletteNEQ applies the NEQ predicate on the "palette" field.
+func PaletteNEQ(v string) predicate.Board {
+	return predicate.Board(sql.FieldNEQ(FieldPalette, v))
+}
+
+// PaletteIn applies the In predicate on the "palette" field.
+func PaletteIn(vs ...string) predicate.Board {
+	return predicate.Board(sql.FieldIn(FieldPalette, vs...))
+}
+
+// PaletteNotIn applies the NotIn predicate on the "palette" field.
+func PaletteNotIn(vs ...string) predicate.Board {
+	return predicate.Board(sql.FieldNotIn(FieldPalette, vs...))
+}
+
+// PaletteGT applies the GT predicate on the "palette" field.
+func PaletteGT(v string) predicate.Board {
+	return predicate.Board(sql.FieldGT(FieldPalette, v))
+}
+
+// PaletteGTE applies the GTE predicate on the "palette" field.
+func PaletteGTE(v string) predicate.Board {
+	return predicate.Board(sql.FieldGTE(FieldPalette, v))
+}
+
+// PaletteLT applies the LT predicate on the "palette" field.
+func PaletteLT(v string) predicate.Board {
+	return predicate.Board(sql.FieldLT(FieldPalette, v))
+}
+
+// PaletteLTE applies the LTE predicate on the "palette" field.
+func PaletteLTE(v string) predicate.Board {
+	return predicate.Board(sql.FieldLTE(FieldPalette, v))
+}
+
+// PaletteContains applies the Contains predicate on the "palette" field.
+func PaletteContains(v string) predicate.Board {
+	return predicate.Board(sql.FieldContains(FieldPalette, v))
+}
+
+// PaletteHasPrefix applies the HasPrefix predicate on the "palette" field.
+func PaletteHasPrefix(v string) predicate.Board {
+	return predicate.Board(sql.FieldHasPrefix(FieldPalette, v))
+}
+
+// PaletteHasSuffix applies the HasSuffix predicate on the "palette" field.
+func PaletteHasSuffix(v string) predicate.Board {
+	return predicate.Board(sql.FieldHasSuffix(FieldPalette, v))
+}
+
+// PaletteEqualFold applies the EqualFold predicate on the "palette" field.
+func PaletteEqualFold(v string) predicate.Board {
+	return predicate.Board(sql.FieldEqualFold(FieldPalette, v))
+}
+
+// PaletteContainsFold applies the ContainsFold predicate on the "palette" field.
+func PaletteContainsFold(v string) predicate.Board {
+	return predicate.Board(sql.FieldContainsFold(FieldPalette, v))
+}
+
+// OpenEQ applies the EQ predicate on the "open" field.
+func OpenEQ(v bool) predicate.Board {
+	return predicate.Board(sql.FieldEQ(FieldOpen, v))
+}
+
+// OpenNEQ applies the NEQ predicate on the "open" field.
+func OpenNEQ(v bool) predicate.Board {
+	return predicate.Board(sql.FieldNEQ(FieldOpen, v))
+}
+
+// SeqEQ applies the EQ predicate on the "seq" field.
+func SeqEQ(v int64) predicate.Board {
+	return predicate.Board(sql.FieldEQ(FieldSeq, v))
+}
+
+// SeqNEQ applies the NEQ predicate on the "seq" field.
+func SeqNEQ(v int64) predicate.Board {
+	return predicate.Board(sql.FieldNEQ(FieldSeq, v))
+}
+
+// SeqIn applies the In predicate on the "seq" field.
+func SeqIn(vs ...int64) predicate.Board {
+	return predicate.Board(sql.FieldIn(FieldSeq, vs...))
+}
+
+// SeqNotIn applies the NotIn predicate on the "seq" field.
+func SeqNotIn(vs ...int64) predicate.Board {
+	return predicate.Board(sql.FieldNotIn(FieldSeq, vs...))
+}
+
+// SeqGT applies the GT predicate on the "seq" field.
+func SeqGT(v int64) predicate.Board {
+	return predicate.Board(sql.FieldGT(FieldSeq, v))
+}
+
+// SeqGTE applies the GTE predicate on the "seq" field.
+func SeqGTE(v int64) predicate.Board {
+	return predicate.Board(sql.FieldGTE(FieldSeq, v))
+}
+
+// SeqLT applies the LT predicate on the "seq" field.
+func SeqLT(v int64) predicate.Board {
+	return predicate.Board(sql.FieldLT(FieldSeq, v))
+}
+
+// SeqLTE applies the LTE predicate on the "seq" field.
+func SeqLTE(v int64) predicate.Board {
+	return predicate.Board(sql.FieldLTE(FieldSeq, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Board {
+	return predicate.Board(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Board {
+	return predicate.Board(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Board {
+	return predicate.Board(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Board {
+	return predicate.Board(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Board {
+	return predicate.Board(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Board {
+	return predicate.Board(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Board {
+	return predicate.Board(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Board {
+	return predicate.Board(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasPixels applies the HasEdge predicate on the "pixels" edge.
+func HasPixels() predicate.Board {
+	return predicate.Board(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PixelsTable, PixelsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPixelsWith applies the HasEdge predicate on the "pixels" edge with a given conditions (other predicates).
+func HasPixelsWith(preds ...predicate.Pixel) predicate.Board {
+	return predicate.Board(func(s *sql.Selector) {
+		step := newPixelsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasChanges applies the HasEdge predicate on the "changes" edge.
+func HasChanges() predicate.Board {
+	return predicate.Board(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChangesTable, ChangesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChangesWith applies the HasEdge predicate on the "changes" edge with a given conditions (other predicates).
+func HasChangesWith(preds ...predicate.PixelChange) predicate.Board {
+	return predicate.Board(func(s *sql.Selector) {
+		step := newChangesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRegions applies the HasEdge predicate on the "regions" edge.
+func HasRegions() predicate.Board {
+	return predicate.Board(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RegionsTable, RegionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRegionsWith applies the HasEdge predicate on the "regions" edge with a given conditions (other predicates).
+func HasRegionsWith(preds ...predicate.Region) predicate.Board {
+	return predicate.Board(func(s *sql.Selector) {
+		step := newRegionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOwner applies the HasEdge predicate on the "owner" edge.
+func HasOwner() predicate.Board {
+	return predicate.Board(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OwnerTable, OwnerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOwnerWith applies the HasEdge predicate on the "owner" edge with a given conditions (other predicates).
+func HasOwnerWith(preds ...predicate.User) predicate.Board {
+	return predicate.Board(func(s *sql.Selector) {
+		step := newOwnerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Board) predicate.Board {
+	return predicate.Board(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Board) predicate.Board {
+	return predicate.Board(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Board) predicate.Board {
+	return predicate.Board(sql.NotPredicates(p))
+}
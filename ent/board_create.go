@@ -0,0 +1,549 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"nevissGo/ent/board"
+	"nevissGo/ent/pixel"
+	"nevissGo/ent/pixelchange"
+	"nevissGo/ent/region"
+	"nevissGo/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BoardCreate is the builder for creating a Board entity.
+type BoardCreate struct {
+	config
+	mutation *BoardMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (bc *BoardCreate) SetName(s string) *BoardCreate {
+	bc.mutation.SetName(s)
+	return bc
+}
+
+// SetWidth sets the "width" field.
+func (bc *BoardCreate) SetWidth(i int) *BoardCreate {
+	bc.mutation.SetWidth(i)
+	return bc
+}
+
+// SetHeight sets the "height" field.
+func (bc *BoardCreate) SetHeight(i int) *BoardCreate {
+	bc.mutation.SetHeight(i)
+	return bc
+}
+
+// SetCooldown sets the "cooldown" field.
+func (bc *BoardCreate) SetCooldown(t time.Duration) *BoardCreate {
+	bc.mutation.SetCooldown(t)
+	return bc
+}
+
+// SetNillableCooldown sets the "cooldown" field if the given value is not nil.
+func (bc *BoardCreate) SetNillableCooldown(t *time.Duration) *BoardCreate {
+	if t != nil {
+		bc.SetCooldown(*t)
+	}
+	return bc
+}
+
+// SetUserCooldown sets the "user_cooldown" field.
+func (bc *BoardCreate) SetUserCooldown(t time.Duration) *BoardCreate {
+	bc.mutation.SetUserCooldown(t)
+	return bc
+}
+
+// SetNillableUserCooldown sets the "user_cooldown" field if the given value is not nil.
+func (bc *BoardCreate) SetNillableUserCooldown(t *time.Duration) *BoardCreate {
+	if t != nil {
+		bc.SetUserCooldown(*t)
+	}
+	return bc
+}
+
+// SetHypeCost sets the "hype_cost" field.
+func (bc *BoardCreate) SetHypeCost(i int) *BoardCreate {
+	bc.mutation.SetHypeCost(i)
+	return bc
+}
+
+// SetNillableHypeCost sets the "hype_cost" field if the given value is not nil.
+func (bc *BoardCreate) SetNillableHypeCost(i *int) *BoardCreate {
+	if i != nil {
+		bc.SetHypeCost(*i)
+	}
+	return bc
+}
+
+// SetPalette sets the "palette" field.
+func (bc *BoardCreate) SetPalette(s string) *BoardCreate {
+	bc.mutation.SetPalette(s)
+	return bc
+}
+
+// SetNillablePalette sets the "palette" field if the given value is not nil.
+func (bc *BoardCreate) SetNillablePalette(s *string) *BoardCreate {
+	if s != nil {
+		bc.SetPalette(*s)
+	}
+	return bc
+}
+
+// SetOpen sets the "open" field.
+func (bc *BoardCreate) SetOpen(b bool) *BoardCreate {
+	bc.mutation.SetOpen(b)
+	return bc
+}
+
+// SetNillableOpen sets the "open" field if the given value is not nil.
+func (bc *BoardCreate) SetNillableOpen(b *bool) *BoardCreate {
+	if b != nil {
+		bc.SetOpen(*b)
+	}
+	return bc
+}
+
+// SetSeq sets the "seq" field.
+func (bc *BoardCreate) SetSeq(i int64) *BoardCreate {
+	bc.mutation.SetSeq(i)
+	return bc
+}
+
+// SetNillableSeq sets the "seq" field if the given value is not nil.
+func (bc *BoardCreate) SetNillableSeq(i *int64) *BoardCreate {
+	if i != nil {
+		bc.SetSeq(*i)
+	}
+	return bc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (bc *BoardCreate) SetCreatedAt(t time.Time) *BoardCreate {
+	bc.mutation.SetCreatedAt(t)
+	return bc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (bc *BoardCreate) SetNillableCreatedAt(t *time.Time) *BoardCreate {
+	if t != nil {
+		bc.SetCreatedAt(*t)
+	}
+	return bc
+}
+
+// AddPixelIDs adds the "pixels" edge to the Pixel entity by IDs.
+func (bc *BoardCreate) AddPixelIDs(ids ...int) *BoardCreate {
+	bc.mutation.AddPixelIDs(ids...)
+	return bc
+}
+
+// AddPixels adds the "pixels" edges to the Pixel entity.
+func (bc *BoardCreate) AddPixels(p ...*Pixel) *BoardCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return bc.AddPixelIDs(ids...)
+}
+
+// AddChangeIDs adds the "changes" edge to the PixelChange entity by IDs.
+func (bc *BoardCreate) AddChangeIDs(ids ...int) *BoardCreate {
+	bc.mutation.AddChangeIDs(ids...)
+	return bc
+}
+
+// AddChanges adds the "changes" edges to the PixelChange entity.
+func (bc *BoardCreate) AddChanges(p ...*PixelChange) *BoardCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return bc.AddChangeIDs(ids...)
+}
+
+// AddRegionIDs adds the "regions" edge to the Region entity by IDs.
+func (bc *BoardCreate) AddRegionIDs(ids ...int) *BoardCreate {
+	bc.mutation.AddRegionIDs(ids...)
+	return bc
+}
+
+// AddRegions adds the "regions" edges to the Region entity.
+func (bc *BoardCreate) AddRegions(r ...*Region) *BoardCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return bc.AddRegionIDs(ids...)
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (bc *BoardCreate) SetOwnerID(id int64) *BoardCreate {
+	bc.mutation.SetOwnerID(id)
+	return bc
+}
+
+// SetNillableOwnerID sets the "owner" edge to the User entity by ID if the given value is not nil.
+func (bc *BoardCreate) SetNillableOwnerID(id *int64) *BoardCreate {
+	if id != nil {
+		bc = bc.SetOwnerID(*id)
+	}
+	return bc
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (bc *BoardCreate) SetOwner(u *User) *BoardCreate {
+	return bc.SetOwnerID(u.ID)
+}
+
+// Mutation returns the BoardMutation object of the builder.
+func (bc *BoardCreate) Mutation() *BoardMutation {
+	return bc.mutation
+}
+
+// Save creates the Board in the database.
+func (bc *BoardCreate) Save(ctx context.Context) (*Board, error) {
+	bc.defaults()
+	return withHooks(ctx, bc.sqlSave, bc.mutation, bc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (bc *BoardCreate) SaveX(ctx context.Context) *Board {
+	v, err := bc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bc *BoardCreate) Exec(ctx context.Context) error {
+	_, err := bc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bc *BoardCreate) ExecX(ctx context.Context) {
+	if err := bc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bc *BoardCreate) defaults() {
+	if _, ok := bc.mutation.Cooldown(); !ok {
+		v := board.DefaultCooldown
+		bc.mutation.SetCooldown(v)
+	}
+	if _, ok := bc.mutation.UserCooldown(); !ok {
+		v := board.DefaultUserCooldown
+		bc.mutation.SetUserCooldown(v)
+	}
+	if _, ok := bc.mutation.HypeCost(); !ok {
+		v := board.DefaultHypeCost
+		bc.mutation.SetHypeCost(v)
+	}
+	if _, ok := bc.mutation.Palette(); !ok {
+		v := board.DefaultPalette
+		bc.mutation.SetPalette(v)
+	}
+	if _, ok := bc.mutation.Open(); !ok {
+		v := board.DefaultOpen
+		bc.mutation.SetOpen(v)
+	}
+	if _, ok := bc.mutation.Seq(); !ok {
+		v := board.DefaultSeq
+		bc.mutation.SetSeq(v)
+	}
+	if _, ok := bc.mutation.CreatedAt(); !ok {
+		v := board.DefaultCreatedAt()
+		bc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bc *BoardCreate) check() error {
+	if _, ok := bc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Board.name"`)}
+	}
+	if v, ok := bc.mutation.Name(); ok {
+		if err := board.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Board.name": %w`, err)}
+		}
+	}
+	if _, ok := bc.mutation.Width(); !ok {
+		return &ValidationError{Name: "width", err: errors.New(`ent: missing required field "Board.width"`)}
+	}
+	if v, ok := bc.mutation.Width(); ok {
+		if err := board.WidthValidator(v); err != nil {
+			return &ValidationError{Name: "width", err: fmt.Errorf(`ent: validator failed for field "Board.width": %w`, err)}
+		}
+	}
+	if _, ok := bc.mutation.Height(); !ok {
+		return &ValidationError{Name: "height", err: errors.New(`ent: missing required field "Board.height"`)}
+	}
+	if v, ok := bc.mutation.Height(); ok {
+		if err := board.HeightValidator(v); err != nil {
+			return &ValidationError{Name: "height", err: fmt.Errorf(`ent: validator failed for field "Board.height": %w`, err)}
+		}
+	}
+	if _, ok := bc.mutation.Cooldown(); !ok {
+		return &ValidationError{Name: "cooldown", err: errors.New(`ent: missing required field "Board.cooldown"`)}
+	}
+	if _, ok := bc.mutation.UserCooldown(); !ok {
+		return &ValidationError{Name: "user_cooldown", err: errors.New(`ent: missing required field "Board.user_cooldown"`)}
+	}
+	if _, ok := bc.mutation.HypeCost(); !ok {
+		return &ValidationError{Name: "hype_cost", err: errors.New(`ent: missing required field "Board.hype_cost"`)}
+	}
+	if v, ok := bc.mutation.HypeCost(); ok {
+		if err := board.HypeCostValidator(v); err != nil {
+			return &ValidationError{Name: "hype_cost", err: fmt.Errorf(`ent: validator failed for field "Board.hype_cost": %w`, err)}
+		}
+	}
+	if _, ok := bc.mutation.Palette(); !ok {
+		return &ValidationError{Name: "palette", err: errors.New(`ent: missing required field "Board.palette"`)}
+	}
+	if _, ok := bc.mutation.Open(); !ok {
+		return &ValidationError{Name: "open", err: errors.New(`ent: missing required field "Board.open"`)}
+	}
+	if _, ok := bc.mutation.Seq(); !ok {
+		return &ValidationError{Name: "seq", err: errors.New(`ent: missing required field "Board.seq"`)}
+	}
+	if v, ok := bc.mutation.Seq(); ok {
+		if err := board.SeqValidator(v); err != nil {
+			return &ValidationError{Name: "seq", err: fmt.Errorf(`ent: validator failed for field "Board.seq": %w`, err)}
+		}
+	}
+	if _, ok := bc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Board.created_at"`)}
+	}
+	return nil
+}
+
+func (bc *BoardCreate) sqlSave(ctx context.Context) (*Board, error) {
+	if err := bc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := bc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, bc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	bc.mutation.id = &_node.ID
+	bc.mutation.done = true
+	return _node, nil
+}
+
+func (bc *BoardCreate) createSpec() (*Board, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Board{config: bc.config}
+		_spec = sqlgraph.NewCreateSpec(board.Table, sqlgraph.NewFieldSpec(board.FieldID, field.TypeInt))
+	)
+	if value, ok := bc.mutation.Name(); ok {
+		_spec.SetField(board.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := bc.mutation.Width(); ok {
+		_spec.SetField(board.FieldWidth, field.TypeInt, value)
+		_node.Width = value
+	}
+	if value, ok := bc.mutation.Height(); ok {
+		_spec.SetField(board.FieldHeight, field.TypeInt, value)
+		_node.Height = value
+	}
+	if value, ok := bc.mutation.Cooldown(); ok {
+		_spec.SetField(board.FieldCooldown, field.TypeInt64, value)
+		_node.Cooldown = value
+	}
+	if value, ok := bc.mutation.UserCooldown(); ok {
+		_spec.SetField(board.FieldUserCooldown, field.TypeInt64, value)
+		_node.UserCooldown = value
+	}
+	if value, ok := bc.mutation.HypeCost(); ok {
+		_spec.SetField(board.FieldHypeCost, field.TypeInt, value)
+		_node.HypeCost = value
+	}
+	if value, ok := bc.mutation.Palette(); ok {
+		_spec.SetField(board.FieldPalette, field.TypeString, value)
+		_node.Palette = value
+	}
+	if value, ok := bc.mutation.Open(); ok {
+		_spec.SetField(board.FieldOpen, field.TypeBool, value)
+		_node.Open = value
+	}
+	if value, ok := bc.mutation.Seq(); ok {
+		_spec.SetField(board.FieldSeq, field.TypeInt64, value)
+		_node.Seq = value
+	}
+	if value, ok := bc.mutation.CreatedAt(); ok {
+		_spec.SetField(board.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := bc.mutation.PixelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.PixelsTable,
+			Columns: []string{board.PixelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pixel.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := bc.mutation.ChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.ChangesTable,
+			Columns: []string{board.ChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pixelchange.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := bc.mutation.RegionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.RegionsTable,
+			Columns: []string{board.RegionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(region.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := bc.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   board.OwnerTable,
+			Columns: []string{board.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_boards = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// BoardCreateBulk is the builder for creating many Board entities in bulk.
+type BoardCreateBulk struct {
+	config
+	err      error
+	builders []*BoardCreate
+}
+
+// Save creates the Board entities in the database.
+func (bcb *BoardCreateBulk) Save(ctx context.Context) ([]*Board, error) {
+	if bcb.err != nil {
+		return nil, bcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(bcb.builders))
+	nodes := make([]*Board, len(bcb.builders))
+	mutators := make([]Mutator, len(bcb.builders))
+	for i := range bcb.builders {
+		func(i int, root context.Context) {
+			builder := bcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BoardMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, bcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, bcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, bcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bcb *BoardCreateBulk) SaveX(ctx context.Context) []*Board {
+	v, err := bcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bcb *BoardCreateBulk) Exec(ctx context.Context) error {
+	_, err := bcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bcb *BoardCreateBulk) ExecX(ctx context.Context) {
+	if err := bcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
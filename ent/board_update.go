@@ -0,0 +1,1266 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"nevissGo/ent/board"
+	"nevissGo/ent/pixel"
+	"nevissGo/ent/pixelchange"
+	"nevissGo/ent/predicate"
+	"nevissGo/ent/region"
+	"nevissGo/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BoardUpdate is the builder for updating Board entities.
+type BoardUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BoardMutation
+}
+
+// Where appends a list predicates to the BoardUpdate builder.
+func (bu *BoardUpdate) Where(ps ...predicate.Board) *BoardUpdate {
+	bu.mutation.Where(ps...)
+	return bu
+}
+
+// SetName sets the "name" field.
+func (bu *BoardUpdate) SetName(s string) *BoardUpdate {
+	bu.mutation.SetName(s)
+	return bu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (bu *BoardUpdate) SetNillableName(s *string) *BoardUpdate {
+	if s != nil {
+		bu.SetName(*s)
+	}
+	return bu
+}
+
+// SetWidth sets the "width" field.
+func (bu *BoardUpdate) SetWidth(i int) *BoardUpdate {
+	bu.mutation.ResetWidth()
+	bu.mutation.SetWidth(i)
+	return bu
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (bu *BoardUpdate) SetNillableWidth(i *int) *BoardUpdate {
+	if i != nil {
+		bu.SetWidth(*i)
+	}
+	return bu
+}
+
+// AddWidth adds i to the "width" field.
+func (bu *BoardUpdate) AddWidth(i int) *BoardUpdate {
+	bu.mutation.AddWidth(i)
+	return bu
+}
+
+// SetHeight sets the "height" field.
+func (bu *BoardUpdate) SetHeight(i int) *BoardUpdate {
+	bu.mutation.ResetHeight()
+	bu.mutation.SetHeight(i)
+	return bu
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (bu *BoardUpdate) SetNillableHeight(i *int) *BoardUpdate {
+	if i != nil {
+		bu.SetHeight(*i)
+	}
+	return bu
+}
+
+// AddHeight adds i to the "height" field.
+func (bu *BoardUpdate) AddHeight(i int) *BoardUpdate {
+	bu.mutation.AddHeight(i)
+	return bu
+}
+
+// SetCooldown sets the "cooldown" field.
+func (bu *BoardUpdate) SetCooldown(t time.Duration) *BoardUpdate {
+	bu.mutation.ResetCooldown()
+	bu.mutation.SetCooldown(t)
+	return bu
+}
+
+// SetNillableCooldown sets the "cooldown" field if the given value is not nil.
+func (bu *BoardUpdate) SetNillableCooldown(t *time.Duration) *BoardUpdate {
+	if t != nil {
+		bu.SetCooldown(*t)
+	}
+	return bu
+}
+
+// AddCooldown adds t to the "cooldown" field.
+func (bu *BoardUpdate) AddCooldown(t time.Duration) *BoardUpdate {
+	bu.mutation.AddCooldown(t)
+	return bu
+}
+
+// SetUserCooldown sets the "user_cooldown" field.
+func (bu *BoardUpdate) SetUserCooldown(t time.Duration) *BoardUpdate {
+	bu.mutation.ResetUserCooldown()
+	bu.mutation.SetUserCooldown(t)
+	return bu
+}
+
+// SetNillableUserCooldown sets the "user_cooldown" field if the given value is not nil.
+func (bu *BoardUpdate) SetNillableUserCooldown(t *time.Duration) *BoardUpdate {
+	if t != nil {
+		bu.SetUserCooldown(*t)
+	}
+	return bu
+}
+
+// AddUserCooldown adds t to the "user_cooldown" field.
+func (bu *BoardUpdate) AddUserCooldown(t time.Duration) *BoardUpdate {
+	bu.mutation.AddUserCooldown(t)
+	return bu
+}
+
+// SetHypeCost sets the "hype_cost" field.
+func (bu *BoardUpdate) SetHypeCost(i int) *BoardUpdate {
+	bu.mutation.ResetHypeCost()
+	bu.mutation.SetHypeCost(i)
+	return bu
+}
+
+// SetNillableHypeCost sets the "hype_cost" field if the given value is not nil.
+func (bu *BoardUpdate) SetNillableHypeCost(i *int) *BoardUpdate {
+	if i != nil {
+		bu.SetHypeCost(*i)
+	}
+	return bu
+}
+
+// AddHypeCost adds i to the "hype_cost" field.
+func (bu *BoardUpdate) AddHypeCost(i int) *BoardUpdate {
+	bu.mutation.AddHypeCost(i)
+	return bu
+}
+
+// SetPalette sets the "palette" field.
+func (bu *BoardUpdate) SetPalette(s string) *BoardUpdate {
+	bu.mutation.SetPalette(s)
+	return bu
+}
+
+// SetNillablePalette sets the "palette" field if the given value is not nil.
+func (bu *BoardUpdate) SetNillablePalette(s *string) *BoardUpdate {
+	if s != nil {
+		bu.SetPalette(*s)
+	}
+	return bu
+}
+
+// SetOpen sets the "open" field.
+func (bu *BoardUpdate) SetOpen(b bool) *BoardUpdate {
+	bu.mutation.SetOpen(b)
+	return bu
+}
+
+// SetNillableOpen sets the "open" field if the given value is not nil.
+func (bu *BoardUpdate) SetNillableOpen(b *bool) *BoardUpdate {
+	if b != nil {
+		bu.SetOpen(*b)
+	}
+	return bu
+}
+
+// SetSeq sets the "seq" field.
+func (bu *BoardUpdate) SetSeq(i int64) *BoardUpdate {
+	bu.mutation.ResetSeq()
+	bu.mutation.SetSeq(i)
+	return bu
+}
+
+// SetNillableSeq sets the "seq" field if the given value is not nil.
+func (bu *BoardUpdate) SetNillableSeq(i *int64) *BoardUpdate {
+	if i != nil {
+		bu.SetSeq(*i)
+	}
+	return bu
+}
+
+// AddSeq adds i to the "seq" field.
+func (bu *BoardUpdate) AddSeq(i int64) *BoardUpdate {
+	bu.mutation.AddSeq(i)
+	return bu
+}
+
+// AddPixelIDs adds the "pixels" edge to the Pixel entity by IDs.
+func (bu *BoardUpdate) AddPixelIDs(ids ...int) *BoardUpdate {
+	bu.mutation.AddPixelIDs(ids...)
+	return bu
+}
+
+// AddPixels adds the "pixels" edges to the Pixel entity.
+func (bu *BoardUpdate) AddPixels(p ...*Pixel) *BoardUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return bu.AddPixelIDs(ids...)
+}
+
+// AddChangeIDs adds the "changes" edge to the PixelChange entity by IDs.
+func (bu *BoardUpdate) AddChangeIDs(ids ...int) *BoardUpdate {
+	bu.mutation.AddChangeIDs(ids...)
+	return bu
+}
+
+// AddChanges adds the "changes" edges to the PixelChange entity.
+func (bu *BoardUpdate) AddChanges(p ...*PixelChange) *BoardUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return bu.AddChangeIDs(ids...)
+}
+
+// AddRegionIDs adds the "regions" edge to the Region entity by IDs.
+func (bu *BoardUpdate) AddRegionIDs(ids ...int) *BoardUpdate {
+	bu.mutation.AddRegionIDs(ids...)
+	return bu
+}
+
+// AddRegions adds the "regions" edges to the Region entity.
+func (bu *BoardUpdate) AddRegions(r ...*Region) *BoardUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return bu.AddRegionIDs(ids...)
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (bu *BoardUpdate) SetOwnerID(id int64) *BoardUpdate {
+	bu.mutation.SetOwnerID(id)
+	return bu
+}
+
+// SetNillableOwnerID sets the "owner" edge to the User entity by ID if the given value is not nil.
+func (bu *BoardUpdate) SetNillableOwnerID(id *int64) *BoardUpdate {
+	if id != nil {
+		bu = bu.SetOwnerID(*id)
+	}
+	return bu
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (bu *BoardUpdate) SetOwner(u *User) *BoardUpdate {
+	return bu.SetOwnerID(u.ID)
+}
+
+// Mutation returns the BoardMutation object of the builder.
+func (bu *BoardUpdate) Mutation() *BoardMutation {
+	return bu.mutation
+}
+
+// ClearPixels clears all "pixels" edges to the Pixel entity.
+func (bu *BoardUpdate) ClearPixels() *BoardUpdate {
+	bu.mutation.ClearPixels()
+	return bu
+}
+
+// RemovePixelIDs removes the "pixels" edge to Pixel entities by IDs.
+func (bu *BoardUpdate) RemovePixelIDs(ids ...int) *BoardUpdate {
+	bu.mutation.RemovePixelIDs(ids...)
+	return bu
+}
+
+// RemovePixels removes "pixels" edges to Pixel entities.
+func (bu *BoardUpdate) RemovePixels(p ...*Pixel) *BoardUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return bu.RemovePixelIDs(ids...)
+}
+
+// ClearChanges clears all "changes" edges to the PixelChange entity.
+func (bu *BoardUpdate) ClearChanges() *BoardUpdate {
+	bu.mutation.ClearChanges()
+	return bu
+}
+
+// RemoveChangeIDs removes the "changes" edge to PixelChange entities by IDs.
+func (bu *BoardUpdate) RemoveChangeIDs(ids ...int) *BoardUpdate {
+	bu.mutation.RemoveChangeIDs(ids...)
+	return bu
+}
+
+// RemoveChanges removes "changes" edges to PixelChange entities.
+func (bu *BoardUpdate) RemoveChanges(p ...*PixelChange) *BoardUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return bu.RemoveChangeIDs(ids...)
+}
+
+// ClearRegions clears all "regions" edges to the Region entity.
+func (bu *BoardUpdate) ClearRegions() *BoardUpdate {
+	bu.mutation.ClearRegions()
+	return bu
+}
+
+// RemoveRegionIDs removes the "regions" edge to Region entities by IDs.
+func (bu *BoardUpdate) RemoveRegionIDs(ids ...int) *BoardUpdate {
+	bu.mutation.RemoveRegionIDs(ids...)
+	return bu
+}
+
+// RemoveRegions removes "regions" edges to Region entities.
+func (bu *BoardUpdate) RemoveRegions(r ...*Region) *BoardUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return bu.RemoveRegionIDs(ids...)
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (bu *BoardUpdate) ClearOwner() *BoardUpdate {
+	bu.mutation.ClearOwner()
+	return bu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (bu *BoardUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, bu.sqlSave, bu.mutation, bu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bu *BoardUpdate) SaveX(ctx context.Context) int {
+	affected, err := bu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (bu *BoardUpdate) Exec(ctx context.Context) error {
+	_, err := bu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bu *BoardUpdate) ExecX(ctx context.Context) {
+	if err := bu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bu *BoardUpdate) check() error {
+	if v, ok := bu.mutation.Name(); ok {
+		if err := board.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Board.name": %w`, err)}
+		}
+	}
+	if v, ok := bu.mutation.Width(); ok {
+		if err := board.WidthValidator(v); err != nil {
+			return &ValidationError{Name: "width", err: fmt.Errorf(`ent: validator failed for field "Board.width": %w`, err)}
+		}
+	}
+	if v, ok := bu.mutation.Height(); ok {
+		if err := board.HeightValidator(v); err != nil {
+			return &ValidationError{Name: "height", err: fmt.Errorf(`ent: validator failed for field "Board.height": %w`, err)}
+		}
+	}
+	if v, ok := bu.mutation.HypeCost(); ok {
+		if err := board.HypeCostValidator(v); err != nil {
+			return &ValidationError{Name: "hype_cost", err: fmt.Errorf(`ent: validator failed for field "Board.hype_cost": %w`, err)}
+		}
+	}
+	if v, ok := bu.mutation.Seq(); ok {
+		if err := board.SeqValidator(v); err != nil {
+			return &ValidationError{Name: "seq", err: fmt.Errorf(`ent: validator failed for field "Board.seq": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (bu *BoardUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := bu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(board.Table, board.Columns, sqlgraph.NewFieldSpec(board.FieldID, field.TypeInt))
+	if ps := bu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bu.mutation.Name(); ok {
+		_spec.SetField(board.FieldName, field.TypeString, value)
+	}
+	if value, ok := bu.mutation.Width(); ok {
+		_spec.SetField(board.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := bu.mutation.AddedWidth(); ok {
+		_spec.AddField(board.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := bu.mutation.Height(); ok {
+		_spec.SetField(board.FieldHeight, field.TypeInt, value)
+	}
+	if value, ok := bu.mutation.AddedHeight(); ok {
+		_spec.AddField(board.FieldHeight, field.TypeInt, value)
+	}
+	if value, ok := bu.mutation.Cooldown(); ok {
+		_spec.SetField(board.FieldCooldown, field.TypeInt64, value)
+	}
+	if value, ok := bu.mutation.AddedCooldown(); ok {
+		_spec.AddField(board.FieldCooldown, field.TypeInt64, value)
+	}
+	if value, ok := bu.mutation.UserCooldown(); ok {
+		_spec.SetField(board.FieldUserCooldown, field.TypeInt64, value)
+	}
+	if value, ok := bu.mutation.AddedUserCooldown(); ok {
+		_spec.AddField(board.FieldUserCooldown, field.TypeInt64, value)
+	}
+	if value, ok := bu.mutation.HypeCost(); ok {
+		_spec.SetField(board.FieldHypeCost, field.TypeInt, value)
+	}
+	if value, ok := bu.mutation.AddedHypeCost(); ok {
+		_spec.AddField(board.FieldHypeCost, field.TypeInt, value)
+	}
+	if value, ok := bu.mutation.Palette(); ok {
+		_spec.SetField(board.FieldPalette, field.TypeString, value)
+	}
+	if value, ok := bu.mutation.Open(); ok {
+		_spec.SetField(board.FieldOpen, field.TypeBool, value)
+	}
+	if value, ok := bu.mutation.Seq(); ok {
+		_spec.SetField(board.FieldSeq, field.TypeInt64, value)
+	}
+	if value, ok := bu.mutation.AddedSeq(); ok {
+		_spec.AddField(board.FieldSeq, field.TypeInt64, value)
+	}
+	if bu.mutation.PixelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.PixelsTable,
+			Columns: []string{board.PixelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pixel.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.RemovedPixelsIDs(); len(nodes) > 0 && !bu.mutation.PixelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.PixelsTable,
+			Columns: []string{board.PixelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pixel.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.PixelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.PixelsTable,
+			Columns: []string{board.PixelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pixel.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bu.mutation.ChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.ChangesTable,
+			Columns: []string{board.ChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pixelchange.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.RemovedChangesIDs(); len(nodes) > 0 && !bu.mutation.ChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.ChangesTable,
+			Columns: []string{board.ChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pixelchange.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.ChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.ChangesTable,
+			Columns: []string{board.ChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pixelchange.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bu.mutation.RegionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.RegionsTable,
+			Columns: []string{board.RegionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(region.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.RemovedRegionsIDs(); len(nodes) > 0 && !bu.mutation.RegionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.RegionsTable,
+			Columns: []string{board.RegionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(region.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.RegionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.RegionsTable,
+			Columns: []string{board.RegionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(region.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bu.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   board.OwnerTable,
+			Columns: []string{board.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   board.OwnerTable,
+			Columns: []string{board.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, bu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{board.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	bu.mutation.done = true
+	return n, nil
+}
+
+// BoardUpdateOne is the builder for updating a single Board entity.
+type BoardUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BoardMutation
+}
+
+// SetName sets the "name" field.
+func (buo *BoardUpdateOne) SetName(s string) *BoardUpdateOne {
+	buo.mutation.SetName(s)
+	return buo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (buo *BoardUpdateOne) SetNillableName(s *string) *BoardUpdateOne {
+	if s != nil {
+		buo.SetName(*s)
+	}
+	return buo
+}
+
+// SetWidth sets the "width" field.
+func (buo *BoardUpdateOne) SetWidth(i int) *BoardUpdateOne {
+	buo.mutation.ResetWidth()
+	buo.mutation.SetWidth(i)
+	return buo
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (buo *BoardUpdateOne) SetNillableWidth(i *int) *BoardUpdateOne {
+	if i != nil {
+		buo.SetWidth(*i)
+	}
+	return buo
+}
+
+// AddWidth adds i to the "width" field.
+func (buo *BoardUpdateOne) AddWidth(i int) *BoardUpdateOne {
+	buo.mutation.AddWidth(i)
+	return buo
+}
+
+// SetHeight sets the "height" field.
+func (buo *BoardUpdateOne) SetHeight(i int) *BoardUpdateOne {
+	buo.mutation.ResetHeight()
+	buo.mutation.SetHeight(i)
+	return buo
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (buo *BoardUpdateOne) SetNillableHeight(i *int) *BoardUpdateOne {
+	if i != nil {
+		buo.SetHeight(*i)
+	}
+	return buo
+}
+
+// AddHeight adds i to the "height" field.
+func (buo *BoardUpdateOne) AddHeight(i int) *BoardUpdateOne {
+	buo.mutation.AddHeight(i)
+	return buo
+}
+
+// SetCooldown sets the "cooldown" field.
+func (buo *BoardUpdateOne) SetCooldown(t time.Duration) *BoardUpdateOne {
+	buo.mutation.ResetCooldown()
+	buo.mutation.SetCooldown(t)
+	return buo
+}
+
+// SetNillableCooldown sets the "cooldown" field if the given value is not nil.
+func (buo *BoardUpdateOne) SetNillableCooldown(t *time.Duration) *BoardUpdateOne {
+	if t != nil {
+		buo.SetCooldown(*t)
+	}
+	return buo
+}
+
+// AddCooldown adds t to the "cooldown" field.
+func (buo *BoardUpdateOne) AddCooldown(t time.Duration) *BoardUpdateOne {
+	buo.mutation.AddCooldown(t)
+	return buo
+}
+
+// SetUserCooldown sets the "user_cooldown" field.
+func (buo *BoardUpdateOne) SetUserCooldown(t time.Duration) *BoardUpdateOne {
+	buo.mutation.ResetUserCooldown()
+	buo.mutation.SetUserCooldown(t)
+	return buo
+}
+
+// SetNillableUserCooldown sets the "user_cooldown" field if the given value is not nil.
+func (buo *BoardUpdateOne) SetNillableUserCooldown(t *time.Duration) *BoardUpdateOne {
+	if t != nil {
+		buo.SetUserCooldown(*t)
+	}
+	return buo
+}
+
+// AddUserCooldown adds t to the "user_cooldown" field.
+func (buo *BoardUpdateOne) AddUserCooldown(t time.Duration) *BoardUpdateOne {
+	buo.mutation.AddUserCooldown(t)
+	return buo
+}
+
+// SetHypeCost sets the "hype_cost" field.
+func (buo *BoardUpdateOne) SetHypeCost(i int) *BoardUpdateOne {
+	buo.mutation.ResetHypeCost()
+	buo.mutation.SetHypeCost(i)
+	return buo
+}
+
+// SetNillableHypeCost sets the "hype_cost" field if the given value is not nil.
+func (buo *BoardUpdateOne) SetNillableHypeCost(i *int) *BoardUpdateOne {
+	if i != nil {
+		buo.SetHypeCost(*i)
+	}
+	return buo
+}
+
+// AddHypeCost adds i to the "hype_cost" field.
+func (buo *BoardUpdateOne) AddHypeCost(i int) *BoardUpdateOne {
+	buo.mutation.AddHypeCost(i)
+	return buo
+}
+
+// SetPalette sets the "palette" field.
+func (buo *BoardUpdateOne) SetPalette(s string) *BoardUpdateOne {
+	buo.mutation.SetPalette(s)
+	return buo
+}
+
+// SetNillablePalette sets the "palette" field if the given value is not nil.
+func (buo *BoardUpdateOne) SetNillablePalette(s *string) *BoardUpdateOne {
+	if s != nil {
+		buo.SetPalette(*s)
+	}
+	return buo
+}
+
+// SetOpen sets the "open" field.
+func (buo *BoardUpdateOne) SetOpen(b bool) *BoardUpdateOne {
+	buo.mutation.SetOpen(b)
+	return buo
+}
+
+// SetNillableOpen sets the "open" field if the given value is not nil.
+func (buo *BoardUpdateOne) SetNillableOpen(b *bool) *BoardUpdateOne {
+	if b != nil {
+		buo.SetOpen(*b)
+	}
+	return buo
+}
+
+// SetSeq sets the "seq" field.
+func (buo *BoardUpdateOne) SetSeq(i int64) *BoardUpdateOne {
+	buo.mutation.ResetSeq()
+	buo.mutation.SetSeq(i)
+	return buo
+}
+
+// SetNillableSeq sets the "seq" field if the given value is not nil.
+func (buo *BoardUpdateOne) SetNillableSeq(i *int64) *BoardUpdateOne {
+	if i != nil {
+		buo.SetSeq(*i)
+	}
+	return buo
+}
+
+// AddSeq adds i to the "seq" field.
+func (buo *BoardUpdateOne) AddSeq(i int64) *BoardUpdateOne {
+	buo.mutation.AddSeq(i)
+	return buo
+}
+
+// AddPixelIDs adds the "pixels" edge to the Pixel entity by IDs.
+func (buo *BoardUpdateOne) AddPixelIDs(ids ...int) *BoardUpdateOne {
+	buo.mutation.AddPixelIDs(ids...)
+	return buo
+}
+
+// AddPixels adds the "pixels" edges to the Pixel entity.
+func (buo *BoardUpdateOne) AddPixels(p ...*Pixel) *BoardUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return buo.AddPixelIDs(ids...)
+}
+
+// AddChangeIDs adds the "changes" edge to the PixelChange entity by IDs.
+func (buo *BoardUpdateOne) AddChangeIDs(ids ...int) *BoardUpdateOne {
+	buo.mutation.AddChangeIDs(ids...)
+	return buo
+}
+
+// AddChanges adds the "changes" edges to the PixelChange entity.
+func (buo *BoardUpdateOne) AddChanges(p ...*PixelChange) *BoardUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return buo.AddChangeIDs(ids...)
+}
+
+// AddRegionIDs adds the "regions" edge to the Region entity by IDs.
+func (buo *BoardUpdateOne) AddRegionIDs(ids ...int) *BoardUpdateOne {
+	buo.mutation.AddRegionIDs(ids...)
+	return buo
+}
+
+// AddRegions adds the "regions" edges to the Region entity.
+func (buo *BoardUpdateOne) AddRegions(r ...*Region) *BoardUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return buo.AddRegionIDs(ids...)
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (buo *BoardUpdateOne) SetOwnerID(id int64) *BoardUpdateOne {
+	buo.mutation.SetOwnerID(id)
+	return buo
+}
+
+// SetNillableOwnerID sets the "owner" edge to the User entity by ID if the given value is not nil.
+func (buo *BoardUpdateOne) SetNillableOwnerID(id *int64) *BoardUpdateOne {
+	if id != nil {
+		buo = buo.SetOwnerID(*id)
+	}
+	return buo
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (buo *BoardUpdateOne) SetOwner(u *User) *BoardUpdateOne {
+	return buo.SetOwnerID(u.ID)
+}
+
+// Mutation returns the BoardMutation object of the builder.
+func (buo *BoardUpdateOne) Mutation() *BoardMutation {
+	return buo.mutation
+}
+
+// ClearPixels clears all "pixels" edges to the Pixel entity.
+func (buo *BoardUpdateOne) ClearPixels() *BoardUpdateOne {
+	buo.mutation.ClearPixels()
+	return buo
+}
+
+// RemovePixelIDs removes the "pixels" edge to Pixel entities by IDs.
+func (buo *BoardUpdateOne) RemovePixelIDs(ids ...int) *BoardUpdateOne {
+	buo.mutation.RemovePixelIDs(ids...)
+	return buo
+}
+
+// RemovePixels removes "pixels" edges to Pixel entities.
+func (buo *BoardUpdateOne) RemovePixels(p ...*Pixel) *BoardUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return buo.RemovePixelIDs(ids...)
+}
+
+// ClearChanges clears all "changes" edges to the PixelChange entity.
+func (buo *BoardUpdateOne) ClearChanges() *BoardUpdateOne {
+	buo.mutation.ClearChanges()
+	return buo
+}
+
+// RemoveChangeIDs removes the "changes" edge to PixelChange entities by IDs.
+func (buo *BoardUpdateOne) RemoveChangeIDs(ids ...int) *BoardUpdateOne {
+	buo.mutation.RemoveChangeIDs(ids...)
+	return buo
+}
+
+// RemoveChanges removes "changes" edges to PixelChange entities.
+func (buo *BoardUpdateOne) RemoveChanges(p ...*PixelChange) *BoardUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return buo.RemoveChangeIDs(ids...)
+}
+
+// ClearRegions clears all "regions" edges to the Region entity.
+func (buo *BoardUpdateOne) ClearRegions() *BoardUpdateOne {
+	buo.mutation.ClearRegions()
+	return buo
+}
+
+// RemoveRegionIDs removes the "regions" edge to Region entities by IDs.
+func (buo *BoardUpdateOne) RemoveRegionIDs(ids ...int) *BoardUpdateOne {
+	buo.mutation.RemoveRegionIDs(ids...)
+	return buo
+}
+
+// RemoveRegions removes "regions" edges to Region entities.
+func (buo *BoardUpdateOne) RemoveRegions(r ...*Region) *BoardUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return buo.RemoveRegionIDs(ids...)
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (buo *BoardUpdateOne) ClearOwner() *BoardUpdateOne {
+	buo.mutation.ClearOwner()
+	return buo
+}
+
+// Where appends a list predicates to the BoardUpdate builder.
+func (buo *BoardUpdateOne) Where(ps ...predicate.Board) *BoardUpdateOne {
+	buo.mutation.Where(ps...)
+	return buo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (buo *BoardUpdateOne) Select(field string, fields ...string) *BoardUpdateOne {
+	buo.fields = append([]string{field}, fields...)
+	return buo
+}
+
+// Save executes the query and returns the updated Board entity.
+func (buo *BoardUpdateOne) Save(ctx context.Context) (*Board, error) {
+	return withHooks(ctx, buo.sqlSave, buo.mutation, buo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (buo *BoardUpdateOne) SaveX(ctx context.Context) *Board {
+	node, err := buo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (buo *BoardUpdateOne) Exec(ctx context.Context) error {
+	_, err := buo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (buo *BoardUpdateOne) ExecX(ctx context.Context) {
+	if err := buo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (buo *BoardUpdateOne) check() error {
+	if v, ok := buo.mutation.Name(); ok {
+		if err := board.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Board.name": %w`, err)}
+		}
+	}
+	if v, ok := buo.mutation.Width(); ok {
+		if err := board.WidthValidator(v); err != nil {
+			return &ValidationError{Name: "width", err: fmt.Errorf(`ent: validator failed for field "Board.width": %w`, err)}
+		}
+	}
+	if v, ok := buo.mutation.Height(); ok {
+		if err := board.HeightValidator(v); err != nil {
+			return &ValidationError{Name: "height", err: fmt.Errorf(`ent: validator failed for field "Board.height": %w`, err)}
+		}
+	}
+	if v, ok := buo.mutation.HypeCost(); ok {
+		if err := board.HypeCostValidator(v); err != nil {
+			return &ValidationError{Name: "hype_cost", err: fmt.Errorf(`ent: validator failed for field "Board.hype_cost": %w`, err)}
+		}
+	}
+	if v, ok := buo.mutation.Seq(); ok {
+		if err := board.SeqValidator(v); err != nil {
+			return &ValidationError{Name: "seq", err: fmt.Errorf(`ent: validator failed for field "Board.seq": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (buo *BoardUpdateOne) sqlSave(ctx context.Context) (_node *Board, err error) {
+	if err := buo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(board.Table, board.Columns, sqlgraph.NewFieldSpec(board.FieldID, field.TypeInt))
+	id, ok := buo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Board.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := buo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, board.FieldID)
+		for _, f := range fields {
+			if !board.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != board.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := buo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := buo.mutation.Name(); ok {
+		_spec.SetField(board.FieldName, field.TypeString, value)
+	}
+	if value, ok := buo.mutation.Width(); ok {
+		_spec.SetField(board.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := buo.mutation.AddedWidth(); ok {
+		_spec.AddField(board.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := buo.mutation.Height(); ok {
+		_spec.SetField(board.FieldHeight, field.TypeInt, value)
+	}
+	if value, ok := buo.mutation.AddedHeight(); ok {
+		_spec.AddField(board.FieldHeight, field.TypeInt, value)
+	}
+	if value, ok := buo.mutation.Cooldown(); ok {
+		_spec.SetField(board.FieldCooldown, field.TypeInt64, value)
+	}
+	if value, ok := buo.mutation.AddedCooldown(); ok {
+		_spec.AddField(board.FieldCooldown, field.TypeInt64, value)
+	}
+	if value, ok := buo.mutation.UserCooldown(); ok {
+		_spec.SetField(board.FieldUserCooldown, field.TypeInt64, value)
+	}
+	if value, ok := buo.mutation.AddedUserCooldown(); ok {
+		_spec.AddField(board.FieldUserCooldown, field.TypeInt64, value)
+	}
+	if value, ok := buo.mutation.HypeCost(); ok {
+		_spec.SetField(board.FieldHypeCost, field.TypeInt, value)
+	}
+	if value, ok := buo.mutation.AddedHypeCost(); ok {
+		_spec.AddField(board.FieldHypeCost, field.TypeInt, value)
+	}
+	if value, ok := buo.mutation.Palette(); ok {
+		_spec.SetField(board.FieldPalette, field.TypeString, value)
+	}
+	if value, ok := buo.mutation.Open(); ok {
+		_spec.SetField(board.FieldOpen, field.TypeBool, value)
+	}
+	if value, ok := buo.mutation.Seq(); ok {
+		_spec.SetField(board.FieldSeq, field.TypeInt64, value)
+	}
+	if value, ok := buo.mutation.AddedSeq(); ok {
+		_spec.AddField(board.FieldSeq, field.TypeInt64, value)
+	}
+	if buo.mutation.PixelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.PixelsTable,
+			Columns: []string{board.PixelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pixel.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.RemovedPixelsIDs(); len(nodes) > 0 && !buo.mutation.PixelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.PixelsTable,
+			Columns: []string{board.PixelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pixel.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.PixelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.PixelsTable,
+			Columns: []string{board.PixelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pixel.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if buo.mutation.ChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.ChangesTable,
+			Columns: []string{board.ChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pixelchange.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.RemovedChangesIDs(); len(nodes) > 0 && !buo.mutation.ChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.ChangesTable,
+			Columns: []string{board.ChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pixelchange.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.ChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.ChangesTable,
+			Columns: []string{board.ChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pixelchange.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if buo.mutation.RegionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.RegionsTable,
+			Columns: []string{board.RegionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(region.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.RemovedRegionsIDs(); len(nodes) > 0 && !buo.mutation.RegionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.RegionsTable,
+			Columns: []string{board.RegionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(region.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.RegionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.RegionsTable,
+			Columns: []string{board.RegionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(region.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if buo.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   board.OwnerTable,
+			Columns: []string{board.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   board.OwnerTable,
+			Columns: []string{board.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Board{config: buo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, buo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{board.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	buo.mutation.done = true
+	return _node, nil
+}
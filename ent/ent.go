@@ -6,8 +6,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"nevissGo/ent/board"
 	"nevissGo/ent/hype"
+	"nevissGo/ent/hypeledger"
+	"nevissGo/ent/outboxevent"
 	"nevissGo/ent/pixel"
+	"nevissGo/ent/pixelchange"
+	"nevissGo/ent/region"
+	"nevissGo/ent/team"
 	"nevissGo/ent/user"
 	"reflect"
 	"sync"
@@ -75,9 +81,15 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			hype.Table:  hype.ValidColumn,
-			pixel.Table: pixel.ValidColumn,
-			user.Table:  user.ValidColumn,
+			board.Table:       board.ValidColumn,
+			hype.Table:        hype.ValidColumn,
+			hypeledger.Table:  hypeledger.ValidColumn,
+			outboxevent.Table: outboxevent.ValidColumn,
+			pixel.Table:       pixel.ValidColumn,
+			pixelchange.Table: pixelchange.ValidColumn,
+			region.Table:      region.ValidColumn,
+			team.Table:        team.ValidColumn,
+			user.Table:        user.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -26,6 +26,10 @@ type Hype struct {
 	LastUpdatedAt time.Time `json:"last_updated_at,omitempty"`
 	// HypePerMinute holds the value of the "hype_per_minute" field.
 	HypePerMinute int `json:"hype_per_minute,omitempty"`
+	// CustomLimits holds the value of the "custom_limits" field.
+	CustomLimits bool `json:"custom_limits,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the HypeQuery when eager-loading is set.
 	Edges        HypeEdges `json:"edges"`
@@ -58,7 +62,9 @@ func (*Hype) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case hype.FieldID, hype.FieldAmountRemaining, hype.FieldMaxHype, hype.FieldHypePerMinute:
+		case hype.FieldCustomLimits:
+			values[i] = new(sql.NullBool)
+		case hype.FieldID, hype.FieldAmountRemaining, hype.FieldMaxHype, hype.FieldHypePerMinute, hype.FieldVersion:
 			values[i] = new(sql.NullInt64)
 		case hype.FieldLastUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -109,6 +115,18 @@ func (h *Hype) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				h.HypePerMinute = int(value.Int64)
 			}
+		case hype.FieldCustomLimits:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field custom_limits", values[i])
+			} else if value.Valid {
+				h.CustomLimits = value.Bool
+			}
+		case hype.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				h.Version = int(value.Int64)
+			}
 		case hype.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field user_hype", value)
@@ -168,6 +186,12 @@ func (h *Hype) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("hype_per_minute=")
 	builder.WriteString(fmt.Sprintf("%v", h.HypePerMinute))
+	builder.WriteString(", ")
+	builder.WriteString("custom_limits=")
+	builder.WriteString(fmt.Sprintf("%v", h.CustomLimits))
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", h.Version))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -22,6 +22,10 @@ const (
 	FieldLastUpdatedAt = "last_updated_at"
 	// FieldHypePerMinute holds the string denoting the hype_per_minute field in the database.
 	FieldHypePerMinute = "hype_per_minute"
+	// FieldCustomLimits holds the string denoting the custom_limits field in the database.
+	FieldCustomLimits = "custom_limits"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
 	// Table holds the table name of the hype in the database.
@@ -42,6 +46,8 @@ var Columns = []string{
 	FieldMaxHype,
 	FieldLastUpdatedAt,
 	FieldHypePerMinute,
+	FieldCustomLimits,
+	FieldVersion,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "hypes"
@@ -72,6 +78,10 @@ var (
 	UpdateDefaultLastUpdatedAt func() time.Time
 	// DefaultHypePerMinute holds the default value on creation for the "hype_per_minute" field.
 	DefaultHypePerMinute int
+	// DefaultCustomLimits holds the default value on creation for the "custom_limits" field.
+	DefaultCustomLimits bool
+	// DefaultVersion holds the default value on creation for the "version" field.
+	DefaultVersion int
 )
 
 // OrderOption defines the ordering options for the Hype queries.
@@ -102,6 +112,16 @@ func ByHypePerMinute(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldHypePerMinute, opts...).ToFunc()
 }
 
+// ByCustomLimits orders the results by the custom_limits field.
+func ByCustomLimits(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCustomLimits, opts...).ToFunc()
+}
+
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
 // ByUserField orders the results by user field.
 func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -75,6 +75,16 @@ func HypePerMinute(v int) predicate.Hype {
 	return predicate.Hype(sql.FieldEQ(FieldHypePerMinute, v))
 }
 
+// CustomLimits applies equality check predicate on the "custom_limits" field. It's identical to CustomLimitsEQ.
+func CustomLimits(v bool) predicate.Hype {
+	return predicate.Hype(sql.FieldEQ(FieldCustomLimits, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.Hype {
+	return predicate.Hype(sql.FieldEQ(FieldVersion, v))
+}
+
 // AmountRemainingEQ applies the EQ predicate on the "amount_remaining" field.
 func AmountRemainingEQ(v int) predicate.Hype {
 	return predicate.Hype(sql.FieldEQ(FieldAmountRemaining, v))
@@ -235,6 +245,56 @@ func HypePerMinuteLTE(v int) predicate.Hype {
 	return predicate.Hype(sql.FieldLTE(FieldHypePerMinute, v))
 }
 
+// CustomLimitsEQ applies the EQ predicate on the "custom_limits" field.
+func CustomLimitsEQ(v bool) predicate.Hype {
+	return predicate.Hype(sql.FieldEQ(FieldCustomLimits, v))
+}
+
+// CustomLimitsNEQ applies the NEQ predicate on the "custom_limits" field.
+func CustomLimitsNEQ(v bool) predicate.Hype {
+	return predicate.Hype(sql.FieldNEQ(FieldCustomLimits, v))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.Hype {
+	return predicate.Hype(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.Hype {
+	return predicate.Hype(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.Hype {
+	return predicate.Hype(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.Hype {
+	return predicate.Hype(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.Hype {
+	return predicate.Hype(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.Hype {
+	return predicate.Hype(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.Hype {
+	return predicate.Hype(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.Hype {
+	return predicate.Hype(sql.FieldLTE(FieldVersion, v))
+}
+
 // HasUser applies the HasEdge predicate on the "user" edge.
 func HasUser() predicate.Hype {
 	return predicate.Hype(func(s *sql.Selector) {
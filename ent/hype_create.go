@@ -61,6 +61,34 @@ func (hc *HypeCreate) SetNillableHypePerMinute(i *int) *HypeCreate {
 	return hc
 }
 
+// SetCustomLimits sets the "custom_limits" field.
+func (hc *HypeCreate) SetCustomLimits(b bool) *HypeCreate {
+	hc.mutation.SetCustomLimits(b)
+	return hc
+}
+
+// SetNillableCustomLimits sets the "custom_limits" field if the given value is not nil.
+func (hc *HypeCreate) SetNillableCustomLimits(b *bool) *HypeCreate {
+	if b != nil {
+		hc.SetCustomLimits(*b)
+	}
+	return hc
+}
+
+// SetVersion sets the "version" field.
+func (hc *HypeCreate) SetVersion(i int) *HypeCreate {
+	hc.mutation.SetVersion(i)
+	return hc
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (hc *HypeCreate) SetNillableVersion(i *int) *HypeCreate {
+	if i != nil {
+		hc.SetVersion(*i)
+	}
+	return hc
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (hc *HypeCreate) SetUserID(id int64) *HypeCreate {
 	hc.mutation.SetUserID(id)
@@ -115,6 +143,14 @@ func (hc *HypeCreate) defaults() {
 		v := hype.DefaultHypePerMinute
 		hc.mutation.SetHypePerMinute(v)
 	}
+	if _, ok := hc.mutation.CustomLimits(); !ok {
+		v := hype.DefaultCustomLimits
+		hc.mutation.SetCustomLimits(v)
+	}
+	if _, ok := hc.mutation.Version(); !ok {
+		v := hype.DefaultVersion
+		hc.mutation.SetVersion(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -131,6 +167,12 @@ func (hc *HypeCreate) check() error {
 	if _, ok := hc.mutation.HypePerMinute(); !ok {
 		return &ValidationError{Name: "hype_per_minute", err: errors.New(`ent: missing required field "Hype.hype_per_minute"`)}
 	}
+	if _, ok := hc.mutation.CustomLimits(); !ok {
+		return &ValidationError{Name: "custom_limits", err: errors.New(`ent: missing required field "Hype.custom_limits"`)}
+	}
+	if _, ok := hc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Hype.version"`)}
+	}
 	if len(hc.mutation.UserIDs()) == 0 {
 		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Hype.user"`)}
 	}
@@ -176,6 +218,14 @@ func (hc *HypeCreate) createSpec() (*Hype, *sqlgraph.CreateSpec) {
 		_spec.SetField(hype.FieldHypePerMinute, field.TypeInt, value)
 		_node.HypePerMinute = value
 	}
+	if value, ok := hc.mutation.CustomLimits(); ok {
+		_spec.SetField(hype.FieldCustomLimits, field.TypeBool, value)
+		_node.CustomLimits = value
+	}
+	if value, ok := hc.mutation.Version(); ok {
+		_spec.SetField(hype.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
 	if nodes := hc.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
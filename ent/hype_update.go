@@ -98,6 +98,41 @@ func (hu *HypeUpdate) AddHypePerMinute(i int) *HypeUpdate {
 	return hu
 }
 
+// SetCustomLimits sets the "custom_limits" field.
+func (hu *HypeUpdate) SetCustomLimits(b bool) *HypeUpdate {
+	hu.mutation.SetCustomLimits(b)
+	return hu
+}
+
+// SetNillableCustomLimits sets the "custom_limits" field if the given value is not nil.
+func (hu *HypeUpdate) SetNillableCustomLimits(b *bool) *HypeUpdate {
+	if b != nil {
+		hu.SetCustomLimits(*b)
+	}
+	return hu
+}
+
+// SetVersion sets the "version" field.
+func (hu *HypeUpdate) SetVersion(i int) *HypeUpdate {
+	hu.mutation.ResetVersion()
+	hu.mutation.SetVersion(i)
+	return hu
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (hu *HypeUpdate) SetNillableVersion(i *int) *HypeUpdate {
+	if i != nil {
+		hu.SetVersion(*i)
+	}
+	return hu
+}
+
+// AddVersion adds i to the "version" field.
+func (hu *HypeUpdate) AddVersion(i int) *HypeUpdate {
+	hu.mutation.AddVersion(i)
+	return hu
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (hu *HypeUpdate) SetUserID(id int64) *HypeUpdate {
 	hu.mutation.SetUserID(id)
@@ -197,6 +232,15 @@ func (hu *HypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := hu.mutation.AddedHypePerMinute(); ok {
 		_spec.AddField(hype.FieldHypePerMinute, field.TypeInt, value)
 	}
+	if value, ok := hu.mutation.CustomLimits(); ok {
+		_spec.SetField(hype.FieldCustomLimits, field.TypeBool, value)
+	}
+	if value, ok := hu.mutation.Version(); ok {
+		_spec.SetField(hype.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := hu.mutation.AddedVersion(); ok {
+		_spec.AddField(hype.FieldVersion, field.TypeInt, value)
+	}
 	if hu.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -315,6 +359,41 @@ func (huo *HypeUpdateOne) AddHypePerMinute(i int) *HypeUpdateOne {
 	return huo
 }
 
+// SetCustomLimits sets the "custom_limits" field.
+func (huo *HypeUpdateOne) SetCustomLimits(b bool) *HypeUpdateOne {
+	huo.mutation.SetCustomLimits(b)
+	return huo
+}
+
+// SetNillableCustomLimits sets the "custom_limits" field if the given value is not nil.
+func (huo *HypeUpdateOne) SetNillableCustomLimits(b *bool) *HypeUpdateOne {
+	if b != nil {
+		huo.SetCustomLimits(*b)
+	}
+	return huo
+}
+
+// SetVersion sets the "version" field.
+func (huo *HypeUpdateOne) SetVersion(i int) *HypeUpdateOne {
+	huo.mutation.ResetVersion()
+	huo.mutation.SetVersion(i)
+	return huo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (huo *HypeUpdateOne) SetNillableVersion(i *int) *HypeUpdateOne {
+	if i != nil {
+		huo.SetVersion(*i)
+	}
+	return huo
+}
+
+// AddVersion adds i to the "version" field.
+func (huo *HypeUpdateOne) AddVersion(i int) *HypeUpdateOne {
+	huo.mutation.AddVersion(i)
+	return huo
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (huo *HypeUpdateOne) SetUserID(id int64) *HypeUpdateOne {
 	huo.mutation.SetUserID(id)
@@ -444,6 +523,15 @@ func (huo *HypeUpdateOne) sqlSave(ctx context.Context) (_node *Hype, err error)
 	if value, ok := huo.mutation.AddedHypePerMinute(); ok {
 		_spec.AddField(hype.FieldHypePerMinute, field.TypeInt, value)
 	}
+	if value, ok := huo.mutation.CustomLimits(); ok {
+		_spec.SetField(hype.FieldCustomLimits, field.TypeBool, value)
+	}
+	if value, ok := huo.mutation.Version(); ok {
+		_spec.SetField(hype.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := huo.mutation.AddedVersion(); ok {
+		_spec.AddField(hype.FieldVersion, field.TypeInt, value)
+	}
 	if huo.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -0,0 +1,217 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"nevissGo/ent/hypeledger"
+	"nevissGo/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// HypeLedger is the model entity for the HypeLedger schema.
+type HypeLedger struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind hypeledger.Kind `json:"kind,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount int `json:"amount,omitempty"`
+	// Balance holds the value of the "balance" field.
+	Balance int `json:"balance,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the HypeLedgerQuery when eager-loading is set.
+	Edges                 HypeLedgerEdges `json:"edges"`
+	user_hype_ledger      *int64
+	user_hype_adjustments *int64
+	selectValues          sql.SelectValues
+}
+
+// HypeLedgerEdges holds the relations/edges for other nodes in the graph.
+type HypeLedgerEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Actor holds the value of the actor edge.
+	Actor *User `json:"actor,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e HypeLedgerEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// ActorOrErr returns the Actor value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e HypeLedgerEdges) ActorOrErr() (*User, error) {
+	if e.Actor != nil {
+		return e.Actor, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "actor"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*HypeLedger) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case hypeledger.FieldID, hypeledger.FieldAmount, hypeledger.FieldBalance:
+			values[i] = new(sql.NullInt64)
+		case hypeledger.FieldKind, hypeledger.FieldReason:
+			values[i] = new(sql.NullString)
+		case hypeledger.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case hypeledger.ForeignKeys[0]: // user_hype_ledger
+			values[i] = new(sql.NullInt64)
+		case hypeledger.ForeignKeys[1]: // user_hype_adjustments
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the HypeLedger fields.
+func (hl *HypeLedger) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case hypeledger.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			hl.ID = int(value.Int64)
+		case hypeledger.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				hl.Kind = hypeledger.Kind(value.String)
+			}
+		case hypeledger.FieldAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				hl.Amount = int(value.Int64)
+			}
+		case hypeledger.FieldBalance:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field balance", values[i])
+			} else if value.Valid {
+				hl.Balance = int(value.Int64)
+			}
+		case hypeledger.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				hl.Reason = value.String
+			}
+		case hypeledger.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				hl.CreatedAt = value.Time
+			}
+		case hypeledger.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_hype_ledger", value)
+			} else if value.Valid {
+				hl.user_hype_ledger = new(int64)
+				*hl.user_hype_ledger = int64(value.Int64)
+			}
+		case hypeledger.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_hype_adjustments", value)
+			} else if value.Valid {
+				hl.user_hype_adjustments = new(int64)
+				*hl.user_hype_adjustments = int64(value.Int64)
+			}
+		default:
+			hl.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the HypeLedger.
+// This includes values selected through modifiers, order, etc.
+func (hl *HypeLedger) Value(name string) (ent.Value, error) {
+	return hl.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the HypeLedger entity.
+func (hl *HypeLedger) QueryUser() *UserQuery {
+	return NewHypeLedgerClient(hl.config).QueryUser(hl)
+}
+
+// QueryActor queries the "actor" edge of the HypeLedger entity.
+func (hl *HypeLedger) QueryActor() *UserQuery {
+	return NewHypeLedgerClient(hl.config).QueryActor(hl)
+}
+
+// Update returns a builder for updating this HypeLedger.
+// Note that you need to call HypeLedger.Unwrap() before calling this method if this HypeLedger
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (hl *HypeLedger) Update() *HypeLedgerUpdateOne {
+	return NewHypeLedgerClient(hl.config).UpdateOne(hl)
+}
+
+// Unwrap unwraps the HypeLedger entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (hl *HypeLedger) Unwrap() *HypeLedger {
+	_tx, ok := hl.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: HypeLedger is not a transactional entity")
+	}
+	hl.config.driver = _tx.drv
+	return hl
+}
+
+// String implements the fmt.Stringer.
+func (hl *HypeLedger) String() string {
+	var builder strings.Builder
+	builder.WriteString("HypeLedger(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", hl.ID))
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", hl.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", hl.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("balance=")
+	builder.WriteString(fmt.Sprintf("%v", hl.Balance))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(hl.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(hl.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// HypeLedgers is a parsable slice of HypeLedger.
+type HypeLedgers []*HypeLedger
@@ -0,0 +1,332 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"nevissGo/ent/hypeledger"
+	"nevissGo/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// HypeLedgerCreate is the builder for creating a HypeLedger entity.
+type HypeLedgerCreate struct {
+	config
+	mutation *HypeLedgerMutation
+	hooks    []Hook
+}
+
+// SetKind sets the "kind" field.
+func (hlc *HypeLedgerCreate) SetKind(h hypeledger.Kind) *HypeLedgerCreate {
+	hlc.mutation.SetKind(h)
+	return hlc
+}
+
+// SetAmount sets the "amount" field.
+func (hlc *HypeLedgerCreate) SetAmount(i int) *HypeLedgerCreate {
+	hlc.mutation.SetAmount(i)
+	return hlc
+}
+
+// SetBalance sets the "balance" field.
+func (hlc *HypeLedgerCreate) SetBalance(i int) *HypeLedgerCreate {
+	hlc.mutation.SetBalance(i)
+	return hlc
+}
+
+// SetReason sets the "reason" field.
+func (hlc *HypeLedgerCreate) SetReason(s string) *HypeLedgerCreate {
+	hlc.mutation.SetReason(s)
+	return hlc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (hlc *HypeLedgerCreate) SetCreatedAt(t time.Time) *HypeLedgerCreate {
+	hlc.mutation.SetCreatedAt(t)
+	return hlc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (hlc *HypeLedgerCreate) SetNillableCreatedAt(t *time.Time) *HypeLedgerCreate {
+	if t != nil {
+		hlc.SetCreatedAt(*t)
+	}
+	return hlc
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (hlc *HypeLedgerCreate) SetUserID(id int64) *HypeLedgerCreate {
+	hlc.mutation.SetUserID(id)
+	return hlc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (hlc *HypeLedgerCreate) SetUser(u *User) *HypeLedgerCreate {
+	return hlc.SetUserID(u.ID)
+}
+
+// SetActorID sets the "actor" edge to the User entity by ID.
+func (hlc *HypeLedgerCreate) SetActorID(id int64) *HypeLedgerCreate {
+	hlc.mutation.SetActorID(id)
+	return hlc
+}
+
+// SetNillableActorID sets the "actor" edge to the User entity by ID if the given value is not nil.
+func (hlc *HypeLedgerCreate) SetNillableActorID(id *int64) *HypeLedgerCreate {
+	if id != nil {
+		hlc = hlc.SetActorID(*id)
+	}
+	return hlc
+}
+
+// SetActor sets the "actor" edge to the User entity.
+func (hlc *HypeLedgerCreate) SetActor(u *User) *HypeLedgerCreate {
+	return hlc.SetActorID(u.ID)
+}
+
+// Mutation returns the HypeLedgerMutation object of the builder.
+func (hlc *HypeLedgerCreate) Mutation() *HypeLedgerMutation {
+	return hlc.mutation
+}
+
+// Save creates the HypeLedger in the database.
+func (hlc *HypeLedgerCreate) Save(ctx context.Context) (*HypeLedger, error) {
+	hlc.defaults()
+	return withHooks(ctx, hlc.sqlSave, hlc.mutation, hlc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (hlc *HypeLedgerCreate) SaveX(ctx context.Context) *HypeLedger {
+	v, err := hlc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (hlc *HypeLedgerCreate) Exec(ctx context.Context) error {
+	_, err := hlc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hlc *HypeLedgerCreate) ExecX(ctx context.Context) {
+	if err := hlc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (hlc *HypeLedgerCreate) defaults() {
+	if _, ok := hlc.mutation.CreatedAt(); !ok {
+		v := hypeledger.DefaultCreatedAt()
+		hlc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (hlc *HypeLedgerCreate) check() error {
+	if _, ok := hlc.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "HypeLedger.kind"`)}
+	}
+	if v, ok := hlc.mutation.Kind(); ok {
+		if err := hypeledger.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "HypeLedger.kind": %w`, err)}
+		}
+	}
+	if _, ok := hlc.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "HypeLedger.amount"`)}
+	}
+	if _, ok := hlc.mutation.Balance(); !ok {
+		return &ValidationError{Name: "balance", err: errors.New(`ent: missing required field "HypeLedger.balance"`)}
+	}
+	if _, ok := hlc.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "HypeLedger.reason"`)}
+	}
+	if v, ok := hlc.mutation.Reason(); ok {
+		if err := hypeledger.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "HypeLedger.reason": %w`, err)}
+		}
+	}
+	if _, ok := hlc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "HypeLedger.created_at"`)}
+	}
+	if len(hlc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "HypeLedger.user"`)}
+	}
+	return nil
+}
+
+func (hlc *HypeLedgerCreate) sqlSave(ctx context.Context) (*HypeLedger, error) {
+	if err := hlc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := hlc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, hlc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	hlc.mutation.id = &_node.ID
+	hlc.mutation.done = true
+	return _node, nil
+}
+
+func (hlc *HypeLedgerCreate) createSpec() (*HypeLedger, *sqlgraph.CreateSpec) {
+	var (
+		_node = &HypeLedger{config: hlc.config}
+		_spec = sqlgraph.NewCreateSpec(hypeledger.Table, sqlgraph.NewFieldSpec(hypeledger.FieldID, field.TypeInt))
+	)
+	if value, ok := hlc.mutation.Kind(); ok {
+		_spec.SetField(hypeledger.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := hlc.mutation.Amount(); ok {
+		_spec.SetField(hypeledger.FieldAmount, field.TypeInt, value)
+		_node.Amount = value
+	}
+	if value, ok := hlc.mutation.Balance(); ok {
+		_spec.SetField(hypeledger.FieldBalance, field.TypeInt, value)
+		_node.Balance = value
+	}
+	if value, ok := hlc.mutation.Reason(); ok {
+		_spec.SetField(hypeledger.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := hlc.mutation.CreatedAt(); ok {
+		_spec.SetField(hypeledger.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := hlc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   hypeledger.UserTable,
+			Columns: []string{hypeledger.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_hype_ledger = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := hlc.mutation.ActorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   hypeledger.ActorTable,
+			Columns: []string{hypeledger.ActorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_hype_adjustments = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// HypeLedgerCreateBulk is the builder for creating many HypeLedger entities in bulk.
+type HypeLedgerCreateBulk struct {
+	config
+	err      error
+	builders []*HypeLedgerCreate
+}
+
+// Save creates the HypeLedger entities in the database.
+func (hlcb *HypeLedgerCreateBulk) Save(ctx context.Context) ([]*HypeLedger, error) {
+	if hlcb.err != nil {
+		return nil, hlcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(hlcb.builders))
+	nodes := make([]*HypeLedger, len(hlcb.builders))
+	mutators := make([]Mutator, len(hlcb.builders))
+	for i := range hlcb.builders {
+		func(i int, root context.Context) {
+			builder := hlcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*HypeLedgerMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, hlcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, hlcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, hlcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (hlcb *HypeLedgerCreateBulk) SaveX(ctx context.Context) []*HypeLedger {
+	v, err := hlcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (hlcb *HypeLedgerCreateBulk) Exec(ctx context.Context) error {
+	_, err := hlcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hlcb *HypeLedgerCreateBulk) ExecX(ctx context.Context) {
+	if err := hlcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"nevissGo/ent/hypeledger"
+	"nevissGo/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// HypeLedgerDelete is the builder for deleting a HypeLedger entity.
+type HypeLedgerDelete struct {
+	config
+	hooks    []Hook
+	mutation *HypeLedgerMutation
+}
+
+// Where appends a list predicates to the HypeLedgerDelete builder.
+func (hld *HypeLedgerDelete) Where(ps ...predicate.HypeLedger) *HypeLedgerDelete {
+	hld.mutation.Where(ps...)
+	return hld
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (hld *HypeLedgerDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, hld.sqlExec, hld.mutation, hld.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hld *HypeLedgerDelete) ExecX(ctx context.Context) int {
+	n, err := hld.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (hld *HypeLedgerDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(hypeledger.Table, sqlgraph.NewFieldSpec(hypeledger.FieldID, field.TypeInt))
+	if ps := hld.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, hld.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	hld.mutation.done = true
+	return affected, err
+}
+
+// HypeLedgerDeleteOne is the builder for deleting a single HypeLedger entity.
+type HypeLedgerDeleteOne struct {
+	hld *HypeLedgerDelete
+}
+
+// Where appends a list predicates to the HypeLedgerDelete builder.
+func (hldo *HypeLedgerDeleteOne) Where(ps ...predicate.HypeLedger) *HypeLedgerDeleteOne {
+	hldo.hld.mutation.Where(ps...)
+	return hldo
+}
+
+// Exec executes the deletion query.
+func (hldo *HypeLedgerDeleteOne) Exec(ctx context.Context) error {
+	n, err := hldo.hld.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{hypeledger.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hldo *HypeLedgerDeleteOne) ExecX(ctx context.Context) {
+	if err := hldo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,688 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"nevissGo/ent/hypeledger"
+	"nevissGo/ent/predicate"
+	"nevissGo/ent/user"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// HypeLedgerQuery is the builder for querying HypeLedger entities.
+type HypeLedgerQuery struct {
+	config
+	ctx        *QueryContext
+	order      []hypeledger.OrderOption
+	inters     []Interceptor
+	predicates []predicate.HypeLedger
+	withUser   *UserQuery
+	withActor  *UserQuery
+	withFKs    bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the HypeLedgerQuery builder.
+func (hlq *HypeLedgerQuery) Where(ps ...predicate.HypeLedger) *HypeLedgerQuery {
+	hlq.predicates = append(hlq.predicates, ps...)
+	return hlq
+}
+
+// Limit the number of records to be returned by this query.
+func (hlq *HypeLedgerQuery) Limit(limit int) *HypeLedgerQuery {
+	hlq.ctx.Limit = &limit
+	return hlq
+}
+
+// Offset to start from.
+func (hlq *HypeLedgerQuery) Offset(offset int) *HypeLedgerQuery {
+	hlq.ctx.Offset = &offset
+	return hlq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (hlq *HypeLedgerQuery) Unique(unique bool) *HypeLedgerQuery {
+	hlq.ctx.Unique = &unique
+	return hlq
+}
+
+// Order specifies how the records should be ordered.
+func (hlq *HypeLedgerQuery) Order(o ...hypeledger.OrderOption) *HypeLedgerQuery {
+	hlq.order = append(hlq.order, o...)
+	return hlq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (hlq *HypeLedgerQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: hlq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := hlq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := hlq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(hypeledger.Table, hypeledger.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, hypeledger.UserTable, hypeledger.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(hlq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryActor chains the current query on the "actor" edge.
+func (hlq *HypeLedgerQuery) QueryActor() *UserQuery {
+	query := (&UserClient{config: hlq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := hlq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := hlq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(hypeledger.Table, hypeledger.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, hypeledger.ActorTable, hypeledger.ActorColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(hlq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first HypeLedger entity from the query.
+// Returns a *NotFoundError when no HypeLedger was found.
+func (hlq *HypeLedgerQuery) First(ctx context.Context) (*HypeLedger, error) {
+	nodes, err := hlq.Limit(1).All(setContextOp(ctx, hlq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{hypeledger.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (hlq *HypeLedgerQuery) FirstX(ctx context.Context) *HypeLedger {
+	node, err := hlq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first HypeLedger ID from the query.
+// Returns a *NotFoundError when no HypeLedger ID was found.
+func (hlq *HypeLedgerQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = hlq.Limit(1).IDs(setContextOp(ctx, hlq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{hypeledger.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (hlq *HypeLedgerQuery) FirstIDX(ctx context.Context) int {
+	id, err := hlq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single HypeLedger entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one HypeLedger entity is found.
+// Returns a *NotFoundError when no HypeLedger entities are found.
+func (hlq *HypeLedgerQuery) Only(ctx context.Context) (*HypeLedger, error) {
+	nodes, err := hlq.Limit(2).All(setContextOp(ctx, hlq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{hypeledger.Label}
+	default:
+		return nil, &NotSingularError{hypeledger.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (hlq *HypeLedgerQuery) OnlyX(ctx context.Context) *HypeLedger {
+	node, err := hlq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only HypeLedger ID in the query.
+// Returns a *NotSingularError when more than one HypeLedger ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (hlq *HypeLedgerQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = hlq.Limit(2).IDs(setContextOp(ctx, hlq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{hypeledger.Label}
+	default:
+		err = &NotSingularError{hypeledger.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (hlq *HypeLedgerQuery) OnlyIDX(ctx context.Context) int {
+	id, err := hlq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of HypeLedgers.
+func (hlq *HypeLedgerQuery) All(ctx context.Context) ([]*HypeLedger, error) {
+	ctx = setContextOp(ctx, hlq.ctx, ent.OpQueryAll)
+	if err := hlq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*HypeLedger, *HypeLedgerQuery]()
+	return withInterceptors[[]*HypeLedger](ctx, hlq, qr, hlq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (hlq *HypeLedgerQuery) AllX(ctx context.Context) []*HypeLedger {
+	nodes, err := hlq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of HypeLedger IDs.
+func (hlq *HypeLedgerQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if hlq.ctx.Unique == nil && hlq.path != nil {
+		hlq.Unique(true)
+	}
+	ctx = setContextOp(ctx, hlq.ctx, ent.OpQueryIDs)
+	if err = hlq.Select(hypeledger.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (hlq *HypeLedgerQuery) IDsX(ctx context.Context) []int {
+	ids, err := hlq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (hlq *HypeLedgerQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, hlq.ctx, ent.OpQueryCount)
+	if err := hlq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, hlq, querierCount[*HypeLedgerQuery](), hlq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (hlq *HypeLedgerQuery) CountX(ctx context.Context) int {
+	count, err := hlq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (hlq *HypeLedgerQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, hlq.ctx, ent.OpQueryExist)
+	switch _, err := hlq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (hlq *HypeLedgerQuery) ExistX(ctx context.Context) bool {
+	exist, err := hlq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the HypeLedgerQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (hlq *HypeLedgerQuery) Clone() *HypeLedgerQuery {
+	if hlq == nil {
+		return nil
+	}
+	return &HypeLedgerQuery{
+		config:     hlq.config,
+		ctx:        hlq.ctx.Clone(),
+		order:      append([]hypeledger.OrderOption{}, hlq.order...),
+		inters:     append([]Interceptor{}, hlq.inters...),
+		predicates: append([]predicate.HypeLedger{}, hlq.predicates...),
+		withUser:   hlq.withUser.Clone(),
+		withActor:  hlq.withActor.Clone(),
+		// clone intermediate query.
+		sql:  hlq.sql.Clone(),
+		path: hlq.path,
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (hlq *HypeLedgerQuery) WithUser(opts ...func(*UserQuery)) *HypeLedgerQuery {
+	query := (&UserClient{config: hlq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	hlq.withUser = query
+	return hlq
+}
+
+// WithActor tells the query-builder to eager-load the nodes that are connected to
+// the "actor" edge. The optional arguments are used to configure the query builder of the edge.
+func (hlq *HypeLedgerQuery) WithActor(opts ...func(*UserQuery)) *HypeLedgerQuery {
+	query := (&UserClient{config: hlq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	hlq.withActor = query
+	return hlq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Kind hypeledger.Kind `json:"kind,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.HypeLedger.Query().
+//		GroupBy(hypeledger.FieldKind).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (hlq *HypeLedgerQuery) GroupBy(field string, fields ...string) *HypeLedgerGroupBy {
+	hlq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &HypeLedgerGroupBy{build: hlq}
+	grbuild.flds = &hlq.ctx.Fields
+	grbuild.label = hypeledger.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Kind hypeledger.Kind `json:"kind,omitempty"`
+//	}
+//
+//	client.HypeLedger.Query().
+//		Select(hypeledger.FieldKind).
+//		Scan(ctx, &v)
+func (hlq *HypeLedgerQuery) Select(fields ...string) *HypeLedgerSelect {
+	hlq.ctx.Fields = append(hlq.ctx.Fields, fields...)
+	sbuild := &HypeLedgerSelect{HypeLedgerQuery: hlq}
+	sbuild.label = hypeledger.Label
+	sbuild.flds, sbuild.scan = &hlq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a HypeLedgerSelect configured with the given aggregations.
+func (hlq *HypeLedgerQuery) Aggregate(fns ...AggregateFunc) *HypeLedgerSelect {
+	return hlq.Select().Aggregate(fns...)
+}
+
+func (hlq *HypeLedgerQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range hlq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, hlq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range hlq.ctx.Fields {
+		if !hypeledger.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if hlq.path != nil {
+		prev, err := hlq.path(ctx)
+		if err != nil {
+			return err
+		}
+		hlq.sql = prev
+	}
+	return nil
+}
+
+func (hlq *HypeLedgerQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*HypeLedger, error) {
+	var (
+		nodes       = []*HypeLedger{}
+		withFKs     = hlq.withFKs
+		_spec       = hlq.querySpec()
+		loadedTypes = [2]bool{
+			hlq.withUser != nil,
+			hlq.withActor != nil,
+		}
+	)
+	if hlq.withUser != nil || hlq.withActor != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, hypeledger.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*HypeLedger).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &HypeLedger{config: hlq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, hlq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := hlq.withUser; query != nil {
+		if err := hlq.loadUser(ctx, query, nodes, nil,
+			func(n *HypeLedger, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := hlq.withActor; query != nil {
+		if err := hlq.loadActor(ctx, query, nodes, nil,
+			func(n *HypeLedger, e *User) { n.Edges.Actor = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (hlq *HypeLedgerQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*HypeLedger, init func(*HypeLedger), assign func(*HypeLedger, *User)) error {
+	ids := make([]int64, 0, len(nodes))
+	nodeids := make(map[int64][]*HypeLedger)
+	for i := range nodes {
+		if nodes[i].user_hype_ledger == nil {
+			continue
+		}
+		fk := *nodes[i].user_hype_ledger
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_hype_ledger" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (hlq *HypeLedgerQuery) loadActor(ctx context.Context, query *UserQuery, nodes []*HypeLedger, init func(*HypeLedger), assign func(*HypeLedger, *User)) error {
+	ids := make([]int64, 0, len(nodes))
+	nodeids := make(map[int64][]*HypeLedger)
+	for i := range nodes {
+		if nodes[i].user_hype_adjustments == nil {
+			continue
+		}
+		fk := *nodes[i].user_hype_adjustments
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_hype_adjustments" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (hlq *HypeLedgerQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := hlq.querySpec()
+	_spec.Node.Columns = hlq.ctx.Fields
+	if len(hlq.ctx.Fields) > 0 {
+		_spec.Unique = hlq.ctx.Unique != nil && *hlq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, hlq.driver, _spec)
+}
+
+func (hlq *HypeLedgerQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(hypeledger.Table, hypeledger.Columns, sqlgraph.NewFieldSpec(hypeledger.FieldID, field.TypeInt))
+	_spec.From = hlq.sql
+	if unique := hlq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if hlq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := hlq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, hypeledger.FieldID)
+		for i := range fields {
+			if fields[i] != hypeledger.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := hlq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := hlq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := hlq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := hlq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (hlq *HypeLedgerQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(hlq.driver.Dialect())
+	t1 := builder.Table(hypeledger.Table)
+	columns := hlq.ctx.Fields
+	if len(columns) == 0 {
+		columns = hypeledger.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if hlq.sql != nil {
+		selector = hlq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if hlq.ctx.Unique != nil && *hlq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range hlq.predicates {
+		p(selector)
+	}
+	for _, p := range hlq.order {
+		p(selector)
+	}
+	if offset := hlq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := hlq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// HypeLedgerGroupBy is the group-by builder for HypeLedger entities.
+type HypeLedgerGroupBy struct {
+	selector
+	build *HypeLedgerQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (hlgb *HypeLedgerGroupBy) Aggregate(fns ...AggregateFunc) *HypeLedgerGroupBy {
+	hlgb.fns = append(hlgb.fns, fns...)
+	return hlgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (hlgb *HypeLedgerGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, hlgb.build.ctx, ent.OpQueryGroupBy)
+	if err := hlgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*HypeLedgerQuery, *HypeLedgerGroupBy](ctx, hlgb.build, hlgb, hlgb.build.inters, v)
+}
+
+func (hlgb *HypeLedgerGroupBy) sqlScan(ctx context.Context, root *HypeLedgerQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(hlgb.fns))
+	for _, fn := range hlgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*hlgb.flds)+len(hlgb.fns))
+		for _, f := range *hlgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*hlgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := hlgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// HypeLedgerSelect is the builder for selecting fields of HypeLedger entities.
+type HypeLedgerSelect struct {
+	*HypeLedgerQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (hls *HypeLedgerSelect) Aggregate(fns ...AggregateFunc) *HypeLedgerSelect {
+	hls.fns = append(hls.fns, fns...)
+	return hls
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (hls *HypeLedgerSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, hls.ctx, ent.OpQuerySelect)
+	if err := hls.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*HypeLedgerQuery, *HypeLedgerSelect](ctx, hls.HypeLedgerQuery, hls, hls.inters, v)
+}
+
+func (hls *HypeLedgerSelect) sqlScan(ctx context.Context, root *HypeLedgerQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(hls.fns))
+	for _, fn := range hls.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*hls.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := hls.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,197 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"nevissGo/ent/hypeledger"
+	"nevissGo/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// HypeLedgerUpdate is the builder for updating HypeLedger entities.
+type HypeLedgerUpdate struct {
+	config
+	hooks    []Hook
+	mutation *HypeLedgerMutation
+}
+
+// Where appends a list predicates to the HypeLedgerUpdate builder.
+func (hlu *HypeLedgerUpdate) Where(ps ...predicate.HypeLedger) *HypeLedgerUpdate {
+	hlu.mutation.Where(ps...)
+	return hlu
+}
+
+// Mutation returns the HypeLedgerMutation object of the builder.
+func (hlu *HypeLedgerUpdate) Mutation() *HypeLedgerMutation {
+	return hlu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (hlu *HypeLedgerUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, hlu.sqlSave, hlu.mutation, hlu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (hlu *HypeLedgerUpdate) SaveX(ctx context.Context) int {
+	affected, err := hlu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (hlu *HypeLedgerUpdate) Exec(ctx context.Context) error {
+	_, err := hlu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hlu *HypeLedgerUpdate) ExecX(ctx context.Context) {
+	if err := hlu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (hlu *HypeLedgerUpdate) check() error {
+	if hlu.mutation.UserCleared() && len(hlu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "HypeLedger.user"`)
+	}
+	return nil
+}
+
+func (hlu *HypeLedgerUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := hlu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(hypeledger.Table, hypeledger.Columns, sqlgraph.NewFieldSpec(hypeledger.FieldID, field.TypeInt))
+	if ps := hlu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, hlu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{hypeledger.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	hlu.mutation.done = true
+	return n, nil
+}
+
+// HypeLedgerUpdateOne is the builder for updating a single HypeLedger entity.
+type HypeLedgerUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *HypeLedgerMutation
+}
+
+// Mutation returns the HypeLedgerMutation object of the builder.
+func (hluo *HypeLedgerUpdateOne) Mutation() *HypeLedgerMutation {
+	return hluo.mutation
+}
+
+// Where appends a list predicates to the HypeLedgerUpdate builder.
+func (hluo *HypeLedgerUpdateOne) Where(ps ...predicate.HypeLedger) *HypeLedgerUpdateOne {
+	hluo.mutation.Where(ps...)
+	return hluo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (hluo *HypeLedgerUpdateOne) Select(field string, fields ...string) *HypeLedgerUpdateOne {
+	hluo.fields = append([]string{field}, fields...)
+	return hluo
+}
+
+// Save executes the query and returns the updated HypeLedger entity.
+func (hluo *HypeLedgerUpdateOne) Save(ctx context.Context) (*HypeLedger, error) {
+	return withHooks(ctx, hluo.sqlSave, hluo.mutation, hluo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (hluo *HypeLedgerUpdateOne) SaveX(ctx context.Context) *HypeLedger {
+	node, err := hluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (hluo *HypeLedgerUpdateOne) Exec(ctx context.Context) error {
+	_, err := hluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hluo *HypeLedgerUpdateOne) ExecX(ctx context.Context) {
+	if err := hluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (hluo *HypeLedgerUpdateOne) check() error {
+	if hluo.mutation.UserCleared() && len(hluo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "HypeLedger.user"`)
+	}
+	return nil
+}
+
+func (hluo *HypeLedgerUpdateOne) sqlSave(ctx context.Context) (_node *HypeLedger, err error) {
+	if err := hluo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(hypeledger.Table, hypeledger.Columns, sqlgraph.NewFieldSpec(hypeledger.FieldID, field.TypeInt))
+	id, ok := hluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "HypeLedger.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := hluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, hypeledger.FieldID)
+		for _, f := range fields {
+			if !hypeledger.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != hypeledger.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := hluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &HypeLedger{config: hluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, hluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{hypeledger.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	hluo.mutation.done = true
+	return _node, nil
+}
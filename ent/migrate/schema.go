@@ -8,6 +8,35 @@ import (
 )
 
 var (
+	// BoardsColumns holds the columns for the "boards" table.
+	BoardsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "width", Type: field.TypeInt},
+		{Name: "height", Type: field.TypeInt},
+		{Name: "cooldown", Type: field.TypeInt64, Default: 0},
+		{Name: "user_cooldown", Type: field.TypeInt64, Default: 0},
+		{Name: "hype_cost", Type: field.TypeInt, Default: 1},
+		{Name: "palette", Type: field.TypeString, Default: "default"},
+		{Name: "open", Type: field.TypeBool, Default: true},
+		{Name: "seq", Type: field.TypeInt64, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_boards", Type: field.TypeInt64, Nullable: true},
+	}
+	// BoardsTable holds the schema information for the "boards" table.
+	BoardsTable = &schema.Table{
+		Name:       "boards",
+		Columns:    BoardsColumns,
+		PrimaryKey: []*schema.Column{BoardsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "boards_users_boards",
+				Columns:    []*schema.Column{BoardsColumns[11]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
 	// HypesColumns holds the columns for the "hypes" table.
 	HypesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -15,6 +44,8 @@ var (
 		{Name: "max_hype", Type: field.TypeInt},
 		{Name: "last_updated_at", Type: field.TypeTime},
 		{Name: "hype_per_minute", Type: field.TypeInt, Default: 2},
+		{Name: "custom_limits", Type: field.TypeBool, Default: false},
+		{Name: "version", Type: field.TypeInt, Default: 0},
 		{Name: "user_hype", Type: field.TypeInt64, Unique: true},
 	}
 	// HypesTable holds the schema information for the "hypes" table.
@@ -25,17 +56,76 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "hypes_users_hype",
-				Columns:    []*schema.Column{HypesColumns[5]},
+				Columns:    []*schema.Column{HypesColumns[7]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 	}
+	// HypeLedgersColumns holds the columns for the "hype_ledgers" table.
+	HypeLedgersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"grant", "refund", "bonus", "limits"}},
+		{Name: "amount", Type: field.TypeInt},
+		{Name: "balance", Type: field.TypeInt},
+		{Name: "reason", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_hype_ledger", Type: field.TypeInt64},
+		{Name: "user_hype_adjustments", Type: field.TypeInt64, Nullable: true},
+	}
+	// HypeLedgersTable holds the schema information for the "hype_ledgers" table.
+	HypeLedgersTable = &schema.Table{
+		Name:       "hype_ledgers",
+		Columns:    HypeLedgersColumns,
+		PrimaryKey: []*schema.Column{HypeLedgersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "hype_ledgers_users_hype_ledger",
+				Columns:    []*schema.Column{HypeLedgersColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "hype_ledgers_users_hype_adjustments",
+				Columns:    []*schema.Column{HypeLedgersColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "hypeledger_created_at_user_hype_ledger",
+				Unique:  false,
+				Columns: []*schema.Column{HypeLedgersColumns[5], HypeLedgersColumns[6]},
+			},
+		},
+	}
+	// OutboxEventsColumns holds the columns for the "outbox_events" table.
+	OutboxEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"broadcast", "team"}},
+		{Name: "target", Type: field.TypeString, Nullable: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeBytes},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "next_attempt_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// OutboxEventsTable holds the schema information for the "outbox_events" table.
+	OutboxEventsTable = &schema.Table{
+		Name:       "outbox_events",
+		Columns:    OutboxEventsColumns,
+		PrimaryKey: []*schema.Column{OutboxEventsColumns[0]},
+	}
 	// PixelsColumns holds the columns for the "pixels" table.
 	PixelsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "position", Type: field.TypeInt, Default: 0},
 		{Name: "color", Type: field.TypeString},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "seq", Type: field.TypeInt64, Default: 0},
+		{Name: "board_pixels", Type: field.TypeInt, Nullable: true},
+		{Name: "team_id", Type: field.TypeInt, Nullable: true},
 		{Name: "user_pixels", Type: field.TypeInt64, Nullable: true},
 	}
 	// PixelsTable holds the schema information for the "pixels" table.
@@ -44,25 +134,169 @@ var (
 		Columns:    PixelsColumns,
 		PrimaryKey: []*schema.Column{PixelsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "pixels_boards_pixels",
+				Columns:    []*schema.Column{PixelsColumns[5]},
+				RefColumns: []*schema.Column{BoardsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "pixels_teams_pixels",
+				Columns:    []*schema.Column{PixelsColumns[6]},
+				RefColumns: []*schema.Column{TeamsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "pixels_users_pixels",
-				Columns:    []*schema.Column{PixelsColumns[3]},
+				Columns:    []*schema.Column{PixelsColumns[7]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "pixel_position_board_pixels",
+				Unique:  true,
+				Columns: []*schema.Column{PixelsColumns[1], PixelsColumns[5]},
+			},
+			{
+				Name:    "pixel_seq_board_pixels",
+				Unique:  true,
+				Columns: []*schema.Column{PixelsColumns[4], PixelsColumns[5]},
+			},
+		},
+	}
+	// PixelChangesColumns holds the columns for the "pixel_changes" table.
+	PixelChangesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "position", Type: field.TypeInt},
+		{Name: "old_color", Type: field.TypeString},
+		{Name: "new_color", Type: field.TypeString},
+		{Name: "seq", Type: field.TypeInt64},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "board_changes", Type: field.TypeInt},
+		{Name: "pixel_changes", Type: field.TypeInt, Nullable: true},
+		{Name: "team_id", Type: field.TypeInt, Nullable: true},
+		{Name: "user_changes", Type: field.TypeInt64, Nullable: true},
+	}
+	// PixelChangesTable holds the schema information for the "pixel_changes" table.
+	PixelChangesTable = &schema.Table{
+		Name:       "pixel_changes",
+		Columns:    PixelChangesColumns,
+		PrimaryKey: []*schema.Column{PixelChangesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "pixel_changes_boards_changes",
+				Columns:    []*schema.Column{PixelChangesColumns[6]},
+				RefColumns: []*schema.Column{BoardsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "pixel_changes_pixels_changes",
+				Columns:    []*schema.Column{PixelChangesColumns[7]},
+				RefColumns: []*schema.Column{PixelsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "pixel_changes_teams_changes",
+				Columns:    []*schema.Column{PixelChangesColumns[8]},
+				RefColumns: []*schema.Column{TeamsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "pixel_changes_users_changes",
+				Columns:    []*schema.Column{PixelChangesColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "pixelchange_position_board_changes",
+				Unique:  false,
+				Columns: []*schema.Column{PixelChangesColumns[1], PixelChangesColumns[6]},
+			},
+			{
+				Name:    "pixelchange_seq_board_changes",
+				Unique:  false,
+				Columns: []*schema.Column{PixelChangesColumns[4], PixelChangesColumns[6]},
+			},
+			{
+				Name:    "pixelchange_created_at_user_changes",
+				Unique:  false,
+				Columns: []*schema.Column{PixelChangesColumns[5], PixelChangesColumns[9]},
+			},
+		},
+	}
+	// RegionsColumns holds the columns for the "regions" table.
+	RegionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "x", Type: field.TypeInt},
+		{Name: "y", Type: field.TypeInt},
+		{Name: "width", Type: field.TypeInt},
+		{Name: "height", Type: field.TypeInt},
+		{Name: "mask", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "board_id", Type: field.TypeInt},
+		{Name: "user_regions", Type: field.TypeInt64, Nullable: true},
+	}
+	// RegionsTable holds the schema information for the "regions" table.
+	RegionsTable = &schema.Table{
+		Name:       "regions",
+		Columns:    RegionsColumns,
+		PrimaryKey: []*schema.Column{RegionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "regions_boards_regions",
+				Columns:    []*schema.Column{RegionsColumns[8]},
+				RefColumns: []*schema.Column{BoardsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "regions_users_regions",
+				Columns:    []*schema.Column{RegionsColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// TeamsColumns holds the columns for the "teams" table.
+	TeamsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "invite_code", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// TeamsTable holds the schema information for the "teams" table.
+	TeamsTable = &schema.Table{
+		Name:       "teams",
+		Columns:    TeamsColumns,
+		PrimaryKey: []*schema.Column{TeamsColumns[0]},
 	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt64, Increment: true},
 		{Name: "display_name", Type: field.TypeString},
 		{Name: "game_id", Type: field.TypeString},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"user", "admin"}, Default: "user"},
+		{Name: "banned_at", Type: field.TypeTime, Nullable: true},
+		{Name: "ban_reason", Type: field.TypeString, Nullable: true},
+		{Name: "team_id", Type: field.TypeInt, Nullable: true},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
 		Name:       "users",
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "users_teams_members",
+				Columns:    []*schema.Column{UsersColumns[6]},
+				RefColumns: []*schema.Column{TeamsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
 		Indexes: []*schema.Index{
 			{
 				Name:    "user_game_id",
@@ -73,13 +307,31 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		BoardsTable,
 		HypesTable,
+		HypeLedgersTable,
+		OutboxEventsTable,
 		PixelsTable,
+		PixelChangesTable,
+		RegionsTable,
+		TeamsTable,
 		UsersTable,
 	}
 )
 
 func init() {
+	BoardsTable.ForeignKeys[0].RefTable = UsersTable
 	HypesTable.ForeignKeys[0].RefTable = UsersTable
-	PixelsTable.ForeignKeys[0].RefTable = UsersTable
+	HypeLedgersTable.ForeignKeys[0].RefTable = UsersTable
+	HypeLedgersTable.ForeignKeys[1].RefTable = UsersTable
+	PixelsTable.ForeignKeys[0].RefTable = BoardsTable
+	PixelsTable.ForeignKeys[1].RefTable = TeamsTable
+	PixelsTable.ForeignKeys[2].RefTable = UsersTable
+	PixelChangesTable.ForeignKeys[0].RefTable = BoardsTable
+	PixelChangesTable.ForeignKeys[1].RefTable = PixelsTable
+	PixelChangesTable.ForeignKeys[2].RefTable = TeamsTable
+	PixelChangesTable.ForeignKeys[3].RefTable = UsersTable
+	RegionsTable.ForeignKeys[0].RefTable = BoardsTable
+	RegionsTable.ForeignKeys[1].RefTable = UsersTable
+	UsersTable.ForeignKeys[0].RefTable = TeamsTable
 }
@@ -6,9 +6,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"nevissGo/ent/board"
 	"nevissGo/ent/hype"
+	"nevissGo/ent/hypeledger"
+	"nevissGo/ent/outboxevent"
 	"nevissGo/ent/pixel"
+	"nevissGo/ent/pixelchange"
 	"nevissGo/ent/predicate"
+	"nevissGo/ent/region"
+	"nevissGo/ent/team"
 	"nevissGo/ent/user"
 	"sync"
 	"time"
@@ -26,43 +32,67 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeHype  = "Hype"
-	TypePixel = "Pixel"
-	TypeUser  = "User"
+	TypeBoard       = "Board"
+	TypeHype        = "Hype"
+	TypeHypeLedger  = "HypeLedger"
+	TypeOutboxEvent = "OutboxEvent"
+	TypePixel       = "Pixel"
+	TypePixelChange = "PixelChange"
+	TypeRegion      = "Region"
+	TypeTeam        = "Team"
+	TypeUser        = "User"
 )
 
-// HypeMutation represents an operation that mutates the Hype nodes in the graph.
-type HypeMutation struct {
+// BoardMutation represents an operation that mutates the Board nodes in the graph.
+type BoardMutation struct {
 	config
-	op                  Op
-	typ                 string
-	id                  *int
-	amount_remaining    *int
-	addamount_remaining *int
-	max_hype            *int
-	addmax_hype         *int
-	last_updated_at     *time.Time
-	hype_per_minute     *int
-	addhype_per_minute  *int
-	clearedFields       map[string]struct{}
-	user                *int64
-	cleareduser         bool
-	done                bool
-	oldValue            func(context.Context) (*Hype, error)
-	predicates          []predicate.Hype
-}
-
-var _ ent.Mutation = (*HypeMutation)(nil)
-
-// hypeOption allows management of the mutation configuration using functional options.
-type hypeOption func(*HypeMutation)
-
-// newHypeMutation creates new mutation for the Hype entity.
-func newHypeMutation(c config, op Op, opts ...hypeOption) *HypeMutation {
-	m := &HypeMutation{
+	op               Op
+	typ              string
+	id               *int
+	name             *string
+	width            *int
+	addwidth         *int
+	height           *int
+	addheight        *int
+	cooldown         *time.Duration
+	addcooldown      *time.Duration
+	user_cooldown    *time.Duration
+	adduser_cooldown *time.Duration
+	hype_cost        *int
+	addhype_cost     *int
+	palette          *string
+	open             *bool
+	seq              *int64
+	addseq           *int64
+	created_at       *time.Time
+	clearedFields    map[string]struct{}
+	pixels           map[int]struct{}
+	removedpixels    map[int]struct{}
+	clearedpixels    bool
+	changes          map[int]struct{}
+	removedchanges   map[int]struct{}
+	clearedchanges   bool
+	regions          map[int]struct{}
+	removedregions   map[int]struct{}
+	clearedregions   bool
+	owner            *int64
+	clearedowner     bool
+	done             bool
+	oldValue         func(context.Context) (*Board, error)
+	predicates       []predicate.Board
+}
+
+var _ ent.Mutation = (*BoardMutation)(nil)
+
+// boardOption allows management of the mutation configuration using functional options.
+type boardOption func(*BoardMutation)
+
+// newBoardMutation creates new mutation for the Board entity.
+func newBoardMutation(c config, op Op, opts ...boardOption) *BoardMutation {
+	m := &BoardMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeHype,
+		typ:           TypeBoard,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -71,20 +101,20 @@ func newHypeMutation(c config, op Op, opts ...hypeOption) *HypeMutation {
 	return m
 }
 
-// withHypeID sets the ID field of the mutation.
-func withHypeID(id int) hypeOption {
-	return func(m *HypeMutation) {
+// withBoardID sets the ID field of the mutation.
+func withBoardID(id int) boardOption {
+	return func(m *BoardMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Hype
+			value *Board
 		)
-		m.oldValue = func(ctx context.Context) (*Hype, error) {
+		m.oldValue = func(ctx context.Context) (*Board, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Hype.Get(ctx, id)
+					value, err = m.Client().Board.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -93,10 +123,10 @@ func withHypeID(id int) hypeOption {
 	}
 }
 
-// withHype sets the old Hype of the mutation.
-func withHype(node *Hype) hypeOption {
-	return func(m *HypeMutation) {
-		m.oldValue = func(context.Context) (*Hype, error) {
+// withBoard sets the old Board of the mutation.
+func withBoard(node *Board) boardOption {
+	return func(m *BoardMutation) {
+		m.oldValue = func(context.Context) (*Board, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -105,7 +135,7 @@ func withHype(node *Hype) hypeOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m HypeMutation) Client() *Client {
+func (m BoardMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -113,7 +143,7 @@ func (m HypeMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m HypeMutation) Tx() (*Tx, error) {
+func (m BoardMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -124,7 +154,7 @@ func (m HypeMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *HypeMutation) ID() (id int, exists bool) {
+func (m *BoardMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -135,7 +165,7 @@ func (m *HypeMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *HypeMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *BoardMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -144,301 +174,6114 @@ func (m *HypeMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Hype.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Board.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetAmountRemaining sets the "amount_remaining" field.
-func (m *HypeMutation) SetAmountRemaining(i int) {
-	m.amount_remaining = &i
-	m.addamount_remaining = nil
+// SetName sets the "name" field.
+func (m *BoardMutation) SetName(s string) {
+	m.name = &s
 }
 
-// AmountRemaining returns the value of the "amount_remaining" field in the mutation.
-func (m *HypeMutation) AmountRemaining() (r int, exists bool) {
-	v := m.amount_remaining
+// Name returns the value of the "name" field in the mutation.
+func (m *BoardMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAmountRemaining returns the old "amount_remaining" field's value of the Hype entity.
-// If the Hype object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Board entity.
+// If the Board object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HypeMutation) OldAmountRemaining(ctx context.Context) (v int, err error) {
+func (m *BoardMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAmountRemaining is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAmountRemaining requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAmountRemaining: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.AmountRemaining, nil
+	return oldValue.Name, nil
 }
 
-// AddAmountRemaining adds i to the "amount_remaining" field.
-func (m *HypeMutation) AddAmountRemaining(i int) {
-	if m.addamount_remaining != nil {
-		*m.addamount_remaining += i
+// ResetName resets all changes to the "name" field.
+func (m *BoardMutation) ResetName() {
+	m.name = nil
+}
+
+// SetWidth sets the "width" field.
+func (m *BoardMutation) SetWidth(i int) {
+	m.width = &i
+	m.addwidth = nil
+}
+
+// Width returns the value of the "width" field in the mutation.
+func (m *BoardMutation) Width() (r int, exists bool) {
+	v := m.width
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWidth returns the old "width" field's value of the Board entity.
+// If the Board object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BoardMutation) OldWidth(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWidth is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWidth requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWidth: %w", err)
+	}
+	return oldValue.Width, nil
+}
+
+// AddWidth adds i to the "width" field.
+func (m *BoardMutation) AddWidth(i int) {
+	if m.addwidth != nil {
+		*m.addwidth += i
 	} else {
-		m.addamount_remaining = &i
+		m.addwidth = &i
 	}
 }
 
-// AddedAmountRemaining returns the value that was added to the "amount_remaining" field in this mutation.
-func (m *HypeMutation) AddedAmountRemaining() (r int, exists bool) {
-	v := m.addamount_remaining
+// AddedWidth returns the value that was added to the "width" field in this mutation.
+func (m *BoardMutation) AddedWidth() (r int, exists bool) {
+	v := m.addwidth
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetAmountRemaining resets all changes to the "amount_remaining" field.
-func (m *HypeMutation) ResetAmountRemaining() {
-	m.amount_remaining = nil
-	m.addamount_remaining = nil
+// ResetWidth resets all changes to the "width" field.
+func (m *BoardMutation) ResetWidth() {
+	m.width = nil
+	m.addwidth = nil
 }
 
-// SetMaxHype sets the "max_hype" field.
-func (m *HypeMutation) SetMaxHype(i int) {
-	m.max_hype = &i
-	m.addmax_hype = nil
+// SetHeight sets the "height" field.
+func (m *BoardMutation) SetHeight(i int) {
+	m.height = &i
+	m.addheight = nil
 }
 
-// MaxHype returns the value of the "max_hype" field in the mutation.
-func (m *HypeMutation) MaxHype() (r int, exists bool) {
-	v := m.max_hype
+// Height returns the value of the "height" field in the mutation.
+func (m *BoardMutation) Height() (r int, exists bool) {
+	v := m.height
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMaxHype returns the old "max_hype" field's value of the Hype entity.
-// If the Hype object wasn't provided to the builder, the object is fetched from the database.
+// OldHeight returns the old "height" field's value of the Board entity.
+// If the Board object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HypeMutation) OldMaxHype(ctx context.Context) (v int, err error) {
+func (m *BoardMutation) OldHeight(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMaxHype is only allowed on UpdateOne operations")
+		return v, errors.New("OldHeight is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMaxHype requires an ID field in the mutation")
+		return v, errors.New("OldHeight requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMaxHype: %w", err)
+		return v, fmt.Errorf("querying old value for OldHeight: %w", err)
 	}
-	return oldValue.MaxHype, nil
+	return oldValue.Height, nil
 }
 
-// AddMaxHype adds i to the "max_hype" field.
-func (m *HypeMutation) AddMaxHype(i int) {
-	if m.addmax_hype != nil {
-		*m.addmax_hype += i
+// AddHeight adds i to the "height" field.
+func (m *BoardMutation) AddHeight(i int) {
+	if m.addheight != nil {
+		*m.addheight += i
 	} else {
-		m.addmax_hype = &i
+		m.addheight = &i
 	}
 }
 
-// AddedMaxHype returns the value that was added to the "max_hype" field in this mutation.
-func (m *HypeMutation) AddedMaxHype() (r int, exists bool) {
-	v := m.addmax_hype
+// AddedHeight returns the value that was added to the "height" field in this mutation.
+func (m *BoardMutation) AddedHeight() (r int, exists bool) {
+	v := m.addheight
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetMaxHype resets all changes to the "max_hype" field.
-func (m *HypeMutation) ResetMaxHype() {
-	m.max_hype = nil
-	m.addmax_hype = nil
+// ResetHeight resets all changes to the "height" field.
+func (m *BoardMutation) ResetHeight() {
+	m.height = nil
+	m.addheight = nil
 }
 
-// SetLastUpdatedAt sets the "last_updated_at" field.
-func (m *HypeMutation) SetLastUpdatedAt(t time.Time) {
-	m.last_updated_at = &t
+// SetCooldown sets the "cooldown" field.
+func (m *BoardMutation) SetCooldown(t time.Duration) {
+	m.cooldown = &t
+	m.addcooldown = nil
 }
 
-// LastUpdatedAt returns the value of the "last_updated_at" field in the mutation.
-func (m *HypeMutation) LastUpdatedAt() (r time.Time, exists bool) {
-	v := m.last_updated_at
+// Cooldown returns the value of the "cooldown" field in the mutation.
+func (m *BoardMutation) Cooldown() (r time.Duration, exists bool) {
+	v := m.cooldown
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastUpdatedAt returns the old "last_updated_at" field's value of the Hype entity.
-// If the Hype object wasn't provided to the builder, the object is fetched from the database.
+// OldCooldown returns the old "cooldown" field's value of the Board entity.
+// If the Board object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HypeMutation) OldLastUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *BoardMutation) OldCooldown(ctx context.Context) (v time.Duration, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCooldown is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldCooldown requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCooldown: %w", err)
 	}
-	return oldValue.LastUpdatedAt, nil
+	return oldValue.Cooldown, nil
 }
 
-// ResetLastUpdatedAt resets all changes to the "last_updated_at" field.
-func (m *HypeMutation) ResetLastUpdatedAt() {
-	m.last_updated_at = nil
+// AddCooldown adds t to the "cooldown" field.
+func (m *BoardMutation) AddCooldown(t time.Duration) {
+	if m.addcooldown != nil {
+		*m.addcooldown += t
+	} else {
+		m.addcooldown = &t
+	}
 }
 
-// SetHypePerMinute sets the "hype_per_minute" field.
-func (m *HypeMutation) SetHypePerMinute(i int) {
-	m.hype_per_minute = &i
-	m.addhype_per_minute = nil
+// AddedCooldown returns the value that was added to the "cooldown" field in this mutation.
+func (m *BoardMutation) AddedCooldown() (r time.Duration, exists bool) {
+	v := m.addcooldown
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// HypePerMinute returns the value of the "hype_per_minute" field in the mutation.
-func (m *HypeMutation) HypePerMinute() (r int, exists bool) {
-	v := m.hype_per_minute
+// ResetCooldown resets all changes to the "cooldown" field.
+func (m *BoardMutation) ResetCooldown() {
+	m.cooldown = nil
+	m.addcooldown = nil
+}
+
+// SetUserCooldown sets the "user_cooldown" field.
+func (m *BoardMutation) SetUserCooldown(t time.Duration) {
+	m.user_cooldown = &t
+	m.adduser_cooldown = nil
+}
+
+// UserCooldown returns the value of the "user_cooldown" field in the mutation.
+func (m *BoardMutation) UserCooldown() (r time.Duration, exists bool) {
+	v := m.user_cooldown
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldHypePerMinute returns the old "hype_per_minute" field's value of the Hype entity.
-// If the Hype object wasn't provided to the builder, the object is fetched from the database.
+// OldUserCooldown returns the old "user_cooldown" field's value of the Board entity.
+// If the Board object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HypeMutation) OldHypePerMinute(ctx context.Context) (v int, err error) {
+func (m *BoardMutation) OldUserCooldown(ctx context.Context) (v time.Duration, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHypePerMinute is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserCooldown is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHypePerMinute requires an ID field in the mutation")
+		return v, errors.New("OldUserCooldown requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHypePerMinute: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserCooldown: %w", err)
 	}
-	return oldValue.HypePerMinute, nil
+	return oldValue.UserCooldown, nil
 }
 
-// AddHypePerMinute adds i to the "hype_per_minute" field.
-func (m *HypeMutation) AddHypePerMinute(i int) {
-	if m.addhype_per_minute != nil {
-		*m.addhype_per_minute += i
+// AddUserCooldown adds t to the "user_cooldown" field.
+func (m *BoardMutation) AddUserCooldown(t time.Duration) {
+	if m.adduser_cooldown != nil {
+		*m.adduser_cooldown += t
 	} else {
-		m.addhype_per_minute = &i
+		m.adduser_cooldown = &t
 	}
 }
 
-// AddedHypePerMinute returns the value that was added to the "hype_per_minute" field in this mutation.
-func (m *HypeMutation) AddedHypePerMinute() (r int, exists bool) {
-	v := m.addhype_per_minute
+// AddedUserCooldown returns the value that was added to the "user_cooldown" field in this mutation.
+func (m *BoardMutation) AddedUserCooldown() (r time.Duration, exists bool) {
+	v := m.adduser_cooldown
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetHypePerMinute resets all changes to the "hype_per_minute" field.
-func (m *HypeMutation) ResetHypePerMinute() {
-	m.hype_per_minute = nil
-	m.addhype_per_minute = nil
+// ResetUserCooldown resets all changes to the "user_cooldown" field.
+func (m *BoardMutation) ResetUserCooldown() {
+	m.user_cooldown = nil
+	m.adduser_cooldown = nil
 }
 
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *HypeMutation) SetUserID(id int64) {
-	m.user = &id
+// SetHypeCost sets the "hype_cost" field.
+func (m *BoardMutation) SetHypeCost(i int) {
+	m.hype_cost = &i
+	m.addhype_cost = nil
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *HypeMutation) ClearUser() {
-	m.cleareduser = true
+// HypeCost returns the value of the "hype_cost" field in the mutation.
+func (m *BoardMutation) HypeCost() (r int, exists bool) {
+	v := m.hype_cost
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *HypeMutation) UserCleared() bool {
-	return m.cleareduser
+// OldHypeCost returns the old "hype_cost" field's value of the Board entity.
+// If the Board object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BoardMutation) OldHypeCost(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHypeCost is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHypeCost requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHypeCost: %w", err)
+	}
+	return oldValue.HypeCost, nil
 }
 
-// UserID returns the "user" edge ID in the mutation.
-func (m *HypeMutation) UserID() (id int64, exists bool) {
-	if m.user != nil {
-		return *m.user, true
+// AddHypeCost adds i to the "hype_cost" field.
+func (m *BoardMutation) AddHypeCost(i int) {
+	if m.addhype_cost != nil {
+		*m.addhype_cost += i
+	} else {
+		m.addhype_cost = &i
 	}
-	return
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *HypeMutation) UserIDs() (ids []int64) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// AddedHypeCost returns the value that was added to the "hype_cost" field in this mutation.
+func (m *BoardMutation) AddedHypeCost() (r int, exists bool) {
+	v := m.addhype_cost
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *HypeMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// ResetHypeCost resets all changes to the "hype_cost" field.
+func (m *BoardMutation) ResetHypeCost() {
+	m.hype_cost = nil
+	m.addhype_cost = nil
 }
 
-// Where appends a list predicates to the HypeMutation builder.
-func (m *HypeMutation) Where(ps ...predicate.Hype) {
-	m.predicates = append(m.predicates, ps...)
+// SetPalette sets the "palette" field.
+func (m *BoardMutation) SetPalette(s string) {
+	m.palette = &s
 }
 
-// WhereP appends storage-level predicates to the HypeMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *HypeMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Hype, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// Palette returns the value of the "palette" field in the mutation.
+func (m *BoardMutation) Palette() (r string, exists bool) {
+	v := m.palette
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *HypeMutation) Op() Op {
-	return m.op
+// OldPalette returns the old "palette" field's value of the Board entity.
+// If the Board object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BoardMutation) OldPalette(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPalette is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPalette requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPalette: %w", err)
+	}
+	return oldValue.Palette, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *HypeMutation) SetOp(op Op) {
-	m.op = op
+// ResetPalette resets all changes to the "palette" field.
+func (m *BoardMutation) ResetPalette() {
+	m.palette = nil
 }
 
-// Type returns the node type of this mutation (Hype).
-func (m *HypeMutation) Type() string {
-	return m.typ
+// SetOpen sets the "open" field.
+func (m *BoardMutation) SetOpen(b bool) {
+	m.open = &b
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *HypeMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.amount_remaining != nil {
-		fields = append(fields, hype.FieldAmountRemaining)
+// Open returns the value of the "open" field in the mutation.
+func (m *BoardMutation) Open() (r bool, exists bool) {
+	v := m.open
+	if v == nil {
+		return
 	}
-	if m.max_hype != nil {
-		fields = append(fields, hype.FieldMaxHype)
+	return *v, true
+}
+
+// OldOpen returns the old "open" field's value of the Board entity.
+// If the Board object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BoardMutation) OldOpen(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOpen is only allowed on UpdateOne operations")
 	}
-	if m.last_updated_at != nil {
-		fields = append(fields, hype.FieldLastUpdatedAt)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOpen requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOpen: %w", err)
+	}
+	return oldValue.Open, nil
+}
+
+// ResetOpen resets all changes to the "open" field.
+func (m *BoardMutation) ResetOpen() {
+	m.open = nil
+}
+
+// SetSeq sets the "seq" field.
+func (m *BoardMutation) SetSeq(i int64) {
+	m.seq = &i
+	m.addseq = nil
+}
+
+// Seq returns the value of the "seq" field in the mutation.
+func (m *BoardMutation) Seq() (r int64, exists bool) {
+	v := m.seq
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeq returns the old "seq" field's value of the Board entity.
+// If the Board object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BoardMutation) OldSeq(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeq is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeq requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeq: %w", err)
+	}
+	return oldValue.Seq, nil
+}
+
+// AddSeq adds i to the "seq" field.
+func (m *BoardMutation) AddSeq(i int64) {
+	if m.addseq != nil {
+		*m.addseq += i
+	} else {
+		m.addseq = &i
+	}
+}
+
+// AddedSeq returns the value that was added to the "seq" field in this mutation.
+func (m *BoardMutation) AddedSeq() (r int64, exists bool) {
+	v := m.addseq
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSeq resets all changes to the "seq" field.
+func (m *BoardMutation) ResetSeq() {
+	m.seq = nil
+	m.addseq = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *BoardMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *BoardMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Board entity.
+// If the Board object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BoardMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *BoardMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// AddPixelIDs adds the "pixels" edge to the Pixel entity by ids.
+func (m *BoardMutation) AddPixelIDs(ids ...int) {
+	if m.pixels == nil {
+		m.pixels = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.pixels[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPixels clears the "pixels" edge to the Pixel entity.
+func (m *BoardMutation) ClearPixels() {
+	m.clearedpixels = true
+}
+
+// PixelsCleared reports if the "pixels" edge to the Pixel entity was cleared.
+func (m *BoardMutation) PixelsCleared() bool {
+	return m.clearedpixels
+}
+
+// RemovePixelIDs removes the "pixels" edge to the Pixel entity by IDs.
+func (m *BoardMutation) RemovePixelIDs(ids ...int) {
+	if m.removedpixels == nil {
+		m.removedpixels = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.pixels, ids[i])
+		m.removedpixels[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPixels returns the removed IDs of the "pixels" edge to the Pixel entity.
+func (m *BoardMutation) RemovedPixelsIDs() (ids []int) {
+	for id := range m.removedpixels {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PixelsIDs returns the "pixels" edge IDs in the mutation.
+func (m *BoardMutation) PixelsIDs() (ids []int) {
+	for id := range m.pixels {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPixels resets all changes to the "pixels" edge.
+func (m *BoardMutation) ResetPixels() {
+	m.pixels = nil
+	m.clearedpixels = false
+	m.removedpixels = nil
+}
+
+// AddChangeIDs adds the "changes" edge to the PixelChange entity by ids.
+func (m *BoardMutation) AddChangeIDs(ids ...int) {
+	if m.changes == nil {
+		m.changes = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.changes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChanges clears the "changes" edge to the PixelChange entity.
+func (m *BoardMutation) ClearChanges() {
+	m.clearedchanges = true
+}
+
+// ChangesCleared reports if the "changes" edge to the PixelChange entity was cleared.
+func (m *BoardMutation) ChangesCleared() bool {
+	return m.clearedchanges
+}
+
+// RemoveChangeIDs removes the "changes" edge to the PixelChange entity by IDs.
+func (m *BoardMutation) RemoveChangeIDs(ids ...int) {
+	if m.removedchanges == nil {
+		m.removedchanges = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.changes, ids[i])
+		m.removedchanges[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChanges returns the removed IDs of the "changes" edge to the PixelChange entity.
+func (m *BoardMutation) RemovedChangesIDs() (ids []int) {
+	for id := range m.removedchanges {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChangesIDs returns the "changes" edge IDs in the mutation.
+func (m *BoardMutation) ChangesIDs() (ids []int) {
+	for id := range m.changes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChanges resets all changes to the "changes" edge.
+func (m *BoardMutation) ResetChanges() {
+	m.changes = nil
+	m.clearedchanges = false
+	m.removedchanges = nil
+}
+
+// AddRegionIDs adds the "regions" edge to the Region entity by ids.
+func (m *BoardMutation) AddRegionIDs(ids ...int) {
+	if m.regions == nil {
+		m.regions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.regions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRegions clears the "regions" edge to the Region entity.
+func (m *BoardMutation) ClearRegions() {
+	m.clearedregions = true
+}
+
+// RegionsCleared reports if the "regions" edge to the Region entity was cleared.
+func (m *BoardMutation) RegionsCleared() bool {
+	return m.clearedregions
+}
+
+// RemoveRegionIDs removes the "regions" edge to the Region entity by IDs.
+func (m *BoardMutation) RemoveRegionIDs(ids ...int) {
+	if m.removedregions == nil {
+		m.removedregions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.regions, ids[i])
+		m.removedregions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRegions returns the removed IDs of the "regions" edge to the Region entity.
+func (m *BoardMutation) RemovedRegionsIDs() (ids []int) {
+	for id := range m.removedregions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RegionsIDs returns the "regions" edge IDs in the mutation.
+func (m *BoardMutation) RegionsIDs() (ids []int) {
+	for id := range m.regions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRegions resets all changes to the "regions" edge.
+func (m *BoardMutation) ResetRegions() {
+	m.regions = nil
+	m.clearedregions = false
+	m.removedregions = nil
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by id.
+func (m *BoardMutation) SetOwnerID(id int64) {
+	m.owner = &id
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *BoardMutation) ClearOwner() {
+	m.clearedowner = true
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *BoardMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerID returns the "owner" edge ID in the mutation.
+func (m *BoardMutation) OwnerID() (id int64, exists bool) {
+	if m.owner != nil {
+		return *m.owner, true
+	}
+	return
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *BoardMutation) OwnerIDs() (ids []int64) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOwner resets all changes to the "owner" edge.
+func (m *BoardMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+}
+
+// Where appends a list predicates to the BoardMutation builder.
+func (m *BoardMutation) Where(ps ...predicate.Board) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BoardMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BoardMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Board, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BoardMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BoardMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Board).
+func (m *BoardMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BoardMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.name != nil {
+		fields = append(fields, board.FieldName)
+	}
+	if m.width != nil {
+		fields = append(fields, board.FieldWidth)
+	}
+	if m.height != nil {
+		fields = append(fields, board.FieldHeight)
+	}
+	if m.cooldown != nil {
+		fields = append(fields, board.FieldCooldown)
+	}
+	if m.user_cooldown != nil {
+		fields = append(fields, board.FieldUserCooldown)
+	}
+	if m.hype_cost != nil {
+		fields = append(fields, board.FieldHypeCost)
+	}
+	if m.palette != nil {
+		fields = append(fields, board.FieldPalette)
+	}
+	if m.open != nil {
+		fields = append(fields, board.FieldOpen)
+	}
+	if m.seq != nil {
+		fields = append(fields, board.FieldSeq)
+	}
+	if m.created_at != nil {
+		fields = append(fields, board.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BoardMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case board.FieldName:
+		return m.Name()
+	case board.FieldWidth:
+		return m.Width()
+	case board.FieldHeight:
+		return m.Height()
+	case board.FieldCooldown:
+		return m.Cooldown()
+	case board.FieldUserCooldown:
+		return m.UserCooldown()
+	case board.FieldHypeCost:
+		return m.HypeCost()
+	case board.FieldPalette:
+		return m.Palette()
+	case board.FieldOpen:
+		return m.Open()
+	case board.FieldSeq:
+		return m.Seq()
+	case board.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BoardMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case board.FieldName:
+		return m.OldName(ctx)
+	case board.FieldWidth:
+		return m.OldWidth(ctx)
+	case board.FieldHeight:
+		return m.OldHeight(ctx)
+	case board.FieldCooldown:
+		return m.OldCooldown(ctx)
+	case board.FieldUserCooldown:
+		return m.OldUserCooldown(ctx)
+	case board.FieldHypeCost:
+		return m.OldHypeCost(ctx)
+	case board.FieldPalette:
+		return m.OldPalette(ctx)
+	case board.FieldOpen:
+		return m.OldOpen(ctx)
+	case board.FieldSeq:
+		return m.OldSeq(ctx)
+	case board.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Board field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BoardMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case board.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case board.FieldWidth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWidth(v)
+		return nil
+	case board.FieldHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeight(v)
+		return nil
+	case board.FieldCooldown:
+		v, ok := value.(time.Duration)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCooldown(v)
+		return nil
+	case board.FieldUserCooldown:
+		v, ok := value.(time.Duration)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserCooldown(v)
+		return nil
+	case board.FieldHypeCost:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHypeCost(v)
+		return nil
+	case board.FieldPalette:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPalette(v)
+		return nil
+	case board.FieldOpen:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOpen(v)
+		return nil
+	case board.FieldSeq:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeq(v)
+		return nil
+	case board.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Board field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BoardMutation) AddedFields() []string {
+	var fields []string
+	if m.addwidth != nil {
+		fields = append(fields, board.FieldWidth)
+	}
+	if m.addheight != nil {
+		fields = append(fields, board.FieldHeight)
+	}
+	if m.addcooldown != nil {
+		fields = append(fields, board.FieldCooldown)
+	}
+	if m.adduser_cooldown != nil {
+		fields = append(fields, board.FieldUserCooldown)
+	}
+	if m.addhype_cost != nil {
+		fields = append(fields, board.FieldHypeCost)
+	}
+	if m.addseq != nil {
+		fields = append(fields, board.FieldSeq)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BoardMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case board.FieldWidth:
+		return m.AddedWidth()
+	case board.FieldHeight:
+		return m.AddedHeight()
+	case board.FieldCooldown:
+		return m.AddedCooldown()
+	case board.FieldUserCooldown:
+		return m.AddedUserCooldown()
+	case board.FieldHypeCost:
+		return m.AddedHypeCost()
+	case board.FieldSeq:
+		return m.AddedSeq()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BoardMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case board.FieldWidth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWidth(v)
+		return nil
+	case board.FieldHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHeight(v)
+		return nil
+	case board.FieldCooldown:
+		v, ok := value.(time.Duration)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCooldown(v)
+		return nil
+	case board.FieldUserCooldown:
+		v, ok := value.(time.Duration)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserCooldown(v)
+		return nil
+	case board.FieldHypeCost:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHypeCost(v)
+		return nil
+	case board.FieldSeq:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSeq(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Board numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BoardMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BoardMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BoardMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Board nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BoardMutation) ResetField(name string) error {
+	switch name {
+	case board.FieldName:
+		m.ResetName()
+		return nil
+	case board.FieldWidth:
+		m.ResetWidth()
+		return nil
+	case board.FieldHeight:
+		m.ResetHeight()
+		return nil
+	case board.FieldCooldown:
+		m.ResetCooldown()
+		return nil
+	case board.FieldUserCooldown:
+		m.ResetUserCooldown()
+		return nil
+	case board.FieldHypeCost:
+		m.ResetHypeCost()
+		return nil
+	case board.FieldPalette:
+		m.ResetPalette()
+		return nil
+	case board.FieldOpen:
+		m.ResetOpen()
+		return nil
+	case board.FieldSeq:
+		m.ResetSeq()
+		return nil
+	case board.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Board field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BoardMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.pixels != nil {
+		edges = append(edges, board.EdgePixels)
+	}
+	if m.changes != nil {
+		edges = append(edges, board.EdgeChanges)
+	}
+	if m.regions != nil {
+		edges = append(edges, board.EdgeRegions)
+	}
+	if m.owner != nil {
+		edges = append(edges, board.EdgeOwner)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BoardMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case board.EdgePixels:
+		ids := make([]ent.Value, 0, len(m.pixels))
+		for id := range m.pixels {
+			ids = append(ids, id)
+		}
+		return ids
+	case board.EdgeChanges:
+		ids := make([]ent.Value, 0, len(m.changes))
+		for id := range m.changes {
+			ids = append(ids, id)
+		}
+		return ids
+	case board.EdgeRegions:
+		ids := make([]ent.Value, 0, len(m.regions))
+		for id := range m.regions {
+			ids = append(ids, id)
+		}
+		return ids
+	case board.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BoardMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedpixels != nil {
+		edges = append(edges, board.EdgePixels)
+	}
+	if m.removedchanges != nil {
+		edges = append(edges, board.EdgeChanges)
+	}
+	if m.removedregions != nil {
+		edges = append(edges, board.EdgeRegions)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BoardMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case board.EdgePixels:
+		ids := make([]ent.Value, 0, len(m.removedpixels))
+		for id := range m.removedpixels {
+			ids = append(ids, id)
+		}
+		return ids
+	case board.EdgeChanges:
+		ids := make([]ent.Value, 0, len(m.removedchanges))
+		for id := range m.removedchanges {
+			ids = append(ids, id)
+		}
+		return ids
+	case board.EdgeRegions:
+		ids := make([]ent.Value, 0, len(m.removedregions))
+		for id := range m.removedregions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BoardMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedpixels {
+		edges = append(edges, board.EdgePixels)
+	}
+	if m.clearedchanges {
+		edges = append(edges, board.EdgeChanges)
+	}
+	if m.clearedregions {
+		edges = append(edges, board.EdgeRegions)
+	}
+	if m.clearedowner {
+		edges = append(edges, board.EdgeOwner)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BoardMutation) EdgeCleared(name string) bool {
+	switch name {
+	case board.EdgePixels:
+		return m.clearedpixels
+	case board.EdgeChanges:
+		return m.clearedchanges
+	case board.EdgeRegions:
+		return m.clearedregions
+	case board.EdgeOwner:
+		return m.clearedowner
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BoardMutation) ClearEdge(name string) error {
+	switch name {
+	case board.EdgeOwner:
+		m.ClearOwner()
+		return nil
+	}
+	return fmt.Errorf("unknown Board unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BoardMutation) ResetEdge(name string) error {
+	switch name {
+	case board.EdgePixels:
+		m.ResetPixels()
+		return nil
+	case board.EdgeChanges:
+		m.ResetChanges()
+		return nil
+	case board.EdgeRegions:
+		m.ResetRegions()
+		return nil
+	case board.EdgeOwner:
+		m.ResetOwner()
+		return nil
+	}
+	return fmt.Errorf("unknown Board edge %s", name)
+}
+
+// HypeMutation represents an operation that mutates the Hype nodes in the graph.
+type HypeMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int
+	amount_remaining    *int
+	addamount_remaining *int
+	max_hype            *int
+	addmax_hype         *int
+	last_updated_at     *time.Time
+	hype_per_minute     *int
+	addhype_per_minute  *int
+	custom_limits       *bool
+	version             *int
+	addversion          *int
+	clearedFields       map[string]struct{}
+	user                *int64
+	cleareduser         bool
+	done                bool
+	oldValue            func(context.Context) (*Hype, error)
+	predicates          []predicate.Hype
+}
+
+var _ ent.Mutation = (*HypeMutation)(nil)
+
+// hypeOption allows management of the mutation configuration using functional options.
+type hypeOption func(*HypeMutation)
+
+// newHypeMutation creates new mutation for the Hype entity.
+func newHypeMutation(c config, op Op, opts ...hypeOption) *HypeMutation {
+	m := &HypeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeHype,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withHypeID sets the ID field of the mutation.
+func withHypeID(id int) hypeOption {
+	return func(m *HypeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Hype
+		)
+		m.oldValue = func(ctx context.Context) (*Hype, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Hype.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withHype sets the old Hype of the mutation.
+func withHype(node *Hype) hypeOption {
+	return func(m *HypeMutation) {
+		m.oldValue = func(context.Context) (*Hype, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m HypeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m HypeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *HypeMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *HypeMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Hype.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetAmountRemaining sets the "amount_remaining" field.
+func (m *HypeMutation) SetAmountRemaining(i int) {
+	m.amount_remaining = &i
+	m.addamount_remaining = nil
+}
+
+// AmountRemaining returns the value of the "amount_remaining" field in the mutation.
+func (m *HypeMutation) AmountRemaining() (r int, exists bool) {
+	v := m.amount_remaining
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmountRemaining returns the old "amount_remaining" field's value of the Hype entity.
+// If the Hype object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HypeMutation) OldAmountRemaining(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmountRemaining is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmountRemaining requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmountRemaining: %w", err)
+	}
+	return oldValue.AmountRemaining, nil
+}
+
+// AddAmountRemaining adds i to the "amount_remaining" field.
+func (m *HypeMutation) AddAmountRemaining(i int) {
+	if m.addamount_remaining != nil {
+		*m.addamount_remaining += i
+	} else {
+		m.addamount_remaining = &i
+	}
+}
+
+// AddedAmountRemaining returns the value that was added to the "amount_remaining" field in this mutation.
+func (m *HypeMutation) AddedAmountRemaining() (r int, exists bool) {
+	v := m.addamount_remaining
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAmountRemaining resets all changes to the "amount_remaining" field.
+func (m *HypeMutation) ResetAmountRemaining() {
+	m.amount_remaining = nil
+	m.addamount_remaining = nil
+}
+
+// SetMaxHype sets the "max_hype" field.
+func (m *HypeMutation) SetMaxHype(i int) {
+	m.max_hype = &i
+	m.addmax_hype = nil
+}
+
+// MaxHype returns the value of the "max_hype" field in the mutation.
+func (m *HypeMutation) MaxHype() (r int, exists bool) {
+	v := m.max_hype
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxHype returns the old "max_hype" field's value of the Hype entity.
+// If the Hype object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HypeMutation) OldMaxHype(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxHype is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxHype requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxHype: %w", err)
+	}
+	return oldValue.MaxHype, nil
+}
+
+// AddMaxHype adds i to the "max_hype" field.
+func (m *HypeMutation) AddMaxHype(i int) {
+	if m.addmax_hype != nil {
+		*m.addmax_hype += i
+	} else {
+		m.addmax_hype = &i
+	}
+}
+
+// AddedMaxHype returns the value that was added to the "max_hype" field in this mutation.
+func (m *HypeMutation) AddedMaxHype() (r int, exists bool) {
+	v := m.addmax_hype
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxHype resets all changes to the "max_hype" field.
+func (m *HypeMutation) ResetMaxHype() {
+	m.max_hype = nil
+	m.addmax_hype = nil
+}
+
+// SetLastUpdatedAt sets the "last_updated_at" field.
+func (m *HypeMutation) SetLastUpdatedAt(t time.Time) {
+	m.last_updated_at = &t
+}
+
+// LastUpdatedAt returns the value of the "last_updated_at" field in the mutation.
+func (m *HypeMutation) LastUpdatedAt() (r time.Time, exists bool) {
+	v := m.last_updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastUpdatedAt returns the old "last_updated_at" field's value of the Hype entity.
+// If the Hype object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HypeMutation) OldLastUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUpdatedAt: %w", err)
+	}
+	return oldValue.LastUpdatedAt, nil
+}
+
+// ResetLastUpdatedAt resets all changes to the "last_updated_at" field.
+func (m *HypeMutation) ResetLastUpdatedAt() {
+	m.last_updated_at = nil
+}
+
+// SetHypePerMinute sets the "hype_per_minute" field.
+func (m *HypeMutation) SetHypePerMinute(i int) {
+	m.hype_per_minute = &i
+	m.addhype_per_minute = nil
+}
+
+// HypePerMinute returns the value of the "hype_per_minute" field in the mutation.
+func (m *HypeMutation) HypePerMinute() (r int, exists bool) {
+	v := m.hype_per_minute
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHypePerMinute returns the old "hype_per_minute" field's value of the Hype entity.
+// If the Hype object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HypeMutation) OldHypePerMinute(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHypePerMinute is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHypePerMinute requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHypePerMinute: %w", err)
+	}
+	return oldValue.HypePerMinute, nil
+}
+
+// AddHypePerMinute adds i to the "hype_per_minute" field.
+func (m *HypeMutation) AddHypePerMinute(i int) {
+	if m.addhype_per_minute != nil {
+		*m.addhype_per_minute += i
+	} else {
+		m.addhype_per_minute = &i
+	}
+}
+
+// AddedHypePerMinute returns the value that was added to the "hype_per_minute" field in this mutation.
+func (m *HypeMutation) AddedHypePerMinute() (r int, exists bool) {
+	v := m.addhype_per_minute
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetHypePerMinute resets all changes to the "hype_per_minute" field.
+func (m *HypeMutation) ResetHypePerMinute() {
+	m.hype_per_minute = nil
+	m.addhype_per_minute = nil
+}
+
+// SetCustomLimits sets the "custom_limits" field.
+func (m *HypeMutation) SetCustomLimits(b bool) {
+	m.custom_limits = &b
+}
+
+// CustomLimits returns the value of the "custom_limits" field in the mutation.
+func (m *HypeMutation) CustomLimits() (r bool, exists bool) {
+	v := m.custom_limits
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCustomLimits returns the old "custom_limits" field's value of the Hype entity.
+// If the Hype object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HypeMutation) OldCustomLimits(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCustomLimits is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCustomLimits requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCustomLimits: %w", err)
+	}
+	return oldValue.CustomLimits, nil
+}
+
+// ResetCustomLimits resets all changes to the "custom_limits" field.
+func (m *HypeMutation) ResetCustomLimits() {
+	m.custom_limits = nil
+}
+
+// SetVersion sets the "version" field.
+func (m *HypeMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *HypeMutation) Version() (r int, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the Hype entity.
+// If the Hype object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HypeMutation) OldVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds i to the "version" field.
+func (m *HypeMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *HypeMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *HypeMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *HypeMutation) SetUserID(id int64) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *HypeMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *HypeMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *HypeMutation) UserID() (id int64, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *HypeMutation) UserIDs() (ids []int64) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *HypeMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the HypeMutation builder.
+func (m *HypeMutation) Where(ps ...predicate.Hype) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the HypeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *HypeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Hype, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *HypeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *HypeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Hype).
+func (m *HypeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *HypeMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.amount_remaining != nil {
+		fields = append(fields, hype.FieldAmountRemaining)
+	}
+	if m.max_hype != nil {
+		fields = append(fields, hype.FieldMaxHype)
+	}
+	if m.last_updated_at != nil {
+		fields = append(fields, hype.FieldLastUpdatedAt)
+	}
+	if m.hype_per_minute != nil {
+		fields = append(fields, hype.FieldHypePerMinute)
+	}
+	if m.custom_limits != nil {
+		fields = append(fields, hype.FieldCustomLimits)
+	}
+	if m.version != nil {
+		fields = append(fields, hype.FieldVersion)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *HypeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case hype.FieldAmountRemaining:
+		return m.AmountRemaining()
+	case hype.FieldMaxHype:
+		return m.MaxHype()
+	case hype.FieldLastUpdatedAt:
+		return m.LastUpdatedAt()
+	case hype.FieldHypePerMinute:
+		return m.HypePerMinute()
+	case hype.FieldCustomLimits:
+		return m.CustomLimits()
+	case hype.FieldVersion:
+		return m.Version()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *HypeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case hype.FieldAmountRemaining:
+		return m.OldAmountRemaining(ctx)
+	case hype.FieldMaxHype:
+		return m.OldMaxHype(ctx)
+	case hype.FieldLastUpdatedAt:
+		return m.OldLastUpdatedAt(ctx)
+	case hype.FieldHypePerMinute:
+		return m.OldHypePerMinute(ctx)
+	case hype.FieldCustomLimits:
+		return m.OldCustomLimits(ctx)
+	case hype.FieldVersion:
+		return m.OldVersion(ctx)
+	}
+	return nil, fmt.Errorf("unknown Hype field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HypeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case hype.FieldAmountRemaining:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmountRemaining(v)
+		return nil
+	case hype.FieldMaxHype:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxHype(v)
+		return nil
+	case hype.FieldLastUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUpdatedAt(v)
+		return nil
+	case hype.FieldHypePerMinute:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHypePerMinute(v)
+		return nil
+	case hype.FieldCustomLimits:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCustomLimits(v)
+		return nil
+	case hype.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Hype field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *HypeMutation) AddedFields() []string {
+	var fields []string
+	if m.addamount_remaining != nil {
+		fields = append(fields, hype.FieldAmountRemaining)
+	}
+	if m.addmax_hype != nil {
+		fields = append(fields, hype.FieldMaxHype)
+	}
+	if m.addhype_per_minute != nil {
+		fields = append(fields, hype.FieldHypePerMinute)
+	}
+	if m.addversion != nil {
+		fields = append(fields, hype.FieldVersion)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *HypeMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case hype.FieldAmountRemaining:
+		return m.AddedAmountRemaining()
+	case hype.FieldMaxHype:
+		return m.AddedMaxHype()
+	case hype.FieldHypePerMinute:
+		return m.AddedHypePerMinute()
+	case hype.FieldVersion:
+		return m.AddedVersion()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HypeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case hype.FieldAmountRemaining:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAmountRemaining(v)
+		return nil
+	case hype.FieldMaxHype:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxHype(v)
+		return nil
+	case hype.FieldHypePerMinute:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHypePerMinute(v)
+		return nil
+	case hype.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Hype numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *HypeMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *HypeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *HypeMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Hype nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *HypeMutation) ResetField(name string) error {
+	switch name {
+	case hype.FieldAmountRemaining:
+		m.ResetAmountRemaining()
+		return nil
+	case hype.FieldMaxHype:
+		m.ResetMaxHype()
+		return nil
+	case hype.FieldLastUpdatedAt:
+		m.ResetLastUpdatedAt()
+		return nil
+	case hype.FieldHypePerMinute:
+		m.ResetHypePerMinute()
+		return nil
+	case hype.FieldCustomLimits:
+		m.ResetCustomLimits()
+		return nil
+	case hype.FieldVersion:
+		m.ResetVersion()
+		return nil
+	}
+	return fmt.Errorf("unknown Hype field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *HypeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, hype.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *HypeMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case hype.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *HypeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *HypeMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *HypeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, hype.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *HypeMutation) EdgeCleared(name string) bool {
+	switch name {
+	case hype.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *HypeMutation) ClearEdge(name string) error {
+	switch name {
+	case hype.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Hype unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *HypeMutation) ResetEdge(name string) error {
+	switch name {
+	case hype.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Hype edge %s", name)
+}
+
+// HypeLedgerMutation represents an operation that mutates the HypeLedger nodes in the graph.
+type HypeLedgerMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	kind          *hypeledger.Kind
+	amount        *int
+	addamount     *int
+	balance       *int
+	addbalance    *int
+	reason        *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *int64
+	cleareduser   bool
+	actor         *int64
+	clearedactor  bool
+	done          bool
+	oldValue      func(context.Context) (*HypeLedger, error)
+	predicates    []predicate.HypeLedger
+}
+
+var _ ent.Mutation = (*HypeLedgerMutation)(nil)
+
+// hypeledgerOption allows management of the mutation configuration using functional options.
+type hypeledgerOption func(*HypeLedgerMutation)
+
+// newHypeLedgerMutation creates new mutation for the HypeLedger entity.
+func newHypeLedgerMutation(c config, op Op, opts ...hypeledgerOption) *HypeLedgerMutation {
+	m := &HypeLedgerMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeHypeLedger,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withHypeLedgerID sets the ID field of the mutation.
+func withHypeLedgerID(id int) hypeledgerOption {
+	return func(m *HypeLedgerMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *HypeLedger
+		)
+		m.oldValue = func(ctx context.Context) (*HypeLedger, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().HypeLedger.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withHypeLedger sets the old HypeLedger of the mutation.
+func withHypeLedger(node *HypeLedger) hypeledgerOption {
+	return func(m *HypeLedgerMutation) {
+		m.oldValue = func(context.Context) (*HypeLedger, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m HypeLedgerMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m HypeLedgerMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *HypeLedgerMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *HypeLedgerMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().HypeLedger.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetKind sets the "kind" field.
+func (m *HypeLedgerMutation) SetKind(h hypeledger.Kind) {
+	m.kind = &h
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *HypeLedgerMutation) Kind() (r hypeledger.Kind, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the HypeLedger entity.
+// If the HypeLedger object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HypeLedgerMutation) OldKind(ctx context.Context) (v hypeledger.Kind, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *HypeLedgerMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetAmount sets the "amount" field.
+func (m *HypeLedgerMutation) SetAmount(i int) {
+	m.amount = &i
+	m.addamount = nil
+}
+
+// Amount returns the value of the "amount" field in the mutation.
+func (m *HypeLedgerMutation) Amount() (r int, exists bool) {
+	v := m.amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmount returns the old "amount" field's value of the HypeLedger entity.
+// If the HypeLedger object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HypeLedgerMutation) OldAmount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+	}
+	return oldValue.Amount, nil
+}
+
+// AddAmount adds i to the "amount" field.
+func (m *HypeLedgerMutation) AddAmount(i int) {
+	if m.addamount != nil {
+		*m.addamount += i
+	} else {
+		m.addamount = &i
+	}
+}
+
+// AddedAmount returns the value that was added to the "amount" field in this mutation.
+func (m *HypeLedgerMutation) AddedAmount() (r int, exists bool) {
+	v := m.addamount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAmount resets all changes to the "amount" field.
+func (m *HypeLedgerMutation) ResetAmount() {
+	m.amount = nil
+	m.addamount = nil
+}
+
+// SetBalance sets the "balance" field.
+func (m *HypeLedgerMutation) SetBalance(i int) {
+	m.balance = &i
+	m.addbalance = nil
+}
+
+// Balance returns the value of the "balance" field in the mutation.
+func (m *HypeLedgerMutation) Balance() (r int, exists bool) {
+	v := m.balance
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBalance returns the old "balance" field's value of the HypeLedger entity.
+// If the HypeLedger object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HypeLedgerMutation) OldBalance(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBalance is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBalance requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBalance: %w", err)
+	}
+	return oldValue.Balance, nil
+}
+
+// AddBalance adds i to the "balance" field.
+func (m *HypeLedgerMutation) AddBalance(i int) {
+	if m.addbalance != nil {
+		*m.addbalance += i
+	} else {
+		m.addbalance = &i
+	}
+}
+
+// AddedBalance returns the value that was added to the "balance" field in this mutation.
+func (m *HypeLedgerMutation) AddedBalance() (r int, exists bool) {
+	v := m.addbalance
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetBalance resets all changes to the "balance" field.
+func (m *HypeLedgerMutation) ResetBalance() {
+	m.balance = nil
+	m.addbalance = nil
+}
+
+// SetReason sets the "reason" field.
+func (m *HypeLedgerMutation) SetReason(s string) {
+	m.reason = &s
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *HypeLedgerMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the HypeLedger entity.
+// If the HypeLedger object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HypeLedgerMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *HypeLedgerMutation) ResetReason() {
+	m.reason = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *HypeLedgerMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *HypeLedgerMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the HypeLedger entity.
+// If the HypeLedger object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HypeLedgerMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *HypeLedgerMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *HypeLedgerMutation) SetUserID(id int64) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *HypeLedgerMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *HypeLedgerMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *HypeLedgerMutation) UserID() (id int64, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *HypeLedgerMutation) UserIDs() (ids []int64) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *HypeLedgerMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// SetActorID sets the "actor" edge to the User entity by id.
+func (m *HypeLedgerMutation) SetActorID(id int64) {
+	m.actor = &id
+}
+
+// ClearActor clears the "actor" edge to the User entity.
+func (m *HypeLedgerMutation) ClearActor() {
+	m.clearedactor = true
+}
+
+// ActorCleared reports if the "actor" edge to the User entity was cleared.
+func (m *HypeLedgerMutation) ActorCleared() bool {
+	return m.clearedactor
+}
+
+// ActorID returns the "actor" edge ID in the mutation.
+func (m *HypeLedgerMutation) ActorID() (id int64, exists bool) {
+	if m.actor != nil {
+		return *m.actor, true
+	}
+	return
+}
+
+// ActorIDs returns the "actor" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ActorID instead. It exists only for internal usage by the builders.
+func (m *HypeLedgerMutation) ActorIDs() (ids []int64) {
+	if id := m.actor; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetActor resets all changes to the "actor" edge.
+func (m *HypeLedgerMutation) ResetActor() {
+	m.actor = nil
+	m.clearedactor = false
+}
+
+// Where appends a list predicates to the HypeLedgerMutation builder.
+func (m *HypeLedgerMutation) Where(ps ...predicate.HypeLedger) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the HypeLedgerMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *HypeLedgerMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.HypeLedger, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *HypeLedgerMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *HypeLedgerMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (HypeLedger).
+func (m *HypeLedgerMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *HypeLedgerMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.kind != nil {
+		fields = append(fields, hypeledger.FieldKind)
+	}
+	if m.amount != nil {
+		fields = append(fields, hypeledger.FieldAmount)
+	}
+	if m.balance != nil {
+		fields = append(fields, hypeledger.FieldBalance)
+	}
+	if m.reason != nil {
+		fields = append(fields, hypeledger.FieldReason)
+	}
+	if m.created_at != nil {
+		fields = append(fields, hypeledger.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *HypeLedgerMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case hypeledger.FieldKind:
+		return m.Kind()
+	case hypeledger.FieldAmount:
+		return m.Amount()
+	case hypeledger.FieldBalance:
+		return m.Balance()
+	case hypeledger.FieldReason:
+		return m.Reason()
+	case hypeledger.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *HypeLedgerMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case hypeledger.FieldKind:
+		return m.OldKind(ctx)
+	case hypeledger.FieldAmount:
+		return m.OldAmount(ctx)
+	case hypeledger.FieldBalance:
+		return m.OldBalance(ctx)
+	case hypeledger.FieldReason:
+		return m.OldReason(ctx)
+	case hypeledger.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown HypeLedger field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HypeLedgerMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case hypeledger.FieldKind:
+		v, ok := value.(hypeledger.Kind)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case hypeledger.FieldAmount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmount(v)
+		return nil
+	case hypeledger.FieldBalance:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBalance(v)
+		return nil
+	case hypeledger.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case hypeledger.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown HypeLedger field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *HypeLedgerMutation) AddedFields() []string {
+	var fields []string
+	if m.addamount != nil {
+		fields = append(fields, hypeledger.FieldAmount)
+	}
+	if m.addbalance != nil {
+		fields = append(fields, hypeledger.FieldBalance)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *HypeLedgerMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case hypeledger.FieldAmount:
+		return m.AddedAmount()
+	case hypeledger.FieldBalance:
+		return m.AddedBalance()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HypeLedgerMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case hypeledger.FieldAmount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAmount(v)
+		return nil
+	case hypeledger.FieldBalance:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBalance(v)
+		return nil
+	}
+	return fmt.Errorf("unknown HypeLedger numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *HypeLedgerMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *HypeLedgerMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *HypeLedgerMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown HypeLedger nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *HypeLedgerMutation) ResetField(name string) error {
+	switch name {
+	case hypeledger.FieldKind:
+		m.ResetKind()
+		return nil
+	case hypeledger.FieldAmount:
+		m.ResetAmount()
+		return nil
+	case hypeledger.FieldBalance:
+		m.ResetBalance()
+		return nil
+	case hypeledger.FieldReason:
+		m.ResetReason()
+		return nil
+	case hypeledger.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown HypeLedger field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *HypeLedgerMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, hypeledger.EdgeUser)
+	}
+	if m.actor != nil {
+		edges = append(edges, hypeledger.EdgeActor)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *HypeLedgerMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case hypeledger.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case hypeledger.EdgeActor:
+		if id := m.actor; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *HypeLedgerMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *HypeLedgerMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *HypeLedgerMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, hypeledger.EdgeUser)
+	}
+	if m.clearedactor {
+		edges = append(edges, hypeledger.EdgeActor)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *HypeLedgerMutation) EdgeCleared(name string) bool {
+	switch name {
+	case hypeledger.EdgeUser:
+		return m.cleareduser
+	case hypeledger.EdgeActor:
+		return m.clearedactor
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *HypeLedgerMutation) ClearEdge(name string) error {
+	switch name {
+	case hypeledger.EdgeUser:
+		m.ClearUser()
+		return nil
+	case hypeledger.EdgeActor:
+		m.ClearActor()
+		return nil
+	}
+	return fmt.Errorf("unknown HypeLedger unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *HypeLedgerMutation) ResetEdge(name string) error {
+	switch name {
+	case hypeledger.EdgeUser:
+		m.ResetUser()
+		return nil
+	case hypeledger.EdgeActor:
+		m.ResetActor()
+		return nil
+	}
+	return fmt.Errorf("unknown HypeLedger edge %s", name)
+}
+
+// OutboxEventMutation represents an operation that mutates the OutboxEvent nodes in the graph.
+type OutboxEventMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	kind            *outboxevent.Kind
+	target          *string
+	name            *string
+	payload         *[]byte
+	attempts        *int
+	addattempts     *int
+	next_attempt_at *time.Time
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*OutboxEvent, error)
+	predicates      []predicate.OutboxEvent
+}
+
+var _ ent.Mutation = (*OutboxEventMutation)(nil)
+
+// outboxeventOption allows management of the mutation configuration using functional options.
+type outboxeventOption func(*OutboxEventMutation)
+
+// newOutboxEventMutation creates new mutation for the OutboxEvent entity.
+func newOutboxEventMutation(c config, op Op, opts ...outboxeventOption) *OutboxEventMutation {
+	m := &OutboxEventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOutboxEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOutboxEventID sets the ID field of the mutation.
+func withOutboxEventID(id int) outboxeventOption {
+	return func(m *OutboxEventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *OutboxEvent
+		)
+		m.oldValue = func(ctx context.Context) (*OutboxEvent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().OutboxEvent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOutboxEvent sets the old OutboxEvent of the mutation.
+func withOutboxEvent(node *OutboxEvent) outboxeventOption {
+	return func(m *OutboxEventMutation) {
+		m.oldValue = func(context.Context) (*OutboxEvent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OutboxEventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OutboxEventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OutboxEventMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OutboxEventMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().OutboxEvent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetKind sets the "kind" field.
+func (m *OutboxEventMutation) SetKind(o outboxevent.Kind) {
+	m.kind = &o
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *OutboxEventMutation) Kind() (r outboxevent.Kind, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldKind(ctx context.Context) (v outboxevent.Kind, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *OutboxEventMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetTarget sets the "target" field.
+func (m *OutboxEventMutation) SetTarget(s string) {
+	m.target = &s
+}
+
+// Target returns the value of the "target" field in the mutation.
+func (m *OutboxEventMutation) Target() (r string, exists bool) {
+	v := m.target
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTarget returns the old "target" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldTarget(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTarget is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTarget requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTarget: %w", err)
+	}
+	return oldValue.Target, nil
+}
+
+// ClearTarget clears the value of the "target" field.
+func (m *OutboxEventMutation) ClearTarget() {
+	m.target = nil
+	m.clearedFields[outboxevent.FieldTarget] = struct{}{}
+}
+
+// TargetCleared returns if the "target" field was cleared in this mutation.
+func (m *OutboxEventMutation) TargetCleared() bool {
+	_, ok := m.clearedFields[outboxevent.FieldTarget]
+	return ok
+}
+
+// ResetTarget resets all changes to the "target" field.
+func (m *OutboxEventMutation) ResetTarget() {
+	m.target = nil
+	delete(m.clearedFields, outboxevent.FieldTarget)
+}
+
+// SetName sets the "name" field.
+func (m *OutboxEventMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *OutboxEventMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *OutboxEventMutation) ResetName() {
+	m.name = nil
+}
+
+// SetPayload sets the "payload" field.
+func (m *OutboxEventMutation) SetPayload(b []byte) {
+	m.payload = &b
+}
+
+// Payload returns the value of the "payload" field in the mutation.
+func (m *OutboxEventMutation) Payload() (r []byte, exists bool) {
+	v := m.payload
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPayload returns the old "payload" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldPayload(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+	}
+	return oldValue.Payload, nil
+}
+
+// ResetPayload resets all changes to the "payload" field.
+func (m *OutboxEventMutation) ResetPayload() {
+	m.payload = nil
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *OutboxEventMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *OutboxEventMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *OutboxEventMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *OutboxEventMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *OutboxEventMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (m *OutboxEventMutation) SetNextAttemptAt(t time.Time) {
+	m.next_attempt_at = &t
+}
+
+// NextAttemptAt returns the value of the "next_attempt_at" field in the mutation.
+func (m *OutboxEventMutation) NextAttemptAt() (r time.Time, exists bool) {
+	v := m.next_attempt_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextAttemptAt returns the old "next_attempt_at" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldNextAttemptAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextAttemptAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextAttemptAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextAttemptAt: %w", err)
+	}
+	return oldValue.NextAttemptAt, nil
+}
+
+// ResetNextAttemptAt resets all changes to the "next_attempt_at" field.
+func (m *OutboxEventMutation) ResetNextAttemptAt() {
+	m.next_attempt_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *OutboxEventMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *OutboxEventMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *OutboxEventMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the OutboxEventMutation builder.
+func (m *OutboxEventMutation) Where(ps ...predicate.OutboxEvent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the OutboxEventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *OutboxEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.OutboxEvent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *OutboxEventMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *OutboxEventMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (OutboxEvent).
+func (m *OutboxEventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OutboxEventMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.kind != nil {
+		fields = append(fields, outboxevent.FieldKind)
+	}
+	if m.target != nil {
+		fields = append(fields, outboxevent.FieldTarget)
+	}
+	if m.name != nil {
+		fields = append(fields, outboxevent.FieldName)
+	}
+	if m.payload != nil {
+		fields = append(fields, outboxevent.FieldPayload)
+	}
+	if m.attempts != nil {
+		fields = append(fields, outboxevent.FieldAttempts)
+	}
+	if m.next_attempt_at != nil {
+		fields = append(fields, outboxevent.FieldNextAttemptAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, outboxevent.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OutboxEventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case outboxevent.FieldKind:
+		return m.Kind()
+	case outboxevent.FieldTarget:
+		return m.Target()
+	case outboxevent.FieldName:
+		return m.Name()
+	case outboxevent.FieldPayload:
+		return m.Payload()
+	case outboxevent.FieldAttempts:
+		return m.Attempts()
+	case outboxevent.FieldNextAttemptAt:
+		return m.NextAttemptAt()
+	case outboxevent.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OutboxEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case outboxevent.FieldKind:
+		return m.OldKind(ctx)
+	case outboxevent.FieldTarget:
+		return m.OldTarget(ctx)
+	case outboxevent.FieldName:
+		return m.OldName(ctx)
+	case outboxevent.FieldPayload:
+		return m.OldPayload(ctx)
+	case outboxevent.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case outboxevent.FieldNextAttemptAt:
+		return m.OldNextAttemptAt(ctx)
+	case outboxevent.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown OutboxEvent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OutboxEventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case outboxevent.FieldKind:
+		v, ok := value.(outboxevent.Kind)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case outboxevent.FieldTarget:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTarget(v)
+		return nil
+	case outboxevent.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case outboxevent.FieldPayload:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayload(v)
+		return nil
+	case outboxevent.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case outboxevent.FieldNextAttemptAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextAttemptAt(v)
+		return nil
+	case outboxevent.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OutboxEvent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OutboxEventMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, outboxevent.FieldAttempts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OutboxEventMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case outboxevent.FieldAttempts:
+		return m.AddedAttempts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OutboxEventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case outboxevent.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OutboxEvent numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OutboxEventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(outboxevent.FieldTarget) {
+		fields = append(fields, outboxevent.FieldTarget)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OutboxEventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OutboxEventMutation) ClearField(name string) error {
+	switch name {
+	case outboxevent.FieldTarget:
+		m.ClearTarget()
+		return nil
+	}
+	return fmt.Errorf("unknown OutboxEvent nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OutboxEventMutation) ResetField(name string) error {
+	switch name {
+	case outboxevent.FieldKind:
+		m.ResetKind()
+		return nil
+	case outboxevent.FieldTarget:
+		m.ResetTarget()
+		return nil
+	case outboxevent.FieldName:
+		m.ResetName()
+		return nil
+	case outboxevent.FieldPayload:
+		m.ResetPayload()
+		return nil
+	case outboxevent.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case outboxevent.FieldNextAttemptAt:
+		m.ResetNextAttemptAt()
+		return nil
+	case outboxevent.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown OutboxEvent field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OutboxEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OutboxEventMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OutboxEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OutboxEventMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OutboxEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OutboxEventMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OutboxEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown OutboxEvent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OutboxEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown OutboxEvent edge %s", name)
+}
+
+// PixelMutation represents an operation that mutates the Pixel nodes in the graph.
+type PixelMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	position       *int
+	addposition    *int
+	color          *string
+	updated_at     *time.Time
+	seq            *int64
+	addseq         *int64
+	clearedFields  map[string]struct{}
+	user           *int64
+	cleareduser    bool
+	board          *int
+	clearedboard   bool
+	team           *int
+	clearedteam    bool
+	changes        map[int]struct{}
+	removedchanges map[int]struct{}
+	clearedchanges bool
+	done           bool
+	oldValue       func(context.Context) (*Pixel, error)
+	predicates     []predicate.Pixel
+}
+
+var _ ent.Mutation = (*PixelMutation)(nil)
+
+// pixelOption allows management of the mutation configuration using functional options.
+type pixelOption func(*PixelMutation)
+
+// newPixelMutation creates new mutation for the Pixel entity.
+func newPixelMutation(c config, op Op, opts ...pixelOption) *PixelMutation {
+	m := &PixelMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePixel,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPixelID sets the ID field of the mutation.
+func withPixelID(id int) pixelOption {
+	return func(m *PixelMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Pixel
+		)
+		m.oldValue = func(ctx context.Context) (*Pixel, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Pixel.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPixel sets the old Pixel of the mutation.
+func withPixel(node *Pixel) pixelOption {
+	return func(m *PixelMutation) {
+		m.oldValue = func(context.Context) (*Pixel, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PixelMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PixelMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Pixel entities.
+func (m *PixelMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PixelMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PixelMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Pixel.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetPosition sets the "position" field.
+func (m *PixelMutation) SetPosition(i int) {
+	m.position = &i
+	m.addposition = nil
+}
+
+// Position returns the value of the "position" field in the mutation.
+func (m *PixelMutation) Position() (r int, exists bool) {
+	v := m.position
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPosition returns the old "position" field's value of the Pixel entity.
+// If the Pixel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PixelMutation) OldPosition(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPosition is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPosition requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPosition: %w", err)
+	}
+	return oldValue.Position, nil
+}
+
+// AddPosition adds i to the "position" field.
+func (m *PixelMutation) AddPosition(i int) {
+	if m.addposition != nil {
+		*m.addposition += i
+	} else {
+		m.addposition = &i
+	}
+}
+
+// AddedPosition returns the value that was added to the "position" field in this mutation.
+func (m *PixelMutation) AddedPosition() (r int, exists bool) {
+	v := m.addposition
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPosition resets all changes to the "position" field.
+func (m *PixelMutation) ResetPosition() {
+	m.position = nil
+	m.addposition = nil
+}
+
+// SetColor sets the "color" field.
+func (m *PixelMutation) SetColor(s string) {
+	m.color = &s
+}
+
+// Color returns the value of the "color" field in the mutation.
+func (m *PixelMutation) Color() (r string, exists bool) {
+	v := m.color
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColor returns the old "color" field's value of the Pixel entity.
+// If the Pixel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PixelMutation) OldColor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColor: %w", err)
+	}
+	return oldValue.Color, nil
+}
+
+// ResetColor resets all changes to the "color" field.
+func (m *PixelMutation) ResetColor() {
+	m.color = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PixelMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PixelMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Pixel entity.
+// If the Pixel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PixelMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PixelMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetSeq sets the "seq" field.
+func (m *PixelMutation) SetSeq(i int64) {
+	m.seq = &i
+	m.addseq = nil
+}
+
+// Seq returns the value of the "seq" field in the mutation.
+func (m *PixelMutation) Seq() (r int64, exists bool) {
+	v := m.seq
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeq returns the old "seq" field's value of the Pixel entity.
+// If the Pixel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PixelMutation) OldSeq(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeq is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeq requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeq: %w", err)
+	}
+	return oldValue.Seq, nil
+}
+
+// AddSeq adds i to the "seq" field.
+func (m *PixelMutation) AddSeq(i int64) {
+	if m.addseq != nil {
+		*m.addseq += i
+	} else {
+		m.addseq = &i
+	}
+}
+
+// AddedSeq returns the value that was added to the "seq" field in this mutation.
+func (m *PixelMutation) AddedSeq() (r int64, exists bool) {
+	v := m.addseq
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSeq resets all changes to the "seq" field.
+func (m *PixelMutation) ResetSeq() {
+	m.seq = nil
+	m.addseq = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *PixelMutation) SetUserID(i int64) {
+	m.user = &i
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *PixelMutation) UserID() (r int64, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Pixel entity.
+// If the Pixel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PixelMutation) OldUserID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (m *PixelMutation) ClearUserID() {
+	m.user = nil
+	m.clearedFields[pixel.FieldUserID] = struct{}{}
+}
+
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *PixelMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[pixel.FieldUserID]
+	return ok
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *PixelMutation) ResetUserID() {
+	m.user = nil
+	delete(m.clearedFields, pixel.FieldUserID)
+}
+
+// SetBoardID sets the "board_id" field.
+func (m *PixelMutation) SetBoardID(i int) {
+	m.board = &i
+}
+
+// BoardID returns the value of the "board_id" field in the mutation.
+func (m *PixelMutation) BoardID() (r int, exists bool) {
+	v := m.board
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBoardID returns the old "board_id" field's value of the Pixel entity.
+// If the Pixel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PixelMutation) OldBoardID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBoardID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBoardID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBoardID: %w", err)
+	}
+	return oldValue.BoardID, nil
+}
+
+// ClearBoardID clears the value of the "board_id" field.
+func (m *PixelMutation) ClearBoardID() {
+	m.board = nil
+	m.clearedFields[pixel.FieldBoardID] = struct{}{}
+}
+
+// BoardIDCleared returns if the "board_id" field was cleared in this mutation.
+func (m *PixelMutation) BoardIDCleared() bool {
+	_, ok := m.clearedFields[pixel.FieldBoardID]
+	return ok
+}
+
+// ResetBoardID resets all changes to the "board_id" field.
+func (m *PixelMutation) ResetBoardID() {
+	m.board = nil
+	delete(m.clearedFields, pixel.FieldBoardID)
+}
+
+// SetTeamID sets the "team_id" field.
+func (m *PixelMutation) SetTeamID(i int) {
+	m.team = &i
+}
+
+// TeamID returns the value of the "team_id" field in the mutation.
+func (m *PixelMutation) TeamID() (r int, exists bool) {
+	v := m.team
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTeamID returns the old "team_id" field's value of the Pixel entity.
+// If the Pixel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PixelMutation) OldTeamID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTeamID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTeamID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTeamID: %w", err)
+	}
+	return oldValue.TeamID, nil
+}
+
+// ClearTeamID clears the value of the "team_id" field.
+func (m *PixelMutation) ClearTeamID() {
+	m.team = nil
+	m.clearedFields[pixel.FieldTeamID] = struct{}{}
+}
+
+// TeamIDCleared returns if the "team_id" field was cleared in this mutation.
+func (m *PixelMutation) TeamIDCleared() bool {
+	_, ok := m.clearedFields[pixel.FieldTeamID]
+	return ok
+}
+
+// ResetTeamID resets all changes to the "team_id" field.
+func (m *PixelMutation) ResetTeamID() {
+	m.team = nil
+	delete(m.clearedFields, pixel.FieldTeamID)
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *PixelMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[pixel.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *PixelMutation) UserCleared() bool {
+	return m.UserIDCleared() || m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *PixelMutation) UserIDs() (ids []int64) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *PixelMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// ClearBoard clears the "board" edge to the Board entity.
+func (m *PixelMutation) ClearBoard() {
+	m.clearedboard = true
+	m.clearedFields[pixel.FieldBoardID] = struct{}{}
+}
+
+// BoardCleared reports if the "board" edge to the Board entity was cleared.
+func (m *PixelMutation) BoardCleared() bool {
+	return m.BoardIDCleared() || m.clearedboard
+}
+
+// BoardIDs returns the "board" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BoardID instead. It exists only for internal usage by the builders.
+func (m *PixelMutation) BoardIDs() (ids []int) {
+	if id := m.board; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBoard resets all changes to the "board" edge.
+func (m *PixelMutation) ResetBoard() {
+	m.board = nil
+	m.clearedboard = false
+}
+
+// ClearTeam clears the "team" edge to the Team entity.
+func (m *PixelMutation) ClearTeam() {
+	m.clearedteam = true
+	m.clearedFields[pixel.FieldTeamID] = struct{}{}
+}
+
+// TeamCleared reports if the "team" edge to the Team entity was cleared.
+func (m *PixelMutation) TeamCleared() bool {
+	return m.TeamIDCleared() || m.clearedteam
+}
+
+// TeamIDs returns the "team" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TeamID instead. It exists only for internal usage by the builders.
+func (m *PixelMutation) TeamIDs() (ids []int) {
+	if id := m.team; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTeam resets all changes to the "team" edge.
+func (m *PixelMutation) ResetTeam() {
+	m.team = nil
+	m.clearedteam = false
+}
+
+// AddChangeIDs adds the "changes" edge to the PixelChange entity by ids.
+func (m *PixelMutation) AddChangeIDs(ids ...int) {
+	if m.changes == nil {
+		m.changes = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.changes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChanges clears the "changes" edge to the PixelChange entity.
+func (m *PixelMutation) ClearChanges() {
+	m.clearedchanges = true
+}
+
+// ChangesCleared reports if the "changes" edge to the PixelChange entity was cleared.
+func (m *PixelMutation) ChangesCleared() bool {
+	return m.clearedchanges
+}
+
+// RemoveChangeIDs removes the "changes" edge to the PixelChange entity by IDs.
+func (m *PixelMutation) RemoveChangeIDs(ids ...int) {
+	if m.removedchanges == nil {
+		m.removedchanges = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.changes, ids[i])
+		m.removedchanges[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChanges returns the removed IDs of the "changes" edge to the PixelChange entity.
+func (m *PixelMutation) RemovedChangesIDs() (ids []int) {
+	for id := range m.removedchanges {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChangesIDs returns the "changes" edge IDs in the mutation.
+func (m *PixelMutation) ChangesIDs() (ids []int) {
+	for id := range m.changes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChanges resets all changes to the "changes" edge.
+func (m *PixelMutation) ResetChanges() {
+	m.changes = nil
+	m.clearedchanges = false
+	m.removedchanges = nil
+}
+
+// Where appends a list predicates to the PixelMutation builder.
+func (m *PixelMutation) Where(ps ...predicate.Pixel) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PixelMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PixelMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Pixel, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PixelMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PixelMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Pixel).
+func (m *PixelMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PixelMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.position != nil {
+		fields = append(fields, pixel.FieldPosition)
+	}
+	if m.color != nil {
+		fields = append(fields, pixel.FieldColor)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, pixel.FieldUpdatedAt)
+	}
+	if m.seq != nil {
+		fields = append(fields, pixel.FieldSeq)
+	}
+	if m.user != nil {
+		fields = append(fields, pixel.FieldUserID)
+	}
+	if m.board != nil {
+		fields = append(fields, pixel.FieldBoardID)
+	}
+	if m.team != nil {
+		fields = append(fields, pixel.FieldTeamID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PixelMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case pixel.FieldPosition:
+		return m.Position()
+	case pixel.FieldColor:
+		return m.Color()
+	case pixel.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case pixel.FieldSeq:
+		return m.Seq()
+	case pixel.FieldUserID:
+		return m.UserID()
+	case pixel.FieldBoardID:
+		return m.BoardID()
+	case pixel.FieldTeamID:
+		return m.TeamID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PixelMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case pixel.FieldPosition:
+		return m.OldPosition(ctx)
+	case pixel.FieldColor:
+		return m.OldColor(ctx)
+	case pixel.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case pixel.FieldSeq:
+		return m.OldSeq(ctx)
+	case pixel.FieldUserID:
+		return m.OldUserID(ctx)
+	case pixel.FieldBoardID:
+		return m.OldBoardID(ctx)
+	case pixel.FieldTeamID:
+		return m.OldTeamID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Pixel field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PixelMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case pixel.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPosition(v)
+		return nil
+	case pixel.FieldColor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColor(v)
+		return nil
+	case pixel.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case pixel.FieldSeq:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeq(v)
+		return nil
+	case pixel.FieldUserID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case pixel.FieldBoardID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBoardID(v)
+		return nil
+	case pixel.FieldTeamID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTeamID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Pixel field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PixelMutation) AddedFields() []string {
+	var fields []string
+	if m.addposition != nil {
+		fields = append(fields, pixel.FieldPosition)
+	}
+	if m.addseq != nil {
+		fields = append(fields, pixel.FieldSeq)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PixelMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case pixel.FieldPosition:
+		return m.AddedPosition()
+	case pixel.FieldSeq:
+		return m.AddedSeq()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PixelMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case pixel.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPosition(v)
+		return nil
+	case pixel.FieldSeq:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSeq(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Pixel numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PixelMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(pixel.FieldUserID) {
+		fields = append(fields, pixel.FieldUserID)
+	}
+	if m.FieldCleared(pixel.FieldBoardID) {
+		fields = append(fields, pixel.FieldBoardID)
+	}
+	if m.FieldCleared(pixel.FieldTeamID) {
+		fields = append(fields, pixel.FieldTeamID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PixelMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PixelMutation) ClearField(name string) error {
+	switch name {
+	case pixel.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case pixel.FieldBoardID:
+		m.ClearBoardID()
+		return nil
+	case pixel.FieldTeamID:
+		m.ClearTeamID()
+		return nil
+	}
+	return fmt.Errorf("unknown Pixel nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PixelMutation) ResetField(name string) error {
+	switch name {
+	case pixel.FieldPosition:
+		m.ResetPosition()
+		return nil
+	case pixel.FieldColor:
+		m.ResetColor()
+		return nil
+	case pixel.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case pixel.FieldSeq:
+		m.ResetSeq()
+		return nil
+	case pixel.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case pixel.FieldBoardID:
+		m.ResetBoardID()
+		return nil
+	case pixel.FieldTeamID:
+		m.ResetTeamID()
+		return nil
+	}
+	return fmt.Errorf("unknown Pixel field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PixelMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.user != nil {
+		edges = append(edges, pixel.EdgeUser)
+	}
+	if m.board != nil {
+		edges = append(edges, pixel.EdgeBoard)
+	}
+	if m.team != nil {
+		edges = append(edges, pixel.EdgeTeam)
+	}
+	if m.changes != nil {
+		edges = append(edges, pixel.EdgeChanges)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PixelMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case pixel.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case pixel.EdgeBoard:
+		if id := m.board; id != nil {
+			return []ent.Value{*id}
+		}
+	case pixel.EdgeTeam:
+		if id := m.team; id != nil {
+			return []ent.Value{*id}
+		}
+	case pixel.EdgeChanges:
+		ids := make([]ent.Value, 0, len(m.changes))
+		for id := range m.changes {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PixelMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedchanges != nil {
+		edges = append(edges, pixel.EdgeChanges)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PixelMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case pixel.EdgeChanges:
+		ids := make([]ent.Value, 0, len(m.removedchanges))
+		for id := range m.removedchanges {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PixelMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.cleareduser {
+		edges = append(edges, pixel.EdgeUser)
+	}
+	if m.clearedboard {
+		edges = append(edges, pixel.EdgeBoard)
+	}
+	if m.clearedteam {
+		edges = append(edges, pixel.EdgeTeam)
+	}
+	if m.clearedchanges {
+		edges = append(edges, pixel.EdgeChanges)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PixelMutation) EdgeCleared(name string) bool {
+	switch name {
+	case pixel.EdgeUser:
+		return m.cleareduser
+	case pixel.EdgeBoard:
+		return m.clearedboard
+	case pixel.EdgeTeam:
+		return m.clearedteam
+	case pixel.EdgeChanges:
+		return m.clearedchanges
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PixelMutation) ClearEdge(name string) error {
+	switch name {
+	case pixel.EdgeUser:
+		m.ClearUser()
+		return nil
+	case pixel.EdgeBoard:
+		m.ClearBoard()
+		return nil
+	case pixel.EdgeTeam:
+		m.ClearTeam()
+		return nil
+	}
+	return fmt.Errorf("unknown Pixel unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PixelMutation) ResetEdge(name string) error {
+	switch name {
+	case pixel.EdgeUser:
+		m.ResetUser()
+		return nil
+	case pixel.EdgeBoard:
+		m.ResetBoard()
+		return nil
+	case pixel.EdgeTeam:
+		m.ResetTeam()
+		return nil
+	case pixel.EdgeChanges:
+		m.ResetChanges()
+		return nil
+	}
+	return fmt.Errorf("unknown Pixel edge %s", name)
+}
+
+// PixelChangeMutation represents an operation that mutates the PixelChange nodes in the graph.
+type PixelChangeMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	position      *int
+	addposition   *int
+	old_color     *string
+	new_color     *string
+	seq           *int64
+	addseq        *int64
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	pixel         *int
+	clearedpixel  bool
+	board         *int
+	clearedboard  bool
+	user          *int64
+	cleareduser   bool
+	team          *int
+	clearedteam   bool
+	done          bool
+	oldValue      func(context.Context) (*PixelChange, error)
+	predicates    []predicate.PixelChange
+}
+
+var _ ent.Mutation = (*PixelChangeMutation)(nil)
+
+// pixelchangeOption allows management of the mutation configuration using functional options.
+type pixelchangeOption func(*PixelChangeMutation)
+
+// newPixelChangeMutation creates new mutation for the PixelChange entity.
+func newPixelChangeMutation(c config, op Op, opts ...pixelchangeOption) *PixelChangeMutation {
+	m := &PixelChangeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePixelChange,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPixelChangeID sets the ID field of the mutation.
+func withPixelChangeID(id int) pixelchangeOption {
+	return func(m *PixelChangeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PixelChange
+		)
+		m.oldValue = func(ctx context.Context) (*PixelChange, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PixelChange.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPixelChange sets the old PixelChange of the mutation.
+func withPixelChange(node *PixelChange) pixelchangeOption {
+	return func(m *PixelChangeMutation) {
+		m.oldValue = func(context.Context) (*PixelChange, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PixelChangeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PixelChangeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PixelChangeMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PixelChangeMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PixelChange.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetPosition sets the "position" field.
+func (m *PixelChangeMutation) SetPosition(i int) {
+	m.position = &i
+	m.addposition = nil
+}
+
+// Position returns the value of the "position" field in the mutation.
+func (m *PixelChangeMutation) Position() (r int, exists bool) {
+	v := m.position
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPosition returns the old "position" field's value of the PixelChange entity.
+// If the PixelChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PixelChangeMutation) OldPosition(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPosition is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPosition requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPosition: %w", err)
+	}
+	return oldValue.Position, nil
+}
+
+// AddPosition adds i to the "position" field.
+func (m *PixelChangeMutation) AddPosition(i int) {
+	if m.addposition != nil {
+		*m.addposition += i
+	} else {
+		m.addposition = &i
+	}
+}
+
+// AddedPosition returns the value that was added to the "position" field in this mutation.
+func (m *PixelChangeMutation) AddedPosition() (r int, exists bool) {
+	v := m.addposition
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPosition resets all changes to the "position" field.
+func (m *PixelChangeMutation) ResetPosition() {
+	m.position = nil
+	m.addposition = nil
+}
+
+// SetOldColor sets the "old_color" field.
+func (m *PixelChangeMutation) SetOldColor(s string) {
+	m.old_color = &s
+}
+
+// OldColor returns the value of the "old_color" field in the mutation.
+func (m *PixelChangeMutation) OldColor() (r string, exists bool) {
+	v := m.old_color
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOldColor returns the old "old_color" field's value of the PixelChange entity.
+// If the PixelChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PixelChangeMutation) OldOldColor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOldColor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOldColor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOldColor: %w", err)
+	}
+	return oldValue.OldColor, nil
+}
+
+// ResetOldColor resets all changes to the "old_color" field.
+func (m *PixelChangeMutation) ResetOldColor() {
+	m.old_color = nil
+}
+
+// SetNewColor sets the "new_color" field.
+func (m *PixelChangeMutation) SetNewColor(s string) {
+	m.new_color = &s
+}
+
+// NewColor returns the value of the "new_color" field in the mutation.
+func (m *PixelChangeMutation) NewColor() (r string, exists bool) {
+	v := m.new_color
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNewColor returns the old "new_color" field's value of the PixelChange entity.
+// If the PixelChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PixelChangeMutation) OldNewColor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNewColor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNewColor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNewColor: %w", err)
+	}
+	return oldValue.NewColor, nil
+}
+
+// ResetNewColor resets all changes to the "new_color" field.
+func (m *PixelChangeMutation) ResetNewColor() {
+	m.new_color = nil
+}
+
+// SetSeq sets the "seq" field.
+func (m *PixelChangeMutation) SetSeq(i int64) {
+	m.seq = &i
+	m.addseq = nil
+}
+
+// Seq returns the value of the "seq" field in the mutation.
+func (m *PixelChangeMutation) Seq() (r int64, exists bool) {
+	v := m.seq
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeq returns the old "seq" field's value of the PixelChange entity.
+// If the PixelChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PixelChangeMutation) OldSeq(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeq is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeq requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeq: %w", err)
+	}
+	return oldValue.Seq, nil
+}
+
+// AddSeq adds i to the "seq" field.
+func (m *PixelChangeMutation) AddSeq(i int64) {
+	if m.addseq != nil {
+		*m.addseq += i
+	} else {
+		m.addseq = &i
+	}
+}
+
+// AddedSeq returns the value that was added to the "seq" field in this mutation.
+func (m *PixelChangeMutation) AddedSeq() (r int64, exists bool) {
+	v := m.addseq
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSeq resets all changes to the "seq" field.
+func (m *PixelChangeMutation) ResetSeq() {
+	m.seq = nil
+	m.addseq = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PixelChangeMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PixelChangeMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PixelChange entity.
+// If the PixelChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PixelChangeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PixelChangeMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetBoardID sets the "board_id" field.
+func (m *PixelChangeMutation) SetBoardID(i int) {
+	m.board = &i
+}
+
+// BoardID returns the value of the "board_id" field in the mutation.
+func (m *PixelChangeMutation) BoardID() (r int, exists bool) {
+	v := m.board
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBoardID returns the old "board_id" field's value of the PixelChange entity.
+// If the PixelChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PixelChangeMutation) OldBoardID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBoardID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBoardID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBoardID: %w", err)
+	}
+	return oldValue.BoardID, nil
+}
+
+// ResetBoardID resets all changes to the "board_id" field.
+func (m *PixelChangeMutation) ResetBoardID() {
+	m.board = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *PixelChangeMutation) SetUserID(i int64) {
+	m.user = &i
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *PixelChangeMutation) UserID() (r int64, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the PixelChange entity.
+// If the PixelChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PixelChangeMutation) OldUserID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (m *PixelChangeMutation) ClearUserID() {
+	m.user = nil
+	m.clearedFields[pixelchange.FieldUserID] = struct{}{}
+}
+
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *PixelChangeMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[pixelchange.FieldUserID]
+	return ok
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *PixelChangeMutation) ResetUserID() {
+	m.user = nil
+	delete(m.clearedFields, pixelchange.FieldUserID)
+}
+
+// SetTeamID sets the "team_id" field.
+func (m *PixelChangeMutation) SetTeamID(i int) {
+	m.team = &i
+}
+
+// TeamID returns the value of the "team_id" field in the mutation.
+func (m *PixelChangeMutation) TeamID() (r int, exists bool) {
+	v := m.team
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTeamID returns the old "team_id" field's value of the PixelChange entity.
+// If the PixelChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PixelChangeMutation) OldTeamID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTeamID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTeamID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTeamID: %w", err)
+	}
+	return oldValue.TeamID, nil
+}
+
+// ClearTeamID clears the value of the "team_id" field.
+func (m *PixelChangeMutation) ClearTeamID() {
+	m.team = nil
+	m.clearedFields[pixelchange.FieldTeamID] = struct{}{}
+}
+
+// TeamIDCleared returns if the "team_id" field was cleared in this mutation.
+func (m *PixelChangeMutation) TeamIDCleared() bool {
+	_, ok := m.clearedFields[pixelchange.FieldTeamID]
+	return ok
+}
+
+// ResetTeamID resets all changes to the "team_id" field.
+func (m *PixelChangeMutation) ResetTeamID() {
+	m.team = nil
+	delete(m.clearedFields, pixelchange.FieldTeamID)
+}
+
+// SetPixelID sets the "pixel" edge to the Pixel entity by id.
+func (m *PixelChangeMutation) SetPixelID(id int) {
+	m.pixel = &id
+}
+
+// ClearPixel clears the "pixel" edge to the Pixel entity.
+func (m *PixelChangeMutation) ClearPixel() {
+	m.clearedpixel = true
+}
+
+// PixelCleared reports if the "pixel" edge to the Pixel entity was cleared.
+func (m *PixelChangeMutation) PixelCleared() bool {
+	return m.clearedpixel
+}
+
+// PixelID returns the "pixel" edge ID in the mutation.
+func (m *PixelChangeMutation) PixelID() (id int, exists bool) {
+	if m.pixel != nil {
+		return *m.pixel, true
+	}
+	return
+}
+
+// PixelIDs returns the "pixel" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PixelID instead. It exists only for internal usage by the builders.
+func (m *PixelChangeMutation) PixelIDs() (ids []int) {
+	if id := m.pixel; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPixel resets all changes to the "pixel" edge.
+func (m *PixelChangeMutation) ResetPixel() {
+	m.pixel = nil
+	m.clearedpixel = false
+}
+
+// ClearBoard clears the "board" edge to the Board entity.
+func (m *PixelChangeMutation) ClearBoard() {
+	m.clearedboard = true
+	m.clearedFields[pixelchange.FieldBoardID] = struct{}{}
+}
+
+// BoardCleared reports if the "board" edge to the Board entity was cleared.
+func (m *PixelChangeMutation) BoardCleared() bool {
+	return m.clearedboard
+}
+
+// BoardIDs returns the "board" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BoardID instead. It exists only for internal usage by the builders.
+func (m *PixelChangeMutation) BoardIDs() (ids []int) {
+	if id := m.board; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBoard resets all changes to the "board" edge.
+func (m *PixelChangeMutation) ResetBoard() {
+	m.board = nil
+	m.clearedboard = false
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *PixelChangeMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[pixelchange.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *PixelChangeMutation) UserCleared() bool {
+	return m.UserIDCleared() || m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *PixelChangeMutation) UserIDs() (ids []int64) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *PixelChangeMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// ClearTeam clears the "team" edge to the Team entity.
+func (m *PixelChangeMutation) ClearTeam() {
+	m.clearedteam = true
+	m.clearedFields[pixelchange.FieldTeamID] = struct{}{}
+}
+
+// TeamCleared reports if the "team" edge to the Team entity was cleared.
+func (m *PixelChangeMutation) TeamCleared() bool {
+	return m.TeamIDCleared() || m.clearedteam
+}
+
+// TeamIDs returns the "team" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TeamID instead. It exists only for internal usage by the builders.
+func (m *PixelChangeMutation) TeamIDs() (ids []int) {
+	if id := m.team; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTeam resets all changes to the "team" edge.
+func (m *PixelChangeMutation) ResetTeam() {
+	m.team = nil
+	m.clearedteam = false
+}
+
+// Where appends a list predicates to the PixelChangeMutation builder.
+func (m *PixelChangeMutation) Where(ps ...predicate.PixelChange) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PixelChangeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PixelChangeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PixelChange, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PixelChangeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PixelChangeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PixelChange).
+func (m *PixelChangeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PixelChangeMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.position != nil {
+		fields = append(fields, pixelchange.FieldPosition)
+	}
+	if m.old_color != nil {
+		fields = append(fields, pixelchange.FieldOldColor)
+	}
+	if m.new_color != nil {
+		fields = append(fields, pixelchange.FieldNewColor)
+	}
+	if m.seq != nil {
+		fields = append(fields, pixelchange.FieldSeq)
+	}
+	if m.created_at != nil {
+		fields = append(fields, pixelchange.FieldCreatedAt)
+	}
+	if m.board != nil {
+		fields = append(fields, pixelchange.FieldBoardID)
+	}
+	if m.user != nil {
+		fields = append(fields, pixelchange.FieldUserID)
+	}
+	if m.team != nil {
+		fields = append(fields, pixelchange.FieldTeamID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PixelChangeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case pixelchange.FieldPosition:
+		return m.Position()
+	case pixelchange.FieldOldColor:
+		return m.OldColor()
+	case pixelchange.FieldNewColor:
+		return m.NewColor()
+	case pixelchange.FieldSeq:
+		return m.Seq()
+	case pixelchange.FieldCreatedAt:
+		return m.CreatedAt()
+	case pixelchange.FieldBoardID:
+		return m.BoardID()
+	case pixelchange.FieldUserID:
+		return m.UserID()
+	case pixelchange.FieldTeamID:
+		return m.TeamID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PixelChangeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case pixelchange.FieldPosition:
+		return m.OldPosition(ctx)
+	case pixelchange.FieldOldColor:
+		return m.OldOldColor(ctx)
+	case pixelchange.FieldNewColor:
+		return m.OldNewColor(ctx)
+	case pixelchange.FieldSeq:
+		return m.OldSeq(ctx)
+	case pixelchange.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case pixelchange.FieldBoardID:
+		return m.OldBoardID(ctx)
+	case pixelchange.FieldUserID:
+		return m.OldUserID(ctx)
+	case pixelchange.FieldTeamID:
+		return m.OldTeamID(ctx)
+	}
+	return nil, fmt.Errorf("unknown PixelChange field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PixelChangeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case pixelchange.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPosition(v)
+		return nil
+	case pixelchange.FieldOldColor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOldColor(v)
+		return nil
+	case pixelchange.FieldNewColor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNewColor(v)
+		return nil
+	case pixelchange.FieldSeq:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeq(v)
+		return nil
+	case pixelchange.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case pixelchange.FieldBoardID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBoardID(v)
+		return nil
+	case pixelchange.FieldUserID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case pixelchange.FieldTeamID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTeamID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PixelChange field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PixelChangeMutation) AddedFields() []string {
+	var fields []string
+	if m.addposition != nil {
+		fields = append(fields, pixelchange.FieldPosition)
+	}
+	if m.addseq != nil {
+		fields = append(fields, pixelchange.FieldSeq)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PixelChangeMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case pixelchange.FieldPosition:
+		return m.AddedPosition()
+	case pixelchange.FieldSeq:
+		return m.AddedSeq()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PixelChangeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case pixelchange.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPosition(v)
+		return nil
+	case pixelchange.FieldSeq:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSeq(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PixelChange numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PixelChangeMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(pixelchange.FieldUserID) {
+		fields = append(fields, pixelchange.FieldUserID)
+	}
+	if m.FieldCleared(pixelchange.FieldTeamID) {
+		fields = append(fields, pixelchange.FieldTeamID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PixelChangeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PixelChangeMutation) ClearField(name string) error {
+	switch name {
+	case pixelchange.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case pixelchange.FieldTeamID:
+		m.ClearTeamID()
+		return nil
+	}
+	return fmt.Errorf("unknown PixelChange nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PixelChangeMutation) ResetField(name string) error {
+	switch name {
+	case pixelchange.FieldPosition:
+		m.ResetPosition()
+		return nil
+	case pixelchange.FieldOldColor:
+		m.ResetOldColor()
+		return nil
+	case pixelchange.FieldNewColor:
+		m.ResetNewColor()
+		return nil
+	case pixelchange.FieldSeq:
+		m.ResetSeq()
+		return nil
+	case pixelchange.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case pixelchange.FieldBoardID:
+		m.ResetBoardID()
+		return nil
+	case pixelchange.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case pixelchange.FieldTeamID:
+		m.ResetTeamID()
+		return nil
+	}
+	return fmt.Errorf("unknown PixelChange field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PixelChangeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.pixel != nil {
+		edges = append(edges, pixelchange.EdgePixel)
+	}
+	if m.board != nil {
+		edges = append(edges, pixelchange.EdgeBoard)
+	}
+	if m.user != nil {
+		edges = append(edges, pixelchange.EdgeUser)
+	}
+	if m.team != nil {
+		edges = append(edges, pixelchange.EdgeTeam)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PixelChangeMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case pixelchange.EdgePixel:
+		if id := m.pixel; id != nil {
+			return []ent.Value{*id}
+		}
+	case pixelchange.EdgeBoard:
+		if id := m.board; id != nil {
+			return []ent.Value{*id}
+		}
+	case pixelchange.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case pixelchange.EdgeTeam:
+		if id := m.team; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PixelChangeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PixelChangeMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PixelChangeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedpixel {
+		edges = append(edges, pixelchange.EdgePixel)
+	}
+	if m.clearedboard {
+		edges = append(edges, pixelchange.EdgeBoard)
+	}
+	if m.cleareduser {
+		edges = append(edges, pixelchange.EdgeUser)
+	}
+	if m.clearedteam {
+		edges = append(edges, pixelchange.EdgeTeam)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PixelChangeMutation) EdgeCleared(name string) bool {
+	switch name {
+	case pixelchange.EdgePixel:
+		return m.clearedpixel
+	case pixelchange.EdgeBoard:
+		return m.clearedboard
+	case pixelchange.EdgeUser:
+		return m.cleareduser
+	case pixelchange.EdgeTeam:
+		return m.clearedteam
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PixelChangeMutation) ClearEdge(name string) error {
+	switch name {
+	case pixelchange.EdgePixel:
+		m.ClearPixel()
+		return nil
+	case pixelchange.EdgeBoard:
+		m.ClearBoard()
+		return nil
+	case pixelchange.EdgeUser:
+		m.ClearUser()
+		return nil
+	case pixelchange.EdgeTeam:
+		m.ClearTeam()
+		return nil
+	}
+	return fmt.Errorf("unknown PixelChange unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PixelChangeMutation) ResetEdge(name string) error {
+	switch name {
+	case pixelchange.EdgePixel:
+		m.ResetPixel()
+		return nil
+	case pixelchange.EdgeBoard:
+		m.ResetBoard()
+		return nil
+	case pixelchange.EdgeUser:
+		m.ResetUser()
+		return nil
+	case pixelchange.EdgeTeam:
+		m.ResetTeam()
+		return nil
+	}
+	return fmt.Errorf("unknown PixelChange edge %s", name)
+}
+
+// RegionMutation represents an operation that mutates the Region nodes in the graph.
+type RegionMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	name           *string
+	x              *int
+	addx           *int
+	y              *int
+	addy           *int
+	width          *int
+	addwidth       *int
+	height         *int
+	addheight      *int
+	mask           *string
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	board          *int
+	clearedboard   bool
+	creator        *int64
+	clearedcreator bool
+	done           bool
+	oldValue       func(context.Context) (*Region, error)
+	predicates     []predicate.Region
+}
+
+var _ ent.Mutation = (*RegionMutation)(nil)
+
+// regionOption allows management of the mutation configuration using functional options.
+type regionOption func(*RegionMutation)
+
+// newRegionMutation creates new mutation for the Region entity.
+func newRegionMutation(c config, op Op, opts ...regionOption) *RegionMutation {
+	m := &RegionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRegion,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRegionID sets the ID field of the mutation.
+func withRegionID(id int) regionOption {
+	return func(m *RegionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Region
+		)
+		m.oldValue = func(ctx context.Context) (*Region, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Region.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRegion sets the old Region of the mutation.
+func withRegion(node *Region) regionOption {
+	return func(m *RegionMutation) {
+		m.oldValue = func(context.Context) (*Region, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RegionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RegionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RegionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RegionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Region.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *RegionMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *RegionMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Region entity.
+// If the Region object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegionMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *RegionMutation) ResetName() {
+	m.name = nil
+}
+
+// SetX sets the "x" field.
+func (m *RegionMutation) SetX(i int) {
+	m.x = &i
+	m.addx = nil
+}
+
+// X returns the value of the "x" field in the mutation.
+func (m *RegionMutation) X() (r int, exists bool) {
+	v := m.x
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldX returns the old "x" field's value of the Region entity.
+// If the Region object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegionMutation) OldX(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldX is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldX requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldX: %w", err)
+	}
+	return oldValue.X, nil
+}
+
+// AddX adds i to the "x" field.
+func (m *RegionMutation) AddX(i int) {
+	if m.addx != nil {
+		*m.addx += i
+	} else {
+		m.addx = &i
+	}
+}
+
+// AddedX returns the value that was added to the "x" field in this mutation.
+func (m *RegionMutation) AddedX() (r int, exists bool) {
+	v := m.addx
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetX resets all changes to the "x" field.
+func (m *RegionMutation) ResetX() {
+	m.x = nil
+	m.addx = nil
+}
+
+// SetY sets the "y" field.
+func (m *RegionMutation) SetY(i int) {
+	m.y = &i
+	m.addy = nil
+}
+
+// Y returns the value of the "y" field in the mutation.
+func (m *RegionMutation) Y() (r int, exists bool) {
+	v := m.y
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldY returns the old "y" field's value of the Region entity.
+// If the Region object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegionMutation) OldY(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldY is only allowed on UpdateOne operations")
 	}
-	if m.hype_per_minute != nil {
-		fields = append(fields, hype.FieldHypePerMinute)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldY requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldY: %w", err)
+	}
+	return oldValue.Y, nil
+}
+
+// AddY adds i to the "y" field.
+func (m *RegionMutation) AddY(i int) {
+	if m.addy != nil {
+		*m.addy += i
+	} else {
+		m.addy = &i
+	}
+}
+
+// AddedY returns the value that was added to the "y" field in this mutation.
+func (m *RegionMutation) AddedY() (r int, exists bool) {
+	v := m.addy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetY resets all changes to the "y" field.
+func (m *RegionMutation) ResetY() {
+	m.y = nil
+	m.addy = nil
+}
+
+// SetWidth sets the "width" field.
+func (m *RegionMutation) SetWidth(i int) {
+	m.width = &i
+	m.addwidth = nil
+}
+
+// Width returns the value of the "width" field in the mutation.
+func (m *RegionMutation) Width() (r int, exists bool) {
+	v := m.width
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWidth returns the old "width" field's value of the Region entity.
+// If the Region object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegionMutation) OldWidth(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWidth is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWidth requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWidth: %w", err)
+	}
+	return oldValue.Width, nil
+}
+
+// AddWidth adds i to the "width" field.
+func (m *RegionMutation) AddWidth(i int) {
+	if m.addwidth != nil {
+		*m.addwidth += i
+	} else {
+		m.addwidth = &i
+	}
+}
+
+// AddedWidth returns the value that was added to the "width" field in this mutation.
+func (m *RegionMutation) AddedWidth() (r int, exists bool) {
+	v := m.addwidth
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetWidth resets all changes to the "width" field.
+func (m *RegionMutation) ResetWidth() {
+	m.width = nil
+	m.addwidth = nil
+}
+
+// SetHeight sets the "height" field.
+func (m *RegionMutation) SetHeight(i int) {
+	m.height = &i
+	m.addheight = nil
+}
+
+// Height returns the value of the "height" field in the mutation.
+func (m *RegionMutation) Height() (r int, exists bool) {
+	v := m.height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHeight returns the old "height" field's value of the Region entity.
+// If the Region object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegionMutation) OldHeight(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHeight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHeight: %w", err)
+	}
+	return oldValue.Height, nil
+}
+
+// AddHeight adds i to the "height" field.
+func (m *RegionMutation) AddHeight(i int) {
+	if m.addheight != nil {
+		*m.addheight += i
+	} else {
+		m.addheight = &i
+	}
+}
+
+// AddedHeight returns the value that was added to the "height" field in this mutation.
+func (m *RegionMutation) AddedHeight() (r int, exists bool) {
+	v := m.addheight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetHeight resets all changes to the "height" field.
+func (m *RegionMutation) ResetHeight() {
+	m.height = nil
+	m.addheight = nil
+}
+
+// SetMask sets the "mask" field.
+func (m *RegionMutation) SetMask(s string) {
+	m.mask = &s
+}
+
+// Mask returns the value of the "mask" field in the mutation.
+func (m *RegionMutation) Mask() (r string, exists bool) {
+	v := m.mask
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMask returns the old "mask" field's value of the Region entity.
+// If the Region object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegionMutation) OldMask(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMask is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMask requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMask: %w", err)
+	}
+	return oldValue.Mask, nil
+}
+
+// ClearMask clears the value of the "mask" field.
+func (m *RegionMutation) ClearMask() {
+	m.mask = nil
+	m.clearedFields[region.FieldMask] = struct{}{}
+}
+
+// MaskCleared returns if the "mask" field was cleared in this mutation.
+func (m *RegionMutation) MaskCleared() bool {
+	_, ok := m.clearedFields[region.FieldMask]
+	return ok
+}
+
+// ResetMask resets all changes to the "mask" field.
+func (m *RegionMutation) ResetMask() {
+	m.mask = nil
+	delete(m.clearedFields, region.FieldMask)
+}
+
+// SetBoardID sets the "board_id" field.
+func (m *RegionMutation) SetBoardID(i int) {
+	m.board = &i
+}
+
+// BoardID returns the value of the "board_id" field in the mutation.
+func (m *RegionMutation) BoardID() (r int, exists bool) {
+	v := m.board
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBoardID returns the old "board_id" field's value of the Region entity.
+// If the Region object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegionMutation) OldBoardID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBoardID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBoardID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBoardID: %w", err)
+	}
+	return oldValue.BoardID, nil
+}
+
+// ResetBoardID resets all changes to the "board_id" field.
+func (m *RegionMutation) ResetBoardID() {
+	m.board = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RegionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RegionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Region entity.
+// If the Region object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RegionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearBoard clears the "board" edge to the Board entity.
+func (m *RegionMutation) ClearBoard() {
+	m.clearedboard = true
+	m.clearedFields[region.FieldBoardID] = struct{}{}
+}
+
+// BoardCleared reports if the "board" edge to the Board entity was cleared.
+func (m *RegionMutation) BoardCleared() bool {
+	return m.clearedboard
+}
+
+// BoardIDs returns the "board" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BoardID instead. It exists only for internal usage by the builders.
+func (m *RegionMutation) BoardIDs() (ids []int) {
+	if id := m.board; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBoard resets all changes to the "board" edge.
+func (m *RegionMutation) ResetBoard() {
+	m.board = nil
+	m.clearedboard = false
+}
+
+// SetCreatorID sets the "creator" edge to the User entity by id.
+func (m *RegionMutation) SetCreatorID(id int64) {
+	m.creator = &id
+}
+
+// ClearCreator clears the "creator" edge to the User entity.
+func (m *RegionMutation) ClearCreator() {
+	m.clearedcreator = true
+}
+
+// CreatorCleared reports if the "creator" edge to the User entity was cleared.
+func (m *RegionMutation) CreatorCleared() bool {
+	return m.clearedcreator
+}
+
+// CreatorID returns the "creator" edge ID in the mutation.
+func (m *RegionMutation) CreatorID() (id int64, exists bool) {
+	if m.creator != nil {
+		return *m.creator, true
+	}
+	return
+}
+
+// CreatorIDs returns the "creator" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CreatorID instead. It exists only for internal usage by the builders.
+func (m *RegionMutation) CreatorIDs() (ids []int64) {
+	if id := m.creator; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCreator resets all changes to the "creator" edge.
+func (m *RegionMutation) ResetCreator() {
+	m.creator = nil
+	m.clearedcreator = false
+}
+
+// Where appends a list predicates to the RegionMutation builder.
+func (m *RegionMutation) Where(ps ...predicate.Region) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RegionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RegionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Region, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RegionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RegionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Region).
+func (m *RegionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RegionMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.name != nil {
+		fields = append(fields, region.FieldName)
+	}
+	if m.x != nil {
+		fields = append(fields, region.FieldX)
+	}
+	if m.y != nil {
+		fields = append(fields, region.FieldY)
+	}
+	if m.width != nil {
+		fields = append(fields, region.FieldWidth)
+	}
+	if m.height != nil {
+		fields = append(fields, region.FieldHeight)
+	}
+	if m.mask != nil {
+		fields = append(fields, region.FieldMask)
+	}
+	if m.board != nil {
+		fields = append(fields, region.FieldBoardID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, region.FieldCreatedAt)
 	}
 	return fields
 }
@@ -446,16 +6289,24 @@ func (m *HypeMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *HypeMutation) Field(name string) (ent.Value, bool) {
+func (m *RegionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case hype.FieldAmountRemaining:
-		return m.AmountRemaining()
-	case hype.FieldMaxHype:
-		return m.MaxHype()
-	case hype.FieldLastUpdatedAt:
-		return m.LastUpdatedAt()
-	case hype.FieldHypePerMinute:
-		return m.HypePerMinute()
+	case region.FieldName:
+		return m.Name()
+	case region.FieldX:
+		return m.X()
+	case region.FieldY:
+		return m.Y()
+	case region.FieldWidth:
+		return m.Width()
+	case region.FieldHeight:
+		return m.Height()
+	case region.FieldMask:
+		return m.Mask()
+	case region.FieldBoardID:
+		return m.BoardID()
+	case region.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -463,69 +6314,108 @@ func (m *HypeMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *HypeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *RegionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case hype.FieldAmountRemaining:
-		return m.OldAmountRemaining(ctx)
-	case hype.FieldMaxHype:
-		return m.OldMaxHype(ctx)
-	case hype.FieldLastUpdatedAt:
-		return m.OldLastUpdatedAt(ctx)
-	case hype.FieldHypePerMinute:
-		return m.OldHypePerMinute(ctx)
+	case region.FieldName:
+		return m.OldName(ctx)
+	case region.FieldX:
+		return m.OldX(ctx)
+	case region.FieldY:
+		return m.OldY(ctx)
+	case region.FieldWidth:
+		return m.OldWidth(ctx)
+	case region.FieldHeight:
+		return m.OldHeight(ctx)
+	case region.FieldMask:
+		return m.OldMask(ctx)
+	case region.FieldBoardID:
+		return m.OldBoardID(ctx)
+	case region.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Hype field %s", name)
+	return nil, fmt.Errorf("unknown Region field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *HypeMutation) SetField(name string, value ent.Value) error {
+func (m *RegionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case hype.FieldAmountRemaining:
+	case region.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case region.FieldX:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAmountRemaining(v)
+		m.SetX(v)
 		return nil
-	case hype.FieldMaxHype:
+	case region.FieldY:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMaxHype(v)
+		m.SetY(v)
 		return nil
-	case hype.FieldLastUpdatedAt:
-		v, ok := value.(time.Time)
+	case region.FieldWidth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWidth(v)
+		return nil
+	case region.FieldHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeight(v)
+		return nil
+	case region.FieldMask:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMask(v)
+		return nil
+	case region.FieldBoardID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLastUpdatedAt(v)
+		m.SetBoardID(v)
 		return nil
-	case hype.FieldHypePerMinute:
-		v, ok := value.(int)
+	case region.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetHypePerMinute(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Hype field %s", name)
+	return fmt.Errorf("unknown Region field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *HypeMutation) AddedFields() []string {
+func (m *RegionMutation) AddedFields() []string {
 	var fields []string
-	if m.addamount_remaining != nil {
-		fields = append(fields, hype.FieldAmountRemaining)
+	if m.addx != nil {
+		fields = append(fields, region.FieldX)
 	}
-	if m.addmax_hype != nil {
-		fields = append(fields, hype.FieldMaxHype)
+	if m.addy != nil {
+		fields = append(fields, region.FieldY)
 	}
-	if m.addhype_per_minute != nil {
-		fields = append(fields, hype.FieldHypePerMinute)
+	if m.addwidth != nil {
+		fields = append(fields, region.FieldWidth)
+	}
+	if m.addheight != nil {
+		fields = append(fields, region.FieldHeight)
 	}
 	return fields
 }
@@ -533,14 +6423,16 @@ func (m *HypeMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *HypeMutation) AddedField(name string) (ent.Value, bool) {
+func (m *RegionMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case hype.FieldAmountRemaining:
-		return m.AddedAmountRemaining()
-	case hype.FieldMaxHype:
-		return m.AddedMaxHype()
-	case hype.FieldHypePerMinute:
-		return m.AddedHypePerMinute()
+	case region.FieldX:
+		return m.AddedX()
+	case region.FieldY:
+		return m.AddedY()
+	case region.FieldWidth:
+		return m.AddedWidth()
+	case region.FieldHeight:
+		return m.AddedHeight()
 	}
 	return nil, false
 }
@@ -548,87 +6440,122 @@ func (m *HypeMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *HypeMutation) AddField(name string, value ent.Value) error {
+func (m *RegionMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case hype.FieldAmountRemaining:
+	case region.FieldX:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddAmountRemaining(v)
+		m.AddX(v)
 		return nil
-	case hype.FieldMaxHype:
+	case region.FieldY:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddMaxHype(v)
+		m.AddY(v)
 		return nil
-	case hype.FieldHypePerMinute:
+	case region.FieldWidth:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddHypePerMinute(v)
+		m.AddWidth(v)
+		return nil
+	case region.FieldHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHeight(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Hype numeric field %s", name)
+	return fmt.Errorf("unknown Region numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *HypeMutation) ClearedFields() []string {
-	return nil
+func (m *RegionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(region.FieldMask) {
+		fields = append(fields, region.FieldMask)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *HypeMutation) FieldCleared(name string) bool {
+func (m *RegionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *HypeMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Hype nullable field %s", name)
+func (m *RegionMutation) ClearField(name string) error {
+	switch name {
+	case region.FieldMask:
+		m.ClearMask()
+		return nil
+	}
+	return fmt.Errorf("unknown Region nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *HypeMutation) ResetField(name string) error {
+func (m *RegionMutation) ResetField(name string) error {
 	switch name {
-	case hype.FieldAmountRemaining:
-		m.ResetAmountRemaining()
+	case region.FieldName:
+		m.ResetName()
 		return nil
-	case hype.FieldMaxHype:
-		m.ResetMaxHype()
+	case region.FieldX:
+		m.ResetX()
 		return nil
-	case hype.FieldLastUpdatedAt:
-		m.ResetLastUpdatedAt()
+	case region.FieldY:
+		m.ResetY()
 		return nil
-	case hype.FieldHypePerMinute:
-		m.ResetHypePerMinute()
+	case region.FieldWidth:
+		m.ResetWidth()
+		return nil
+	case region.FieldHeight:
+		m.ResetHeight()
+		return nil
+	case region.FieldMask:
+		m.ResetMask()
+		return nil
+	case region.FieldBoardID:
+		m.ResetBoardID()
+		return nil
+	case region.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Hype field %s", name)
+	return fmt.Errorf("unknown Region field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *HypeMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.user != nil {
-		edges = append(edges, hype.EdgeUser)
+func (m *RegionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.board != nil {
+		edges = append(edges, region.EdgeBoard)
+	}
+	if m.creator != nil {
+		edges = append(edges, region.EdgeCreator)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *HypeMutation) AddedIDs(name string) []ent.Value {
+func (m *RegionMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case hype.EdgeUser:
-		if id := m.user; id != nil {
+	case region.EdgeBoard:
+		if id := m.board; id != nil {
+			return []ent.Value{*id}
+		}
+	case region.EdgeCreator:
+		if id := m.creator; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -636,85 +6563,104 @@ func (m *HypeMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *HypeMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *RegionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *HypeMutation) RemovedIDs(name string) []ent.Value {
+func (m *RegionMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *HypeMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.cleareduser {
-		edges = append(edges, hype.EdgeUser)
+func (m *RegionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedboard {
+		edges = append(edges, region.EdgeBoard)
+	}
+	if m.clearedcreator {
+		edges = append(edges, region.EdgeCreator)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *HypeMutation) EdgeCleared(name string) bool {
+func (m *RegionMutation) EdgeCleared(name string) bool {
 	switch name {
-	case hype.EdgeUser:
-		return m.cleareduser
+	case region.EdgeBoard:
+		return m.clearedboard
+	case region.EdgeCreator:
+		return m.clearedcreator
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *HypeMutation) ClearEdge(name string) error {
+func (m *RegionMutation) ClearEdge(name string) error {
 	switch name {
-	case hype.EdgeUser:
-		m.ClearUser()
+	case region.EdgeBoard:
+		m.ClearBoard()
+		return nil
+	case region.EdgeCreator:
+		m.ClearCreator()
 		return nil
 	}
-	return fmt.Errorf("unknown Hype unique edge %s", name)
+	return fmt.Errorf("unknown Region unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *HypeMutation) ResetEdge(name string) error {
+func (m *RegionMutation) ResetEdge(name string) error {
 	switch name {
-	case hype.EdgeUser:
-		m.ResetUser()
+	case region.EdgeBoard:
+		m.ResetBoard()
+		return nil
+	case region.EdgeCreator:
+		m.ResetCreator()
 		return nil
 	}
-	return fmt.Errorf("unknown Hype edge %s", name)
+	return fmt.Errorf("unknown Region edge %s", name)
 }
 
-// PixelMutation represents an operation that mutates the Pixel nodes in the graph.
-type PixelMutation struct {
+// TeamMutation represents an operation that mutates the Team nodes in the graph.
+type TeamMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	color         *string
-	updated_at    *time.Time
-	clearedFields map[string]struct{}
-	user          *int64
-	cleareduser   bool
-	done          bool
-	oldValue      func(context.Context) (*Pixel, error)
-	predicates    []predicate.Pixel
-}
-
-var _ ent.Mutation = (*PixelMutation)(nil)
-
-// pixelOption allows management of the mutation configuration using functional options.
-type pixelOption func(*PixelMutation)
-
-// newPixelMutation creates new mutation for the Pixel entity.
-func newPixelMutation(c config, op Op, opts ...pixelOption) *PixelMutation {
-	m := &PixelMutation{
+	op             Op
+	typ            string
+	id             *int
+	name           *string
+	invite_code    *string
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	members        map[int64]struct{}
+	removedmembers map[int64]struct{}
+	clearedmembers bool
+	pixels         map[int]struct{}
+	removedpixels  map[int]struct{}
+	clearedpixels  bool
+	changes        map[int]struct{}
+	removedchanges map[int]struct{}
+	clearedchanges bool
+	done           bool
+	oldValue       func(context.Context) (*Team, error)
+	predicates     []predicate.Team
+}
+
+var _ ent.Mutation = (*TeamMutation)(nil)
+
+// teamOption allows management of the mutation configuration using functional options.
+type teamOption func(*TeamMutation)
+
+// newTeamMutation creates new mutation for the Team entity.
+func newTeamMutation(c config, op Op, opts ...teamOption) *TeamMutation {
+	m := &TeamMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePixel,
+		typ:           TypeTeam,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -723,20 +6669,20 @@ func newPixelMutation(c config, op Op, opts ...pixelOption) *PixelMutation {
 	return m
 }
 
-// withPixelID sets the ID field of the mutation.
-func withPixelID(id int) pixelOption {
-	return func(m *PixelMutation) {
+// withTeamID sets the ID field of the mutation.
+func withTeamID(id int) teamOption {
+	return func(m *TeamMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Pixel
+			value *Team
 		)
-		m.oldValue = func(ctx context.Context) (*Pixel, error) {
+		m.oldValue = func(ctx context.Context) (*Team, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Pixel.Get(ctx, id)
+					value, err = m.Client().Team.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -745,10 +6691,10 @@ func withPixelID(id int) pixelOption {
 	}
 }
 
-// withPixel sets the old Pixel of the mutation.
-func withPixel(node *Pixel) pixelOption {
-	return func(m *PixelMutation) {
-		m.oldValue = func(context.Context) (*Pixel, error) {
+// withTeam sets the old Team of the mutation.
+func withTeam(node *Team) teamOption {
+	return func(m *TeamMutation) {
+		m.oldValue = func(context.Context) (*Team, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -757,7 +6703,7 @@ func withPixel(node *Pixel) pixelOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PixelMutation) Client() *Client {
+func (m TeamMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -765,7 +6711,7 @@ func (m PixelMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PixelMutation) Tx() (*Tx, error) {
+func (m TeamMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -774,15 +6720,9 @@ func (m PixelMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Pixel entities.
-func (m *PixelMutation) SetID(id int) {
-	m.id = &id
-}
-
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PixelMutation) ID() (id int, exists bool) {
+func (m *TeamMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -793,7 +6733,7 @@ func (m *PixelMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PixelMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *TeamMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -802,132 +6742,291 @@ func (m *PixelMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Pixel.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Team.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetColor sets the "color" field.
-func (m *PixelMutation) SetColor(s string) {
-	m.color = &s
+// SetName sets the "name" field.
+func (m *TeamMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Color returns the value of the "color" field in the mutation.
-func (m *PixelMutation) Color() (r string, exists bool) {
-	v := m.color
+// Name returns the value of the "name" field in the mutation.
+func (m *TeamMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldColor returns the old "color" field's value of the Pixel entity.
-// If the Pixel object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PixelMutation) OldColor(ctx context.Context) (v string, err error) {
+func (m *TeamMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldColor is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldColor requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldColor: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Color, nil
+	return oldValue.Name, nil
 }
 
-// ResetColor resets all changes to the "color" field.
-func (m *PixelMutation) ResetColor() {
-	m.color = nil
+// ResetName resets all changes to the "name" field.
+func (m *TeamMutation) ResetName() {
+	m.name = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *PixelMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetInviteCode sets the "invite_code" field.
+func (m *TeamMutation) SetInviteCode(s string) {
+	m.invite_code = &s
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *PixelMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// InviteCode returns the value of the "invite_code" field in the mutation.
+func (m *TeamMutation) InviteCode() (r string, exists bool) {
+	v := m.invite_code
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Pixel entity.
-// If the Pixel object wasn't provided to the builder, the object is fetched from the database.
+// OldInviteCode returns the old "invite_code" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PixelMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *TeamMutation) OldInviteCode(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldInviteCode is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldInviteCode requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldInviteCode: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.InviteCode, nil
+}
+
+// ResetInviteCode resets all changes to the "invite_code" field.
+func (m *TeamMutation) ResetInviteCode() {
+	m.invite_code = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TeamMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TeamMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TeamMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// AddMemberIDs adds the "members" edge to the User entity by ids.
+func (m *TeamMutation) AddMemberIDs(ids ...int64) {
+	if m.members == nil {
+		m.members = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.members[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMembers clears the "members" edge to the User entity.
+func (m *TeamMutation) ClearMembers() {
+	m.clearedmembers = true
+}
+
+// MembersCleared reports if the "members" edge to the User entity was cleared.
+func (m *TeamMutation) MembersCleared() bool {
+	return m.clearedmembers
+}
+
+// RemoveMemberIDs removes the "members" edge to the User entity by IDs.
+func (m *TeamMutation) RemoveMemberIDs(ids ...int64) {
+	if m.removedmembers == nil {
+		m.removedmembers = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.members, ids[i])
+		m.removedmembers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMembers returns the removed IDs of the "members" edge to the User entity.
+func (m *TeamMutation) RemovedMembersIDs() (ids []int64) {
+	for id := range m.removedmembers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MembersIDs returns the "members" edge IDs in the mutation.
+func (m *TeamMutation) MembersIDs() (ids []int64) {
+	for id := range m.members {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMembers resets all changes to the "members" edge.
+func (m *TeamMutation) ResetMembers() {
+	m.members = nil
+	m.clearedmembers = false
+	m.removedmembers = nil
+}
+
+// AddPixelIDs adds the "pixels" edge to the Pixel entity by ids.
+func (m *TeamMutation) AddPixelIDs(ids ...int) {
+	if m.pixels == nil {
+		m.pixels = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.pixels[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPixels clears the "pixels" edge to the Pixel entity.
+func (m *TeamMutation) ClearPixels() {
+	m.clearedpixels = true
+}
+
+// PixelsCleared reports if the "pixels" edge to the Pixel entity was cleared.
+func (m *TeamMutation) PixelsCleared() bool {
+	return m.clearedpixels
+}
+
+// RemovePixelIDs removes the "pixels" edge to the Pixel entity by IDs.
+func (m *TeamMutation) RemovePixelIDs(ids ...int) {
+	if m.removedpixels == nil {
+		m.removedpixels = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.pixels, ids[i])
+		m.removedpixels[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPixels returns the removed IDs of the "pixels" edge to the Pixel entity.
+func (m *TeamMutation) RemovedPixelsIDs() (ids []int) {
+	for id := range m.removedpixels {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PixelsIDs returns the "pixels" edge IDs in the mutation.
+func (m *TeamMutation) PixelsIDs() (ids []int) {
+	for id := range m.pixels {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPixels resets all changes to the "pixels" edge.
+func (m *TeamMutation) ResetPixels() {
+	m.pixels = nil
+	m.clearedpixels = false
+	m.removedpixels = nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *PixelMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// AddChangeIDs adds the "changes" edge to the PixelChange entity by ids.
+func (m *TeamMutation) AddChangeIDs(ids ...int) {
+	if m.changes == nil {
+		m.changes = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.changes[ids[i]] = struct{}{}
+	}
 }
 
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *PixelMutation) SetUserID(id int64) {
-	m.user = &id
+// ClearChanges clears the "changes" edge to the PixelChange entity.
+func (m *TeamMutation) ClearChanges() {
+	m.clearedchanges = true
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *PixelMutation) ClearUser() {
-	m.cleareduser = true
+// ChangesCleared reports if the "changes" edge to the PixelChange entity was cleared.
+func (m *TeamMutation) ChangesCleared() bool {
+	return m.clearedchanges
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *PixelMutation) UserCleared() bool {
-	return m.cleareduser
+// RemoveChangeIDs removes the "changes" edge to the PixelChange entity by IDs.
+func (m *TeamMutation) RemoveChangeIDs(ids ...int) {
+	if m.removedchanges == nil {
+		m.removedchanges = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.changes, ids[i])
+		m.removedchanges[ids[i]] = struct{}{}
+	}
 }
 
-// UserID returns the "user" edge ID in the mutation.
-func (m *PixelMutation) UserID() (id int64, exists bool) {
-	if m.user != nil {
-		return *m.user, true
+// RemovedChanges returns the removed IDs of the "changes" edge to the PixelChange entity.
+func (m *TeamMutation) RemovedChangesIDs() (ids []int) {
+	for id := range m.removedchanges {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *PixelMutation) UserIDs() (ids []int64) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// ChangesIDs returns the "changes" edge IDs in the mutation.
+func (m *TeamMutation) ChangesIDs() (ids []int) {
+	for id := range m.changes {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *PixelMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// ResetChanges resets all changes to the "changes" edge.
+func (m *TeamMutation) ResetChanges() {
+	m.changes = nil
+	m.clearedchanges = false
+	m.removedchanges = nil
 }
 
-// Where appends a list predicates to the PixelMutation builder.
-func (m *PixelMutation) Where(ps ...predicate.Pixel) {
+// Where appends a list predicates to the TeamMutation builder.
+func (m *TeamMutation) Where(ps ...predicate.Team) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PixelMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TeamMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PixelMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Pixel, len(ps))
+func (m *TeamMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Team, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -935,30 +7034,33 @@ func (m *PixelMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PixelMutation) Op() Op {
+func (m *TeamMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PixelMutation) SetOp(op Op) {
+func (m *TeamMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Pixel).
-func (m *PixelMutation) Type() string {
+// Type returns the node type of this mutation (Team).
+func (m *TeamMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PixelMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.color != nil {
-		fields = append(fields, pixel.FieldColor)
+func (m *TeamMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.name != nil {
+		fields = append(fields, team.FieldName)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, pixel.FieldUpdatedAt)
+	if m.invite_code != nil {
+		fields = append(fields, team.FieldInviteCode)
+	}
+	if m.created_at != nil {
+		fields = append(fields, team.FieldCreatedAt)
 	}
 	return fields
 }
@@ -966,12 +7068,14 @@ func (m *PixelMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PixelMutation) Field(name string) (ent.Value, bool) {
+func (m *TeamMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case pixel.FieldColor:
-		return m.Color()
-	case pixel.FieldUpdatedAt:
-		return m.UpdatedAt()
+	case team.FieldName:
+		return m.Name()
+	case team.FieldInviteCode:
+		return m.InviteCode()
+	case team.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -979,185 +7083,279 @@ func (m *PixelMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PixelMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TeamMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case pixel.FieldColor:
-		return m.OldColor(ctx)
-	case pixel.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+	case team.FieldName:
+		return m.OldName(ctx)
+	case team.FieldInviteCode:
+		return m.OldInviteCode(ctx)
+	case team.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Pixel field %s", name)
+	return nil, fmt.Errorf("unknown Team field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PixelMutation) SetField(name string, value ent.Value) error {
+func (m *TeamMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case pixel.FieldColor:
+	case team.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetColor(v)
+		m.SetName(v)
 		return nil
-	case pixel.FieldUpdatedAt:
+	case team.FieldInviteCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInviteCode(v)
+		return nil
+	case team.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Pixel field %s", name)
+	return fmt.Errorf("unknown Team field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PixelMutation) AddedFields() []string {
+func (m *TeamMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PixelMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TeamMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PixelMutation) AddField(name string, value ent.Value) error {
+func (m *TeamMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Pixel numeric field %s", name)
+	return fmt.Errorf("unknown Team numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PixelMutation) ClearedFields() []string {
+func (m *TeamMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PixelMutation) FieldCleared(name string) bool {
+func (m *TeamMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PixelMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Pixel nullable field %s", name)
+func (m *TeamMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Team nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PixelMutation) ResetField(name string) error {
+func (m *TeamMutation) ResetField(name string) error {
 	switch name {
-	case pixel.FieldColor:
-		m.ResetColor()
+	case team.FieldName:
+		m.ResetName()
 		return nil
-	case pixel.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case team.FieldInviteCode:
+		m.ResetInviteCode()
+		return nil
+	case team.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Pixel field %s", name)
+	return fmt.Errorf("unknown Team field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PixelMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.user != nil {
-		edges = append(edges, pixel.EdgeUser)
+func (m *TeamMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.members != nil {
+		edges = append(edges, team.EdgeMembers)
+	}
+	if m.pixels != nil {
+		edges = append(edges, team.EdgePixels)
+	}
+	if m.changes != nil {
+		edges = append(edges, team.EdgeChanges)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PixelMutation) AddedIDs(name string) []ent.Value {
+func (m *TeamMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case pixel.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
+	case team.EdgeMembers:
+		ids := make([]ent.Value, 0, len(m.members))
+		for id := range m.members {
+			ids = append(ids, id)
+		}
+		return ids
+	case team.EdgePixels:
+		ids := make([]ent.Value, 0, len(m.pixels))
+		for id := range m.pixels {
+			ids = append(ids, id)
 		}
+		return ids
+	case team.EdgeChanges:
+		ids := make([]ent.Value, 0, len(m.changes))
+		for id := range m.changes {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PixelMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *TeamMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedmembers != nil {
+		edges = append(edges, team.EdgeMembers)
+	}
+	if m.removedpixels != nil {
+		edges = append(edges, team.EdgePixels)
+	}
+	if m.removedchanges != nil {
+		edges = append(edges, team.EdgeChanges)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PixelMutation) RemovedIDs(name string) []ent.Value {
+func (m *TeamMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case team.EdgeMembers:
+		ids := make([]ent.Value, 0, len(m.removedmembers))
+		for id := range m.removedmembers {
+			ids = append(ids, id)
+		}
+		return ids
+	case team.EdgePixels:
+		ids := make([]ent.Value, 0, len(m.removedpixels))
+		for id := range m.removedpixels {
+			ids = append(ids, id)
+		}
+		return ids
+	case team.EdgeChanges:
+		ids := make([]ent.Value, 0, len(m.removedchanges))
+		for id := range m.removedchanges {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PixelMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.cleareduser {
-		edges = append(edges, pixel.EdgeUser)
+func (m *TeamMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedmembers {
+		edges = append(edges, team.EdgeMembers)
+	}
+	if m.clearedpixels {
+		edges = append(edges, team.EdgePixels)
+	}
+	if m.clearedchanges {
+		edges = append(edges, team.EdgeChanges)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PixelMutation) EdgeCleared(name string) bool {
+func (m *TeamMutation) EdgeCleared(name string) bool {
 	switch name {
-	case pixel.EdgeUser:
-		return m.cleareduser
+	case team.EdgeMembers:
+		return m.clearedmembers
+	case team.EdgePixels:
+		return m.clearedpixels
+	case team.EdgeChanges:
+		return m.clearedchanges
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PixelMutation) ClearEdge(name string) error {
+func (m *TeamMutation) ClearEdge(name string) error {
 	switch name {
-	case pixel.EdgeUser:
-		m.ClearUser()
-		return nil
 	}
-	return fmt.Errorf("unknown Pixel unique edge %s", name)
+	return fmt.Errorf("unknown Team unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PixelMutation) ResetEdge(name string) error {
+func (m *TeamMutation) ResetEdge(name string) error {
 	switch name {
-	case pixel.EdgeUser:
-		m.ResetUser()
+	case team.EdgeMembers:
+		m.ResetMembers()
+		return nil
+	case team.EdgePixels:
+		m.ResetPixels()
+		return nil
+	case team.EdgeChanges:
+		m.ResetChanges()
 		return nil
 	}
-	return fmt.Errorf("unknown Pixel edge %s", name)
+	return fmt.Errorf("unknown Team edge %s", name)
 }
 
 // UserMutation represents an operation that mutates the User nodes in the graph.
 type UserMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int64
-	display_name  *string
-	game_id       *string
-	clearedFields map[string]struct{}
-	pixels        map[int]struct{}
-	removedpixels map[int]struct{}
-	clearedpixels bool
-	hype          *int
-	clearedhype   bool
-	done          bool
-	oldValue      func(context.Context) (*User, error)
-	predicates    []predicate.User
+	op                      Op
+	typ                     string
+	id                      *int64
+	display_name            *string
+	game_id                 *string
+	role                    *user.Role
+	banned_at               *time.Time
+	ban_reason              *string
+	clearedFields           map[string]struct{}
+	pixels                  map[int]struct{}
+	removedpixels           map[int]struct{}
+	clearedpixels           bool
+	hype                    *int
+	clearedhype             bool
+	boards                  map[int]struct{}
+	removedboards           map[int]struct{}
+	clearedboards           bool
+	changes                 map[int]struct{}
+	removedchanges          map[int]struct{}
+	clearedchanges          bool
+	hype_ledger             map[int]struct{}
+	removedhype_ledger      map[int]struct{}
+	clearedhype_ledger      bool
+	hype_adjustments        map[int]struct{}
+	removedhype_adjustments map[int]struct{}
+	clearedhype_adjustments bool
+	regions                 map[int]struct{}
+	removedregions          map[int]struct{}
+	clearedregions          bool
+	team                    *int
+	clearedteam             bool
+	done                    bool
+	oldValue                func(context.Context) (*User, error)
+	predicates              []predicate.User
 }
 
 var _ ent.Mutation = (*UserMutation)(nil)
@@ -1319,21 +7517,204 @@ func (m *UserMutation) GameID() (r string, exists bool) {
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
 func (m *UserMutation) OldGameID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGameID is only allowed on UpdateOne operations")
+		return v, errors.New("OldGameID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGameID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGameID: %w", err)
+	}
+	return oldValue.GameID, nil
+}
+
+// ResetGameID resets all changes to the "game_id" field.
+func (m *UserMutation) ResetGameID() {
+	m.game_id = nil
+}
+
+// SetTeamID sets the "team_id" field.
+func (m *UserMutation) SetTeamID(i int) {
+	m.team = &i
+}
+
+// TeamID returns the value of the "team_id" field in the mutation.
+func (m *UserMutation) TeamID() (r int, exists bool) {
+	v := m.team
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTeamID returns the old "team_id" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldTeamID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTeamID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTeamID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTeamID: %w", err)
+	}
+	return oldValue.TeamID, nil
+}
+
+// ClearTeamID clears the value of the "team_id" field.
+func (m *UserMutation) ClearTeamID() {
+	m.team = nil
+	m.clearedFields[user.FieldTeamID] = struct{}{}
+}
+
+// TeamIDCleared returns if the "team_id" field was cleared in this mutation.
+func (m *UserMutation) TeamIDCleared() bool {
+	_, ok := m.clearedFields[user.FieldTeamID]
+	return ok
+}
+
+// ResetTeamID resets all changes to the "team_id" field.
+func (m *UserMutation) ResetTeamID() {
+	m.team = nil
+	delete(m.clearedFields, user.FieldTeamID)
+}
+
+// SetRole sets the "role" field.
+func (m *UserMutation) SetRole(u user.Role) {
+	m.role = &u
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *UserMutation) Role() (r user.Role, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRole returns the old "role" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldRole(ctx context.Context) (v user.Role, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	}
+	return oldValue.Role, nil
+}
+
+// ResetRole resets all changes to the "role" field.
+func (m *UserMutation) ResetRole() {
+	m.role = nil
+}
+
+// SetBannedAt sets the "banned_at" field.
+func (m *UserMutation) SetBannedAt(t time.Time) {
+	m.banned_at = &t
+}
+
+// BannedAt returns the value of the "banned_at" field in the mutation.
+func (m *UserMutation) BannedAt() (r time.Time, exists bool) {
+	v := m.banned_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBannedAt returns the old "banned_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldBannedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBannedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBannedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBannedAt: %w", err)
+	}
+	return oldValue.BannedAt, nil
+}
+
+// ClearBannedAt clears the value of the "banned_at" field.
+func (m *UserMutation) ClearBannedAt() {
+	m.banned_at = nil
+	m.clearedFields[user.FieldBannedAt] = struct{}{}
+}
+
+// BannedAtCleared returns if the "banned_at" field was cleared in this mutation.
+func (m *UserMutation) BannedAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldBannedAt]
+	return ok
+}
+
+// ResetBannedAt resets all changes to the "banned_at" field.
+func (m *UserMutation) ResetBannedAt() {
+	m.banned_at = nil
+	delete(m.clearedFields, user.FieldBannedAt)
+}
+
+// SetBanReason sets the "ban_reason" field.
+func (m *UserMutation) SetBanReason(s string) {
+	m.ban_reason = &s
+}
+
+// BanReason returns the value of the "ban_reason" field in the mutation.
+func (m *UserMutation) BanReason() (r string, exists bool) {
+	v := m.ban_reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBanReason returns the old "ban_reason" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldBanReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBanReason is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGameID requires an ID field in the mutation")
+		return v, errors.New("OldBanReason requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGameID: %w", err)
+		return v, fmt.Errorf("querying old value for OldBanReason: %w", err)
 	}
-	return oldValue.GameID, nil
+	return oldValue.BanReason, nil
 }
 
-// ResetGameID resets all changes to the "game_id" field.
-func (m *UserMutation) ResetGameID() {
-	m.game_id = nil
+// ClearBanReason clears the value of the "ban_reason" field.
+func (m *UserMutation) ClearBanReason() {
+	m.ban_reason = nil
+	m.clearedFields[user.FieldBanReason] = struct{}{}
+}
+
+// BanReasonCleared returns if the "ban_reason" field was cleared in this mutation.
+func (m *UserMutation) BanReasonCleared() bool {
+	_, ok := m.clearedFields[user.FieldBanReason]
+	return ok
+}
+
+// ResetBanReason resets all changes to the "ban_reason" field.
+func (m *UserMutation) ResetBanReason() {
+	m.ban_reason = nil
+	delete(m.clearedFields, user.FieldBanReason)
 }
 
 // AddPixelIDs adds the "pixels" edge to the Pixel entity by ids.
@@ -1429,6 +7810,303 @@ func (m *UserMutation) ResetHype() {
 	m.clearedhype = false
 }
 
+// AddBoardIDs adds the "boards" edge to the Board entity by ids.
+func (m *UserMutation) AddBoardIDs(ids ...int) {
+	if m.boards == nil {
+		m.boards = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.boards[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBoards clears the "boards" edge to the Board entity.
+func (m *UserMutation) ClearBoards() {
+	m.clearedboards = true
+}
+
+// BoardsCleared reports if the "boards" edge to the Board entity was cleared.
+func (m *UserMutation) BoardsCleared() bool {
+	return m.clearedboards
+}
+
+// RemoveBoardIDs removes the "boards" edge to the Board entity by IDs.
+func (m *UserMutation) RemoveBoardIDs(ids ...int) {
+	if m.removedboards == nil {
+		m.removedboards = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.boards, ids[i])
+		m.removedboards[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBoards returns the removed IDs of the "boards" edge to the Board entity.
+func (m *UserMutation) RemovedBoardsIDs() (ids []int) {
+	for id := range m.removedboards {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BoardsIDs returns the "boards" edge IDs in the mutation.
+func (m *UserMutation) BoardsIDs() (ids []int) {
+	for id := range m.boards {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBoards resets all changes to the "boards" edge.
+func (m *UserMutation) ResetBoards() {
+	m.boards = nil
+	m.clearedboards = false
+	m.removedboards = nil
+}
+
+// AddChangeIDs adds the "changes" edge to the PixelChange entity by ids.
+func (m *UserMutation) AddChangeIDs(ids ...int) {
+	if m.changes == nil {
+		m.changes = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.changes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChanges clears the "changes" edge to the PixelChange entity.
+func (m *UserMutation) ClearChanges() {
+	m.clearedchanges = true
+}
+
+// ChangesCleared reports if the "changes" edge to the PixelChange entity was cleared.
+func (m *UserMutation) ChangesCleared() bool {
+	return m.clearedchanges
+}
+
+// RemoveChangeIDs removes the "changes" edge to the PixelChange entity by IDs.
+func (m *UserMutation) RemoveChangeIDs(ids ...int) {
+	if m.removedchanges == nil {
+		m.removedchanges = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.changes, ids[i])
+		m.removedchanges[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChanges returns the removed IDs of the "changes" edge to the PixelChange entity.
+func (m *UserMutation) RemovedChangesIDs() (ids []int) {
+	for id := range m.removedchanges {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChangesIDs returns the "changes" edge IDs in the mutation.
+func (m *UserMutation) ChangesIDs() (ids []int) {
+	for id := range m.changes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChanges resets all changes to the "changes" edge.
+func (m *UserMutation) ResetChanges() {
+	m.changes = nil
+	m.clearedchanges = false
+	m.removedchanges = nil
+}
+
+// AddHypeLedgerIDs adds the "hype_ledger" edge to the HypeLedger entity by ids.
+func (m *UserMutation) AddHypeLedgerIDs(ids ...int) {
+	if m.hype_ledger == nil {
+		m.hype_ledger = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.hype_ledger[ids[i]] = struct{}{}
+	}
+}
+
+// ClearHypeLedger clears the "hype_ledger" edge to the HypeLedger entity.
+func (m *UserMutation) ClearHypeLedger() {
+	m.clearedhype_ledger = true
+}
+
+// HypeLedgerCleared reports if the "hype_ledger" edge to the HypeLedger entity was cleared.
+func (m *UserMutation) HypeLedgerCleared() bool {
+	return m.clearedhype_ledger
+}
+
+// RemoveHypeLedgerIDs removes the "hype_ledger" edge to the HypeLedger entity by IDs.
+func (m *UserMutation) RemoveHypeLedgerIDs(ids ...int) {
+	if m.removedhype_ledger == nil {
+		m.removedhype_ledger = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.hype_ledger, ids[i])
+		m.removedhype_ledger[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedHypeLedger returns the removed IDs of the "hype_ledger" edge to the HypeLedger entity.
+func (m *UserMutation) RemovedHypeLedgerIDs() (ids []int) {
+	for id := range m.removedhype_ledger {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// HypeLedgerIDs returns the "hype_ledger" edge IDs in the mutation.
+func (m *UserMutation) HypeLedgerIDs() (ids []int) {
+	for id := range m.hype_ledger {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetHypeLedger resets all changes to the "hype_ledger" edge.
+func (m *UserMutation) ResetHypeLedger() {
+	m.hype_ledger = nil
+	m.clearedhype_ledger = false
+	m.removedhype_ledger = nil
+}
+
+// AddHypeAdjustmentIDs adds the "hype_adjustments" edge to the HypeLedger entity by ids.
+func (m *UserMutation) AddHypeAdjustmentIDs(ids ...int) {
+	if m.hype_adjustments == nil {
+		m.hype_adjustments = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.hype_adjustments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearHypeAdjustments clears the "hype_adjustments" edge to the HypeLedger entity.
+func (m *UserMutation) ClearHypeAdjustments() {
+	m.clearedhype_adjustments = true
+}
+
+// HypeAdjustmentsCleared reports if the "hype_adjustments" edge to the HypeLedger entity was cleared.
+func (m *UserMutation) HypeAdjustmentsCleared() bool {
+	return m.clearedhype_adjustments
+}
+
+// RemoveHypeAdjustmentIDs removes the "hype_adjustments" edge to the HypeLedger entity by IDs.
+func (m *UserMutation) RemoveHypeAdjustmentIDs(ids ...int) {
+	if m.removedhype_adjustments == nil {
+		m.removedhype_adjustments = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.hype_adjustments, ids[i])
+		m.removedhype_adjustments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedHypeAdjustments returns the removed IDs of the "hype_adjustments" edge to the HypeLedger entity.
+func (m *UserMutation) RemovedHypeAdjustmentsIDs() (ids []int) {
+	for id := range m.removedhype_adjustments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// HypeAdjustmentsIDs returns the "hype_adjustments" edge IDs in the mutation.
+func (m *UserMutation) HypeAdjustmentsIDs() (ids []int) {
+	for id := range m.hype_adjustments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetHypeAdjustments resets all changes to the "hype_adjustments" edge.
+func (m *UserMutation) ResetHypeAdjustments() {
+	m.hype_adjustments = nil
+	m.clearedhype_adjustments = false
+	m.removedhype_adjustments = nil
+}
+
+// AddRegionIDs adds the "regions" edge to the Region entity by ids.
+func (m *UserMutation) AddRegionIDs(ids ...int) {
+	if m.regions == nil {
+		m.regions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.regions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRegions clears the "regions" edge to the Region entity.
+func (m *UserMutation) ClearRegions() {
+	m.clearedregions = true
+}
+
+// RegionsCleared reports if the "regions" edge to the Region entity was cleared.
+func (m *UserMutation) RegionsCleared() bool {
+	return m.clearedregions
+}
+
+// RemoveRegionIDs removes the "regions" edge to the Region entity by IDs.
+func (m *UserMutation) RemoveRegionIDs(ids ...int) {
+	if m.removedregions == nil {
+		m.removedregions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.regions, ids[i])
+		m.removedregions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRegions returns the removed IDs of the "regions" edge to the Region entity.
+func (m *UserMutation) RemovedRegionsIDs() (ids []int) {
+	for id := range m.removedregions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RegionsIDs returns the "regions" edge IDs in the mutation.
+func (m *UserMutation) RegionsIDs() (ids []int) {
+	for id := range m.regions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRegions resets all changes to the "regions" edge.
+func (m *UserMutation) ResetRegions() {
+	m.regions = nil
+	m.clearedregions = false
+	m.removedregions = nil
+}
+
+// ClearTeam clears the "team" edge to the Team entity.
+func (m *UserMutation) ClearTeam() {
+	m.clearedteam = true
+	m.clearedFields[user.FieldTeamID] = struct{}{}
+}
+
+// TeamCleared reports if the "team" edge to the Team entity was cleared.
+func (m *UserMutation) TeamCleared() bool {
+	return m.TeamIDCleared() || m.clearedteam
+}
+
+// TeamIDs returns the "team" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TeamID instead. It exists only for internal usage by the builders.
+func (m *UserMutation) TeamIDs() (ids []int) {
+	if id := m.team; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTeam resets all changes to the "team" edge.
+func (m *UserMutation) ResetTeam() {
+	m.team = nil
+	m.clearedteam = false
+}
+
 // Where appends a list predicates to the UserMutation builder.
 func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
@@ -1463,13 +8141,25 @@ func (m *UserMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 2)
+	fields := make([]string, 0, 6)
 	if m.display_name != nil {
 		fields = append(fields, user.FieldDisplayName)
 	}
 	if m.game_id != nil {
 		fields = append(fields, user.FieldGameID)
 	}
+	if m.team != nil {
+		fields = append(fields, user.FieldTeamID)
+	}
+	if m.role != nil {
+		fields = append(fields, user.FieldRole)
+	}
+	if m.banned_at != nil {
+		fields = append(fields, user.FieldBannedAt)
+	}
+	if m.ban_reason != nil {
+		fields = append(fields, user.FieldBanReason)
+	}
 	return fields
 }
 
@@ -1482,6 +8172,14 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 		return m.DisplayName()
 	case user.FieldGameID:
 		return m.GameID()
+	case user.FieldTeamID:
+		return m.TeamID()
+	case user.FieldRole:
+		return m.Role()
+	case user.FieldBannedAt:
+		return m.BannedAt()
+	case user.FieldBanReason:
+		return m.BanReason()
 	}
 	return nil, false
 }
@@ -1495,6 +8193,14 @@ func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldDisplayName(ctx)
 	case user.FieldGameID:
 		return m.OldGameID(ctx)
+	case user.FieldTeamID:
+		return m.OldTeamID(ctx)
+	case user.FieldRole:
+		return m.OldRole(ctx)
+	case user.FieldBannedAt:
+		return m.OldBannedAt(ctx)
+	case user.FieldBanReason:
+		return m.OldBanReason(ctx)
 	}
 	return nil, fmt.Errorf("unknown User field %s", name)
 }
@@ -1518,6 +8224,34 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetGameID(v)
 		return nil
+	case user.FieldTeamID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTeamID(v)
+		return nil
+	case user.FieldRole:
+		v, ok := value.(user.Role)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
+	case user.FieldBannedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBannedAt(v)
+		return nil
+	case user.FieldBanReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBanReason(v)
+		return nil
 	}
 	return fmt.Errorf("unknown User field %s", name)
 }
@@ -1525,13 +8259,16 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
 func (m *UserMutation) AddedFields() []string {
-	return nil
+	var fields []string
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
 func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
 	return nil, false
 }
 
@@ -1547,7 +8284,17 @@ func (m *UserMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *UserMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(user.FieldTeamID) {
+		fields = append(fields, user.FieldTeamID)
+	}
+	if m.FieldCleared(user.FieldBannedAt) {
+		fields = append(fields, user.FieldBannedAt)
+	}
+	if m.FieldCleared(user.FieldBanReason) {
+		fields = append(fields, user.FieldBanReason)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -1560,6 +8307,17 @@ func (m *UserMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *UserMutation) ClearField(name string) error {
+	switch name {
+	case user.FieldTeamID:
+		m.ClearTeamID()
+		return nil
+	case user.FieldBannedAt:
+		m.ClearBannedAt()
+		return nil
+	case user.FieldBanReason:
+		m.ClearBanReason()
+		return nil
+	}
 	return fmt.Errorf("unknown User nullable field %s", name)
 }
 
@@ -1573,19 +8331,49 @@ func (m *UserMutation) ResetField(name string) error {
 	case user.FieldGameID:
 		m.ResetGameID()
 		return nil
+	case user.FieldTeamID:
+		m.ResetTeamID()
+		return nil
+	case user.FieldRole:
+		m.ResetRole()
+		return nil
+	case user.FieldBannedAt:
+		m.ResetBannedAt()
+		return nil
+	case user.FieldBanReason:
+		m.ResetBanReason()
+		return nil
 	}
 	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 8)
 	if m.pixels != nil {
 		edges = append(edges, user.EdgePixels)
 	}
 	if m.hype != nil {
 		edges = append(edges, user.EdgeHype)
 	}
+	if m.boards != nil {
+		edges = append(edges, user.EdgeBoards)
+	}
+	if m.changes != nil {
+		edges = append(edges, user.EdgeChanges)
+	}
+	if m.hype_ledger != nil {
+		edges = append(edges, user.EdgeHypeLedger)
+	}
+	if m.hype_adjustments != nil {
+		edges = append(edges, user.EdgeHypeAdjustments)
+	}
+	if m.regions != nil {
+		edges = append(edges, user.EdgeRegions)
+	}
+	if m.team != nil {
+		edges = append(edges, user.EdgeTeam)
+	}
 	return edges
 }
 
@@ -1603,16 +8391,65 @@ func (m *UserMutation) AddedIDs(name string) []ent.Value {
 		if id := m.hype; id != nil {
 			return []ent.Value{*id}
 		}
+	case user.EdgeBoards:
+		ids := make([]ent.Value, 0, len(m.boards))
+		for id := range m.boards {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeChanges:
+		ids := make([]ent.Value, 0, len(m.changes))
+		for id := range m.changes {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeHypeLedger:
+		ids := make([]ent.Value, 0, len(m.hype_ledger))
+		for id := range m.hype_ledger {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeHypeAdjustments:
+		ids := make([]ent.Value, 0, len(m.hype_adjustments))
+		for id := range m.hype_adjustments {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeRegions:
+		ids := make([]ent.Value, 0, len(m.regions))
+		for id := range m.regions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeTeam:
+		if id := m.team; id != nil {
+			return []ent.Value{*id}
+		}
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 8)
 	if m.removedpixels != nil {
 		edges = append(edges, user.EdgePixels)
 	}
+	if m.removedboards != nil {
+		edges = append(edges, user.EdgeBoards)
+	}
+	if m.removedchanges != nil {
+		edges = append(edges, user.EdgeChanges)
+	}
+	if m.removedhype_ledger != nil {
+		edges = append(edges, user.EdgeHypeLedger)
+	}
+	if m.removedhype_adjustments != nil {
+		edges = append(edges, user.EdgeHypeAdjustments)
+	}
+	if m.removedregions != nil {
+		edges = append(edges, user.EdgeRegions)
+	}
 	return edges
 }
 
@@ -1626,19 +8463,67 @@ func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeBoards:
+		ids := make([]ent.Value, 0, len(m.removedboards))
+		for id := range m.removedboards {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeChanges:
+		ids := make([]ent.Value, 0, len(m.removedchanges))
+		for id := range m.removedchanges {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeHypeLedger:
+		ids := make([]ent.Value, 0, len(m.removedhype_ledger))
+		for id := range m.removedhype_ledger {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeHypeAdjustments:
+		ids := make([]ent.Value, 0, len(m.removedhype_adjustments))
+		for id := range m.removedhype_adjustments {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeRegions:
+		ids := make([]ent.Value, 0, len(m.removedregions))
+		for id := range m.removedregions {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 8)
 	if m.clearedpixels {
 		edges = append(edges, user.EdgePixels)
 	}
 	if m.clearedhype {
 		edges = append(edges, user.EdgeHype)
 	}
+	if m.clearedboards {
+		edges = append(edges, user.EdgeBoards)
+	}
+	if m.clearedchanges {
+		edges = append(edges, user.EdgeChanges)
+	}
+	if m.clearedhype_ledger {
+		edges = append(edges, user.EdgeHypeLedger)
+	}
+	if m.clearedhype_adjustments {
+		edges = append(edges, user.EdgeHypeAdjustments)
+	}
+	if m.clearedregions {
+		edges = append(edges, user.EdgeRegions)
+	}
+	if m.clearedteam {
+		edges = append(edges, user.EdgeTeam)
+	}
 	return edges
 }
 
@@ -1650,6 +8535,18 @@ func (m *UserMutation) EdgeCleared(name string) bool {
 		return m.clearedpixels
 	case user.EdgeHype:
 		return m.clearedhype
+	case user.EdgeBoards:
+		return m.clearedboards
+	case user.EdgeChanges:
+		return m.clearedchanges
+	case user.EdgeHypeLedger:
+		return m.clearedhype_ledger
+	case user.EdgeHypeAdjustments:
+		return m.clearedhype_adjustments
+	case user.EdgeRegions:
+		return m.clearedregions
+	case user.EdgeTeam:
+		return m.clearedteam
 	}
 	return false
 }
@@ -1661,6 +8558,9 @@ func (m *UserMutation) ClearEdge(name string) error {
 	case user.EdgeHype:
 		m.ClearHype()
 		return nil
+	case user.EdgeTeam:
+		m.ClearTeam()
+		return nil
 	}
 	return fmt.Errorf("unknown User unique edge %s", name)
 }
@@ -1675,6 +8575,24 @@ func (m *UserMutation) ResetEdge(name string) error {
 	case user.EdgeHype:
 		m.ResetHype()
 		return nil
+	case user.EdgeBoards:
+		m.ResetBoards()
+		return nil
+	case user.EdgeChanges:
+		m.ResetChanges()
+		return nil
+	case user.EdgeHypeLedger:
+		m.ResetHypeLedger()
+		return nil
+	case user.EdgeHypeAdjustments:
+		m.ResetHypeAdjustments()
+		return nil
+	case user.EdgeRegions:
+		m.ResetRegions()
+		return nil
+	case user.EdgeTeam:
+		m.ResetTeam()
+		return nil
 	}
 	return fmt.Errorf("unknown User edge %s", name)
 }
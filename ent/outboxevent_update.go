@@ -0,0 +1,448 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"nevissGo/ent/outboxevent"
+	"nevissGo/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OutboxEventUpdate is the builder for updating OutboxEvent entities.
+type OutboxEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OutboxEventMutation
+}
+
+// Where appends a list predicates to the OutboxEventUpdate builder.
+func (oeu *OutboxEventUpdate) Where(ps ...predicate.OutboxEvent) *OutboxEventUpdate {
+	oeu.mutation.Where(ps...)
+	return oeu
+}
+
+// SetKind sets the "kind" field.
+func (oeu *OutboxEventUpdate) SetKind(o outboxevent.Kind) *OutboxEventUpdate {
+	oeu.mutation.SetKind(o)
+	return oeu
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (oeu *OutboxEventUpdate) SetNillableKind(o *outboxevent.Kind) *OutboxEventUpdate {
+	if o != nil {
+		oeu.SetKind(*o)
+	}
+	return oeu
+}
+
+// SetTarget sets the "target" field.
+func (oeu *OutboxEventUpdate) SetTarget(s string) *OutboxEventUpdate {
+	oeu.mutation.SetTarget(s)
+	return oeu
+}
+
+// SetNillableTarget sets the "target" field if the given value is not nil.
+func (oeu *OutboxEventUpdate) SetNillableTarget(s *string) *OutboxEventUpdate {
+	if s != nil {
+		oeu.SetTarget(*s)
+	}
+	return oeu
+}
+
+// ClearTarget clears the value of the "target" field.
+func (oeu *OutboxEventUpdate) ClearTarget() *OutboxEventUpdate {
+	oeu.mutation.ClearTarget()
+	return oeu
+}
+
+// SetName sets the "name" field.
+func (oeu *OutboxEventUpdate) SetName(s string) *OutboxEventUpdate {
+	oeu.mutation.SetName(s)
+	return oeu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (oeu *OutboxEventUpdate) SetNillableName(s *string) *OutboxEventUpdate {
+	if s != nil {
+		oeu.SetName(*s)
+	}
+	return oeu
+}
+
+// SetPayload sets the "payload" field.
+func (oeu *OutboxEventUpdate) SetPayload(b []byte) *OutboxEventUpdate {
+	oeu.mutation.SetPayload(b)
+	return oeu
+}
+
+// SetAttempts sets the "attempts" field.
+func (oeu *OutboxEventUpdate) SetAttempts(i int) *OutboxEventUpdate {
+	oeu.mutation.ResetAttempts()
+	oeu.mutation.SetAttempts(i)
+	return oeu
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (oeu *OutboxEventUpdate) SetNillableAttempts(i *int) *OutboxEventUpdate {
+	if i != nil {
+		oeu.SetAttempts(*i)
+	}
+	return oeu
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (oeu *OutboxEventUpdate) AddAttempts(i int) *OutboxEventUpdate {
+	oeu.mutation.AddAttempts(i)
+	return oeu
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (oeu *OutboxEventUpdate) SetNextAttemptAt(t time.Time) *OutboxEventUpdate {
+	oeu.mutation.SetNextAttemptAt(t)
+	return oeu
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (oeu *OutboxEventUpdate) SetNillableNextAttemptAt(t *time.Time) *OutboxEventUpdate {
+	if t != nil {
+		oeu.SetNextAttemptAt(*t)
+	}
+	return oeu
+}
+
+// Mutation returns the OutboxEventMutation object of the builder.
+func (oeu *OutboxEventUpdate) Mutation() *OutboxEventMutation {
+	return oeu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (oeu *OutboxEventUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, oeu.sqlSave, oeu.mutation, oeu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (oeu *OutboxEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := oeu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (oeu *OutboxEventUpdate) Exec(ctx context.Context) error {
+	_, err := oeu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oeu *OutboxEventUpdate) ExecX(ctx context.Context) {
+	if err := oeu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (oeu *OutboxEventUpdate) check() error {
+	if v, ok := oeu.mutation.Kind(); ok {
+		if err := outboxevent.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "OutboxEvent.kind": %w`, err)}
+		}
+	}
+	if v, ok := oeu.mutation.Name(); ok {
+		if err := outboxevent.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "OutboxEvent.name": %w`, err)}
+		}
+	}
+	if v, ok := oeu.mutation.Attempts(); ok {
+		if err := outboxevent.AttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "attempts", err: fmt.Errorf(`ent: validator failed for field "OutboxEvent.attempts": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (oeu *OutboxEventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := oeu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(outboxevent.Table, outboxevent.Columns, sqlgraph.NewFieldSpec(outboxevent.FieldID, field.TypeInt))
+	if ps := oeu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := oeu.mutation.Kind(); ok {
+		_spec.SetField(outboxevent.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := oeu.mutation.Target(); ok {
+		_spec.SetField(outboxevent.FieldTarget, field.TypeString, value)
+	}
+	if oeu.mutation.TargetCleared() {
+		_spec.ClearField(outboxevent.FieldTarget, field.TypeString)
+	}
+	if value, ok := oeu.mutation.Name(); ok {
+		_spec.SetField(outboxevent.FieldName, field.TypeString, value)
+	}
+	if value, ok := oeu.mutation.Payload(); ok {
+		_spec.SetField(outboxevent.FieldPayload, field.TypeBytes, value)
+	}
+	if value, ok := oeu.mutation.Attempts(); ok {
+		_spec.SetField(outboxevent.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := oeu.mutation.AddedAttempts(); ok {
+		_spec.AddField(outboxevent.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := oeu.mutation.NextAttemptAt(); ok {
+		_spec.SetField(outboxevent.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, oeu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{outboxevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	oeu.mutation.done = true
+	return n, nil
+}
+
+// OutboxEventUpdateOne is the builder for updating a single OutboxEvent entity.
+type OutboxEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OutboxEventMutation
+}
+
+// SetKind sets the "kind" field.
+func (oeuo *OutboxEventUpdateOne) SetKind(o outboxevent.Kind) *OutboxEventUpdateOne {
+	oeuo.mutation.SetKind(o)
+	return oeuo
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (oeuo *OutboxEventUpdateOne) SetNillableKind(o *outboxevent.Kind) *OutboxEventUpdateOne {
+	if o != nil {
+		oeuo.SetKind(*o)
+	}
+	return oeuo
+}
+
+// SetTarget sets the "target" field.
+func (oeuo *OutboxEventUpdateOne) SetTarget(s string) *OutboxEventUpdateOne {
+	oeuo.mutation.SetTarget(s)
+	return oeuo
+}
+
+// SetNillableTarget sets the "target" field if the given value is not nil.
+func (oeuo *OutboxEventUpdateOne) SetNillableTarget(s *string) *OutboxEventUpdateOne {
+	if s != nil {
+		oeuo.SetTarget(*s)
+	}
+	return oeuo
+}
+
+// ClearTarget clears the value of the "target" field.
+func (oeuo *OutboxEventUpdateOne) ClearTarget() *OutboxEventUpdateOne {
+	oeuo.mutation.ClearTarget()
+	return oeuo
+}
+
+// SetName sets the "name" field.
+func (oeuo *OutboxEventUpdateOne) SetName(s string) *OutboxEventUpdateOne {
+	oeuo.mutation.SetName(s)
+	return oeuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (oeuo *OutboxEventUpdateOne) SetNillableName(s *string) *OutboxEventUpdateOne {
+	if s != nil {
+		oeuo.SetName(*s)
+	}
+	return oeuo
+}
+
+// SetPayload sets the "payload" field.
+func (oeuo *OutboxEventUpdateOne) SetPayload(b []byte) *OutboxEventUpdateOne {
+	oeuo.mutation.SetPayload(b)
+	return oeuo
+}
+
+// SetAttempts sets the "attempts" field.
+func (oeuo *OutboxEventUpdateOne) SetAttempts(i int) *OutboxEventUpdateOne {
+	oeuo.mutation.ResetAttempts()
+	oeuo.mutation.SetAttempts(i)
+	return oeuo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (oeuo *OutboxEventUpdateOne) SetNillableAttempts(i *int) *OutboxEventUpdateOne {
+	if i != nil {
+		oeuo.SetAttempts(*i)
+	}
+	return oeuo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (oeuo *OutboxEventUpdateOne) AddAttempts(i int) *OutboxEventUpdateOne {
+	oeuo.mutation.AddAttempts(i)
+	return oeuo
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (oeuo *OutboxEventUpdateOne) SetNextAttemptAt(t time.Time) *OutboxEventUpdateOne {
+	oeuo.mutation.SetNextAttemptAt(t)
+	return oeuo
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (oeuo *OutboxEventUpdateOne) SetNillableNextAttemptAt(t *time.Time) *OutboxEventUpdateOne {
+	if t != nil {
+		oeuo.SetNextAttemptAt(*t)
+	}
+	return oeuo
+}
+
+// Mutation returns the OutboxEventMutation object of the builder.
+func (oeuo *OutboxEventUpdateOne) Mutation() *OutboxEventMutation {
+	return oeuo.mutation
+}
+
+// Where appends a list predicates to the OutboxEventUpdate builder.
+func (oeuo *OutboxEventUpdateOne) Where(ps ...predicate.OutboxEvent) *OutboxEventUpdateOne {
+	oeuo.mutation.Where(ps...)
+	return oeuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (oeuo *OutboxEventUpdateOne) Select(field string, fields ...string) *OutboxEventUpdateOne {
+	oeuo.fields = append([]string{field}, fields...)
+	return oeuo
+}
+
+// Save executes the query and returns the updated OutboxEvent entity.
+func (oeuo *OutboxEventUpdateOne) Save(ctx context.Context) (*OutboxEvent, error) {
+	return withHooks(ctx, oeuo.sqlSave, oeuo.mutation, oeuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (oeuo *OutboxEventUpdateOne) SaveX(ctx context.Context) *OutboxEvent {
+	node, err := oeuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (oeuo *OutboxEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := oeuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oeuo *OutboxEventUpdateOne) ExecX(ctx context.Context) {
+	if err := oeuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (oeuo *OutboxEventUpdateOne) check() error {
+	if v, ok := oeuo.mutation.Kind(); ok {
+		if err := outboxevent.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "OutboxEvent.kind": %w`, err)}
+		}
+	}
+	if v, ok := oeuo.mutation.Name(); ok {
+		if err := outboxevent.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "OutboxEvent.name": %w`, err)}
+		}
+	}
+	if v, ok := oeuo.mutation.Attempts(); ok {
+		if err := outboxevent.AttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "attempts", err: fmt.Errorf(`ent: validator failed for field "OutboxEvent.attempts": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (oeuo *OutboxEventUpdateOne) sqlSave(ctx context.Context) (_node *OutboxEvent, err error) {
+	if err := oeuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(outboxevent.Table, outboxevent.Columns, sqlgraph.NewFieldSpec(outboxevent.FieldID, field.TypeInt))
+	id, ok := oeuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OutboxEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := oeuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, outboxevent.FieldID)
+		for _, f := range fields {
+			if !outboxevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != outboxevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := oeuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := oeuo.mutation.Kind(); ok {
+		_spec.SetField(outboxevent.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := oeuo.mutation.Target(); ok {
+		_spec.SetField(outboxevent.FieldTarget, field.TypeString, value)
+	}
+	if oeuo.mutation.TargetCleared() {
+		_spec.ClearField(outboxevent.FieldTarget, field.TypeString)
+	}
+	if value, ok := oeuo.mutation.Name(); ok {
+		_spec.SetField(outboxevent.FieldName, field.TypeString, value)
+	}
+	if value, ok := oeuo.mutation.Payload(); ok {
+		_spec.SetField(outboxevent.FieldPayload, field.TypeBytes, value)
+	}
+	if value, ok := oeuo.mutation.Attempts(); ok {
+		_spec.SetField(outboxevent.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := oeuo.mutation.AddedAttempts(); ok {
+		_spec.AddField(outboxevent.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := oeuo.mutation.NextAttemptAt(); ok {
+		_spec.SetField(outboxevent.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	_node = &OutboxEvent{config: oeuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, oeuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{outboxevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	oeuo.mutation.done = true
+	return _node, nil
+}
@@ -4,7 +4,9 @@ package ent
 
 import (
 	"fmt"
+	"nevissGo/ent/board"
 	"nevissGo/ent/pixel"
+	"nevissGo/ent/team"
 	"nevissGo/ent/user"
 	"strings"
 	"time"
@@ -18,14 +20,23 @@ type Pixel struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID int `json:"oid,omitempty"`
+	// Position holds the value of the "position" field.
+	Position int `json:"position,omitempty"`
 	// Color holds the value of the "color" field.
 	Color string `json:"color,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Seq holds the value of the "seq" field.
+	Seq int64 `json:"seq,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int64 `json:"user_id,omitempty"`
+	// BoardID holds the value of the "board_id" field.
+	BoardID int `json:"board_id,omitempty"`
+	// TeamID holds the value of the "team_id" field.
+	TeamID *int `json:"team_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the PixelQuery when eager-loading is set.
 	Edges        PixelEdges `json:"edges"`
-	user_pixels  *int64
 	selectValues sql.SelectValues
 }
 
@@ -33,9 +44,15 @@ type Pixel struct {
 type PixelEdges struct {
 	// User holds the value of the user edge.
 	User *User `json:"user,omitempty"`
+	// Board holds the value of the board edge.
+	Board *Board `json:"board,omitempty"`
+	// Team holds the value of the team edge.
+	Team *Team `json:"team,omitempty"`
+	// Changes holds the value of the changes edge.
+	Changes []*PixelChange `json:"changes,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [4]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -49,19 +66,48 @@ func (e PixelEdges) UserOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "user"}
 }
 
+// BoardOrErr returns the Board value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PixelEdges) BoardOrErr() (*Board, error) {
+	if e.Board != nil {
+		return e.Board, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: board.Label}
+	}
+	return nil, &NotLoadedError{edge: "board"}
+}
+
+// TeamOrErr returns the Team value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PixelEdges) TeamOrErr() (*Team, error) {
+	if e.Team != nil {
+		return e.Team, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: team.Label}
+	}
+	return nil, &NotLoadedError{edge: "team"}
+}
+
+// ChangesOrErr returns the Changes value or an error if the edge
+// was not loaded in eager-loading.
+func (e PixelEdges) ChangesOrErr() ([]*PixelChange, error) {
+	if e.loadedTypes[3] {
+		return e.Changes, nil
+	}
+	return nil, &NotLoadedError{edge: "changes"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Pixel) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case pixel.FieldID:
+		case pixel.FieldID, pixel.FieldPosition, pixel.FieldSeq, pixel.FieldUserID, pixel.FieldBoardID, pixel.FieldTeamID:
 			values[i] = new(sql.NullInt64)
 		case pixel.FieldColor:
 			values[i] = new(sql.NullString)
 		case pixel.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
-		case pixel.ForeignKeys[0]: // user_pixels
-			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -83,6 +129,12 @@ func (pi *Pixel) assignValues(columns []string, values []any) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			pi.ID = int(value.Int64)
+		case pixel.FieldPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				pi.Position = int(value.Int64)
+			}
 		case pixel.FieldColor:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field color", values[i])
@@ -95,12 +147,30 @@ func (pi *Pixel) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				pi.UpdatedAt = value.Time
 			}
-		case pixel.ForeignKeys[0]:
+		case pixel.FieldSeq:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field seq", values[i])
+			} else if value.Valid {
+				pi.Seq = value.Int64
+			}
+		case pixel.FieldUserID:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field user_pixels", value)
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
 			} else if value.Valid {
-				pi.user_pixels = new(int64)
-				*pi.user_pixels = int64(value.Int64)
+				pi.UserID = value.Int64
+			}
+		case pixel.FieldBoardID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field board_id", values[i])
+			} else if value.Valid {
+				pi.BoardID = int(value.Int64)
+			}
+		case pixel.FieldTeamID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field team_id", values[i])
+			} else if value.Valid {
+				pi.TeamID = new(int)
+				*pi.TeamID = int(value.Int64)
 			}
 		default:
 			pi.selectValues.Set(columns[i], values[i])
@@ -120,6 +190,21 @@ func (pi *Pixel) QueryUser() *UserQuery {
 	return NewPixelClient(pi.config).QueryUser(pi)
 }
 
+// QueryBoard queries the "board" edge of the Pixel entity.
+func (pi *Pixel) QueryBoard() *BoardQuery {
+	return NewPixelClient(pi.config).QueryBoard(pi)
+}
+
+// QueryTeam queries the "team" edge of the Pixel entity.
+func (pi *Pixel) QueryTeam() *TeamQuery {
+	return NewPixelClient(pi.config).QueryTeam(pi)
+}
+
+// QueryChanges queries the "changes" edge of the Pixel entity.
+func (pi *Pixel) QueryChanges() *PixelChangeQuery {
+	return NewPixelClient(pi.config).QueryChanges(pi)
+}
+
 // Update returns a builder for updating this Pixel.
 // Note that you need to call Pixel.Unwrap() before calling this method if this Pixel
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -143,11 +228,28 @@ func (pi *Pixel) String() string {
 	var builder strings.Builder
 	builder.WriteString("Pixel(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", pi.ID))
+	builder.WriteString("position=")
+	builder.WriteString(fmt.Sprintf("%v", pi.Position))
+	builder.WriteString(", ")
 	builder.WriteString("color=")
 	builder.WriteString(pi.Color)
 	builder.WriteString(", ")
 	builder.WriteString("updated_at=")
 	builder.WriteString(pi.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("seq=")
+	builder.WriteString(fmt.Sprintf("%v", pi.Seq))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", pi.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("board_id=")
+	builder.WriteString(fmt.Sprintf("%v", pi.BoardID))
+	builder.WriteString(", ")
+	if v := pi.TeamID; v != nil {
+		builder.WriteString("team_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -55,6 +55,11 @@ func IDLTE(id int) predicate.Pixel {
 	return predicate.Pixel(sql.FieldLTE(FieldID, id))
 }
 
+// Position applies equality check predicate on the "position" field. It's identical to PositionEQ.
+func Position(v int) predicate.Pixel {
+	return predicate.Pixel(sql.FieldEQ(FieldPosition, v))
+}
+
 // Color applies equality check predicate on the "color" field. It's identical to ColorEQ.
 func Color(v string) predicate.Pixel {
 	return predicate.Pixel(sql.FieldEQ(FieldColor, v))
@@ -65,6 +70,66 @@ func UpdatedAt(v time.Time) predicate.Pixel {
 	return predicate.Pixel(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
+// Seq applies equality check predicate on the "seq" field. It's identical to SeqEQ.
+func Seq(v int64) predicate.Pixel {
+	return predicate.Pixel(sql.FieldEQ(FieldSeq, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int64) predicate.Pixel {
+	return predicate.Pixel(sql.FieldEQ(FieldUserID, v))
+}
+
+// BoardID applies equality check predicate on the "board_id" field. It's identical to BoardIDEQ.
+func BoardID(v int) predicate.Pixel {
+	return predicate.Pixel(sql.FieldEQ(FieldBoardID, v))
+}
+
+// TeamID applies equality check predicate on the "team_id" field. It's identical to TeamIDEQ.
+func TeamID(v int) predicate.Pixel {
+	return predicate.Pixel(sql.FieldEQ(FieldTeamID, v))
+}
+
+// PositionEQ applies the EQ predicate on the "position" field.
+func PositionEQ(v int) predicate.Pixel {
+	return predicate.Pixel(sql.FieldEQ(FieldPosition, v))
+}
+
+// PositionNEQ applies the NEQ predicate on the "position" field.
+func PositionNEQ(v int) predicate.Pixel {
+	return predicate.Pixel(sql.FieldNEQ(FieldPosition, v))
+}
+
+// PositionIn applies the In predicate on the "position" field.
+func PositionIn(vs ...int) predicate.Pixel {
+	return predicate.Pixel(sql.FieldIn(FieldPosition, vs...))
+}
+
+// PositionNotIn applies the NotIn predicate on the "position" field.
+func PositionNotIn(vs ...int) predicate.Pixel {
+	return predicate.Pixel(sql.FieldNotIn(FieldPosition, vs...))
+}
+
+// PositionGT applies the GT predicate on the "position" field.
+func PositionGT(v int) predicate.Pixel {
+	return predicate.Pixel(sql.FieldGT(FieldPosition, v))
+}
+
+// PositionGTE applies the GTE predicate on the "position" field.
+func PositionGTE(v int) predicate.Pixel {
+	return predicate.Pixel(sql.FieldGTE(FieldPosition, v))
+}
+
+// PositionLT applies the LT predicate on the "position" field.
+func PositionLT(v int) predicate.Pixel {
+	return predicate.Pixel(sql.FieldLT(FieldPosition, v))
+}
+
+// PositionLTE applies the LTE predicate on the "position" field.
+func PositionLTE(v int) predicate.Pixel {
+	return predicate.Pixel(sql.FieldLTE(FieldPosition, v))
+}
+
 // ColorEQ applies the EQ predicate on the "color" field.
 func ColorEQ(v string) predicate.Pixel {
 	return predicate.Pixel(sql.FieldEQ(FieldColor, v))
@@ -170,6 +235,136 @@ func UpdatedAtLTE(v time.Time) predicate.Pixel {
 	return predicate.Pixel(sql.FieldLTE(FieldUpdatedAt, v))
 }
 
+// SeqEQ applies the EQ predicate on the "seq" field.
+func SeqEQ(v int64) predicate.Pixel {
+	return predicate.Pixel(sql.FieldEQ(FieldSeq, v))
+}
+
+// SeqNEQ applies the NEQ predicate on the "seq" field.
+func SeqNEQ(v int64) predicate.Pixel {
+	return predicate.Pixel(sql.FieldNEQ(FieldSeq, v))
+}
+
+// SeqIn applies the In predicate on the "seq" field.
+func SeqIn(vs ...int64) predicate.Pixel {
+	return predicate.Pixel(sql.FieldIn(FieldSeq, vs...))
+}
+
+// SeqNotIn applies the NotIn predicate on the "seq" field.
+func SeqNotIn(vs ...int64) predicate.Pixel {
+	return predicate.Pixel(sql.FieldNotIn(FieldSeq, vs...))
+}
+
+// SeqGT applies the GT predicate on the "seq" field.
+func SeqGT(v int64) predicate.Pixel {
+	return predicate.Pixel(sql.FieldGT(FieldSeq, v))
+}
+
+// SeqGTE applies the GTE predicate on the "seq" field.
+func SeqGTE(v int64) predicate.Pixel {
+	return predicate.Pixel(sql.FieldGTE(FieldSeq, v))
+}
+
+// SeqLT applies the LT predicate on the "seq" field.
+func SeqLT(v int64) predicate.Pixel {
+	return predicate.Pixel(sql.FieldLT(FieldSeq, v))
+}
+
+// SeqLTE applies the LTE predicate on the "seq" field.
+func SeqLTE(v int64) predicate.Pixel {
+	return predicate.Pixel(sql.FieldLTE(FieldSeq, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int64) predicate.Pixel {
+	return predicate.Pixel(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int64) predicate.Pixel {
+	return predicate.Pixel(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int64) predicate.Pixel {
+	return predicate.Pixel(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int64) predicate.Pixel {
+	return predicate.Pixel(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDIsNil applies the IsNil predicate on the "user_id" field.
+func UserIDIsNil() predicate.Pixel {
+	return predicate.Pixel(sql.FieldIsNull(FieldUserID))
+}
+
+// UserIDNotNil applies the NotNil predicate on the "user_id" field.
+func UserIDNotNil() predicate.Pixel {
+	return predicate.Pixel(sql.FieldNotNull(FieldUserID))
+}
+
+// BoardIDEQ applies the EQ predicate on the "board_id" field.
+func BoardIDEQ(v int) predicate.Pixel {
+	return predicate.Pixel(sql.FieldEQ(FieldBoardID, v))
+}
+
+// BoardIDNEQ applies the NEQ predicate on the "board_id" field.
+func BoardIDNEQ(v int) predicate.Pixel {
+	return predicate.Pixel(sql.FieldNEQ(FieldBoardID, v))
+}
+
+// BoardIDIn applies the In predicate on the "board_id" field.
+func BoardIDIn(vs ...int) predicate.Pixel {
+	return predicate.Pixel(sql.FieldIn(FieldBoardID, vs...))
+}
+
+// BoardIDNotIn applies the NotIn predicate on the "board_id" field.
+func BoardIDNotIn(vs ...int) predicate.Pixel {
+	return predicate.Pixel(sql.FieldNotIn(FieldBoardID, vs...))
+}
+
+// BoardIDIsNil applies the IsNil predicate on the "board_id" field.
+func BoardIDIsNil() predicate.Pixel {
+	return predicate.Pixel(sql.FieldIsNull(FieldBoardID))
+}
+
+// BoardIDNotNil applies the NotNil predicate on the "board_id" field.
+func BoardIDNotNil() predicate.Pixel {
+	return predicate.Pixel(sql.FieldNotNull(FieldBoardID))
+}
+
+// TeamIDEQ applies the EQ predicate on the "team_id" field.
+func TeamIDEQ(v int) predicate.Pixel {
+	return predicate.Pixel(sql.FieldEQ(FieldTeamID, v))
+}
+
+// TeamIDNEQ applies the NEQ predicate on the "team_id" field.
+func TeamIDNEQ(v int) predicate.Pixel {
+	return predicate.Pixel(sql.FieldNEQ(FieldTeamID, v))
+}
+
+// TeamIDIn applies the In predicate on the "team_id" field.
+func TeamIDIn(vs ...int) predicate.Pixel {
+	return predicate.Pixel(sql.FieldIn(FieldTeamID, vs...))
+}
+
+// TeamIDNotIn applies the NotIn predicate on the "team_id" field.
+func TeamIDNotIn(vs ...int) predicate.Pixel {
+	return predicate.Pixel(sql.FieldNotIn(FieldTeamID, vs...))
+}
+
+// TeamIDIsNil applies the IsNil predicate on the "team_id" field.
+func TeamIDIsNil() predicate.Pixel {
+	return predicate.Pixel(sql.FieldIsNull(FieldTeamID))
+}
+
+// TeamIDNotNil applies the NotNil predicate on the "team_id" field.
+func TeamIDNotNil() predicate.Pixel {
+	return predicate.Pixel(sql.FieldNotNull(FieldTeamID))
+}
+
 // HasUser applies the HasEdge predicate on the "user" edge.
 func HasUser() predicate.Pixel {
 	return predicate.Pixel(func(s *sql.Selector) {
@@ -193,6 +388,75 @@ func HasUserWith(preds ...predicate.User) predicate.Pixel {
 	})
 }
 
+// HasBoard applies the HasEdge predicate on the "board" edge.
+func HasBoard() predicate.Pixel {
+	return predicate.Pixel(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BoardTable, BoardColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBoardWith applies the HasEdge predicate on the "board" edge with a given conditions (other predicates).
+func HasBoardWith(preds ...predicate.Board) predicate.Pixel {
+	return predicate.Pixel(func(s *sql.Selector) {
+		step := newBoardStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTeam applies the HasEdge predicate on the "team" edge.
+func HasTeam() predicate.Pixel {
+	return predicate.Pixel(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TeamTable, TeamColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTeamWith applies the HasEdge predicate on the "team" edge with a given conditions (other predicates).
+func HasTeamWith(preds ...predicate.Team) predicate.Pixel {
+	return predicate.Pixel(func(s *sql.Selector) {
+		step := newTeamStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasChanges applies the HasEdge predicate on the "changes" edge.
+func HasChanges() predicate.Pixel {
+	return predicate.Pixel(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChangesTable, ChangesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChangesWith applies the HasEdge predicate on the "changes" edge with a given conditions (other predicates).
+func HasChangesWith(preds ...predicate.PixelChange) predicate.Pixel {
+	return predicate.Pixel(func(s *sql.Selector) {
+		step := newChangesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Pixel) predicate.Pixel {
 	return predicate.Pixel(sql.AndPredicates(predicates...))
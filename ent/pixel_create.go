@@ -6,7 +6,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"nevissGo/ent/board"
 	"nevissGo/ent/pixel"
+	"nevissGo/ent/pixelchange"
+	"nevissGo/ent/team"
 	"nevissGo/ent/user"
 	"time"
 
@@ -21,6 +24,20 @@ type PixelCreate struct {
 	hooks    []Hook
 }
 
+// SetPosition sets the "position" field.
+func (pc *PixelCreate) SetPosition(i int) *PixelCreate {
+	pc.mutation.SetPosition(i)
+	return pc
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (pc *PixelCreate) SetNillablePosition(i *int) *PixelCreate {
+	if i != nil {
+		pc.SetPosition(*i)
+	}
+	return pc
+}
+
 // SetColor sets the "color" field.
 func (pc *PixelCreate) SetColor(s string) *PixelCreate {
 	pc.mutation.SetColor(s)
@@ -41,31 +58,98 @@ func (pc *PixelCreate) SetNillableUpdatedAt(t *time.Time) *PixelCreate {
 	return pc
 }
 
-// SetID sets the "id" field.
-func (pc *PixelCreate) SetID(i int) *PixelCreate {
-	pc.mutation.SetID(i)
+// SetSeq sets the "seq" field.
+func (pc *PixelCreate) SetSeq(i int64) *PixelCreate {
+	pc.mutation.SetSeq(i)
+	return pc
+}
+
+// SetNillableSeq sets the "seq" field if the given value is not nil.
+func (pc *PixelCreate) SetNillableSeq(i *int64) *PixelCreate {
+	if i != nil {
+		pc.SetSeq(*i)
+	}
+	return pc
+}
+
+// SetUserID sets the "user_id" field.
+func (pc *PixelCreate) SetUserID(i int64) *PixelCreate {
+	pc.mutation.SetUserID(i)
+	return pc
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (pc *PixelCreate) SetNillableUserID(i *int64) *PixelCreate {
+	if i != nil {
+		pc.SetUserID(*i)
+	}
 	return pc
 }
 
-// SetUserID sets the "user" edge to the User entity by ID.
-func (pc *PixelCreate) SetUserID(id int64) *PixelCreate {
-	pc.mutation.SetUserID(id)
+// SetBoardID sets the "board_id" field.
+func (pc *PixelCreate) SetBoardID(i int) *PixelCreate {
+	pc.mutation.SetBoardID(i)
 	return pc
 }
 
-// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
-func (pc *PixelCreate) SetNillableUserID(id *int64) *PixelCreate {
-	if id != nil {
-		pc = pc.SetUserID(*id)
+// SetNillableBoardID sets the "board_id" field if the given value is not nil.
+func (pc *PixelCreate) SetNillableBoardID(i *int) *PixelCreate {
+	if i != nil {
+		pc.SetBoardID(*i)
 	}
 	return pc
 }
 
+// SetTeamID sets the "team_id" field.
+func (pc *PixelCreate) SetTeamID(i int) *PixelCreate {
+	pc.mutation.SetTeamID(i)
+	return pc
+}
+
+// SetNillableTeamID sets the "team_id" field if the given value is not nil.
+func (pc *PixelCreate) SetNillableTeamID(i *int) *PixelCreate {
+	if i != nil {
+		pc.SetTeamID(*i)
+	}
+	return pc
+}
+
+// SetID sets the "id" field.
+func (pc *PixelCreate) SetID(i int) *PixelCreate {
+	pc.mutation.SetID(i)
+	return pc
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (pc *PixelCreate) SetUser(u *User) *PixelCreate {
 	return pc.SetUserID(u.ID)
 }
 
+// SetBoard sets the "board" edge to the Board entity.
+func (pc *PixelCreate) SetBoard(b *Board) *PixelCreate {
+	return pc.SetBoardID(b.ID)
+}
+
+// SetTeam sets the "team" edge to the Team entity.
+func (pc *PixelCreate) SetTeam(t *Team) *PixelCreate {
+	return pc.SetTeamID(t.ID)
+}
+
+// AddChangeIDs adds the "changes" edge to the PixelChange entity by IDs.
+func (pc *PixelCreate) AddChangeIDs(ids ...int) *PixelCreate {
+	pc.mutation.AddChangeIDs(ids...)
+	return pc
+}
+
+// AddChanges adds the "changes" edges to the PixelChange entity.
+func (pc *PixelCreate) AddChanges(p ...*PixelChange) *PixelCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pc.AddChangeIDs(ids...)
+}
+
 // Mutation returns the PixelMutation object of the builder.
 func (pc *PixelCreate) Mutation() *PixelMutation {
 	return pc.mutation
@@ -101,20 +185,34 @@ func (pc *PixelCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (pc *PixelCreate) defaults() {
+	if _, ok := pc.mutation.Position(); !ok {
+		v := pixel.DefaultPosition
+		pc.mutation.SetPosition(v)
+	}
 	if _, ok := pc.mutation.UpdatedAt(); !ok {
 		v := pixel.DefaultUpdatedAt()
 		pc.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := pc.mutation.Seq(); !ok {
+		v := pixel.DefaultSeq
+		pc.mutation.SetSeq(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (pc *PixelCreate) check() error {
+	if _, ok := pc.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "Pixel.position"`)}
+	}
 	if _, ok := pc.mutation.Color(); !ok {
 		return &ValidationError{Name: "color", err: errors.New(`ent: missing required field "Pixel.color"`)}
 	}
 	if _, ok := pc.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Pixel.updated_at"`)}
 	}
+	if _, ok := pc.mutation.Seq(); !ok {
+		return &ValidationError{Name: "seq", err: errors.New(`ent: missing required field "Pixel.seq"`)}
+	}
 	return nil
 }
 
@@ -147,6 +245,10 @@ func (pc *PixelCreate) createSpec() (*Pixel, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = id
 	}
+	if value, ok := pc.mutation.Position(); ok {
+		_spec.SetField(pixel.FieldPosition, field.TypeInt, value)
+		_node.Position = value
+	}
 	if value, ok := pc.mutation.Color(); ok {
 		_spec.SetField(pixel.FieldColor, field.TypeString, value)
 		_node.Color = value
@@ -155,6 +257,10 @@ func (pc *PixelCreate) createSpec() (*Pixel, *sqlgraph.CreateSpec) {
 		_spec.SetField(pixel.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if value, ok := pc.mutation.Seq(); ok {
+		_spec.SetField(pixel.FieldSeq, field.TypeInt64, value)
+		_node.Seq = value
+	}
 	if nodes := pc.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -169,7 +275,57 @@ func (pc *PixelCreate) createSpec() (*Pixel, *sqlgraph.CreateSpec) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		_node.user_pixels = &nodes[0]
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.BoardIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pixel.BoardTable,
+			Columns: []string{pixel.BoardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.BoardID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.TeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pixel.TeamTable,
+			Columns: []string{pixel.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TeamID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.ChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   pixel.ChangesTable,
+			Columns: []string{pixel.ChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pixelchange.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	return _node, _spec
@@ -4,10 +4,14 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
+	"nevissGo/ent/board"
 	"nevissGo/ent/pixel"
+	"nevissGo/ent/pixelchange"
 	"nevissGo/ent/predicate"
+	"nevissGo/ent/team"
 	"nevissGo/ent/user"
 
 	"entgo.io/ent"
@@ -19,12 +23,14 @@ import (
 // PixelQuery is the builder for querying Pixel entities.
 type PixelQuery struct {
 	config
-	ctx        *QueryContext
-	order      []pixel.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Pixel
-	withUser   *UserQuery
-	withFKs    bool
+	ctx         *QueryContext
+	order       []pixel.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.Pixel
+	withUser    *UserQuery
+	withBoard   *BoardQuery
+	withTeam    *TeamQuery
+	withChanges *PixelChangeQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -83,6 +89,72 @@ func (pq *PixelQuery) QueryUser() *UserQuery {
 	return query
 }
 
+// QueryBoard chains the current query on the "board" edge.
+func (pq *PixelQuery) QueryBoard() *BoardQuery {
+	query := (&BoardClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pixel.Table, pixel.FieldID, selector),
+			sqlgraph.To(board.Table, board.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, pixel.BoardTable, pixel.BoardColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTeam chains the current query on the "team" edge.
+func (pq *PixelQuery) QueryTeam() *TeamQuery {
+	query := (&TeamClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pixel.Table, pixel.FieldID, selector),
+			sqlgraph.To(team.Table, team.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, pixel.TeamTable, pixel.TeamColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryChanges chains the current query on the "changes" edge.
+func (pq *PixelQuery) QueryChanges() *PixelChangeQuery {
+	query := (&PixelChangeClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pixel.Table, pixel.FieldID, selector),
+			sqlgraph.To(pixelchange.Table, pixelchange.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, pixel.ChangesTable, pixel.ChangesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Pixel entity from the query.
 // Returns a *NotFoundError when no Pixel was found.
 func (pq *PixelQuery) First(ctx context.Context) (*Pixel, error) {
@@ -270,12 +342,15 @@ func (pq *PixelQuery) Clone() *PixelQuery {
 		return nil
 	}
 	return &PixelQuery{
-		config:     pq.config,
-		ctx:        pq.ctx.Clone(),
-		order:      append([]pixel.OrderOption{}, pq.order...),
-		inters:     append([]Interceptor{}, pq.inters...),
-		predicates: append([]predicate.Pixel{}, pq.predicates...),
-		withUser:   pq.withUser.Clone(),
+		config:      pq.config,
+		ctx:         pq.ctx.Clone(),
+		order:       append([]pixel.OrderOption{}, pq.order...),
+		inters:      append([]Interceptor{}, pq.inters...),
+		predicates:  append([]predicate.Pixel{}, pq.predicates...),
+		withUser:    pq.withUser.Clone(),
+		withBoard:   pq.withBoard.Clone(),
+		withTeam:    pq.withTeam.Clone(),
+		withChanges: pq.withChanges.Clone(),
 		// clone intermediate query.
 		sql:  pq.sql.Clone(),
 		path: pq.path,
@@ -293,18 +368,51 @@ func (pq *PixelQuery) WithUser(opts ...func(*UserQuery)) *PixelQuery {
 	return pq
 }
 
+// WithBoard tells the query-builder to eager-load the nodes that are connected to
+// the "board" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *PixelQuery) WithBoard(opts ...func(*BoardQuery)) *PixelQuery {
+	query := (&BoardClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withBoard = query
+	return pq
+}
+
+// WithTeam tells the query-builder to eager-load the nodes that are connected to
+// the "team" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *PixelQuery) WithTeam(opts ...func(*TeamQuery)) *PixelQuery {
+	query := (&TeamClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withTeam = query
+	return pq
+}
+
+// WithChanges tells the query-builder to eager-load the nodes that are connected to
+// the "changes" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *PixelQuery) WithChanges(opts ...func(*PixelChangeQuery)) *PixelQuery {
+	query := (&PixelChangeClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withChanges = query
+	return pq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
 // Example:
 //
 //	var v []struct {
-//		Color string `json:"color,omitempty"`
+//		Position int `json:"position,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Pixel.Query().
-//		GroupBy(pixel.FieldColor).
+//		GroupBy(pixel.FieldPosition).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (pq *PixelQuery) GroupBy(field string, fields ...string) *PixelGroupBy {
@@ -322,11 +430,11 @@ func (pq *PixelQuery) GroupBy(field string, fields ...string) *PixelGroupBy {
 // Example:
 //
 //	var v []struct {
-//		Color string `json:"color,omitempty"`
+//		Position int `json:"position,omitempty"`
 //	}
 //
 //	client.Pixel.Query().
-//		Select(pixel.FieldColor).
+//		Select(pixel.FieldPosition).
 //		Scan(ctx, &v)
 func (pq *PixelQuery) Select(fields ...string) *PixelSelect {
 	pq.ctx.Fields = append(pq.ctx.Fields, fields...)
@@ -370,18 +478,14 @@ func (pq *PixelQuery) prepareQuery(ctx context.Context) error {
 func (pq *PixelQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Pixel, error) {
 	var (
 		nodes       = []*Pixel{}
-		withFKs     = pq.withFKs
 		_spec       = pq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [4]bool{
 			pq.withUser != nil,
+			pq.withBoard != nil,
+			pq.withTeam != nil,
+			pq.withChanges != nil,
 		}
 	)
-	if pq.withUser != nil {
-		withFKs = true
-	}
-	if withFKs {
-		_spec.Node.Columns = append(_spec.Node.Columns, pixel.ForeignKeys...)
-	}
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*Pixel).scanValues(nil, columns)
 	}
@@ -406,6 +510,25 @@ func (pq *PixelQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Pixel,
 			return nil, err
 		}
 	}
+	if query := pq.withBoard; query != nil {
+		if err := pq.loadBoard(ctx, query, nodes, nil,
+			func(n *Pixel, e *Board) { n.Edges.Board = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withTeam; query != nil {
+		if err := pq.loadTeam(ctx, query, nodes, nil,
+			func(n *Pixel, e *Team) { n.Edges.Team = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withChanges; query != nil {
+		if err := pq.loadChanges(ctx, query, nodes,
+			func(n *Pixel) { n.Edges.Changes = []*PixelChange{} },
+			func(n *Pixel, e *PixelChange) { n.Edges.Changes = append(n.Edges.Changes, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -413,10 +536,68 @@ func (pq *PixelQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*P
 	ids := make([]int64, 0, len(nodes))
 	nodeids := make(map[int64][]*Pixel)
 	for i := range nodes {
-		if nodes[i].user_pixels == nil {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (pq *PixelQuery) loadBoard(ctx context.Context, query *BoardQuery, nodes []*Pixel, init func(*Pixel), assign func(*Pixel, *Board)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Pixel)
+	for i := range nodes {
+		fk := nodes[i].BoardID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(board.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "board_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (pq *PixelQuery) loadTeam(ctx context.Context, query *TeamQuery, nodes []*Pixel, init func(*Pixel), assign func(*Pixel, *Team)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Pixel)
+	for i := range nodes {
+		if nodes[i].TeamID == nil {
 			continue
 		}
-		fk := *nodes[i].user_pixels
+		fk := *nodes[i].TeamID
 		if _, ok := nodeids[fk]; !ok {
 			ids = append(ids, fk)
 		}
@@ -425,7 +606,7 @@ func (pq *PixelQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*P
 	if len(ids) == 0 {
 		return nil
 	}
-	query.Where(user.IDIn(ids...))
+	query.Where(team.IDIn(ids...))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
@@ -433,7 +614,7 @@ func (pq *PixelQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*P
 	for _, n := range neighbors {
 		nodes, ok := nodeids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected foreign-key "user_pixels" returned %v`, n.ID)
+			return fmt.Errorf(`unexpected foreign-key "team_id" returned %v`, n.ID)
 		}
 		for i := range nodes {
 			assign(nodes[i], n)
@@ -441,6 +622,37 @@ func (pq *PixelQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*P
 	}
 	return nil
 }
+func (pq *PixelQuery) loadChanges(ctx context.Context, query *PixelChangeQuery, nodes []*Pixel, init func(*Pixel), assign func(*Pixel, *PixelChange)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Pixel)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.PixelChange(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(pixel.ChangesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.pixel_changes
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "pixel_changes" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "pixel_changes" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (pq *PixelQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := pq.querySpec()
@@ -467,6 +679,15 @@ func (pq *PixelQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if pq.withUser != nil {
+			_spec.Node.AddColumnOnce(pixel.FieldUserID)
+		}
+		if pq.withBoard != nil {
+			_spec.Node.AddColumnOnce(pixel.FieldBoardID)
+		}
+		if pq.withTeam != nil {
+			_spec.Node.AddColumnOnce(pixel.FieldTeamID)
+		}
 	}
 	if ps := pq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
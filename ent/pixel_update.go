@@ -6,8 +6,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"nevissGo/ent/board"
 	"nevissGo/ent/pixel"
+	"nevissGo/ent/pixelchange"
 	"nevissGo/ent/predicate"
+	"nevissGo/ent/team"
 	"nevissGo/ent/user"
 	"time"
 
@@ -29,6 +32,27 @@ func (pu *PixelUpdate) Where(ps ...predicate.Pixel) *PixelUpdate {
 	return pu
 }
 
+// SetPosition sets the "position" field.
+func (pu *PixelUpdate) SetPosition(i int) *PixelUpdate {
+	pu.mutation.ResetPosition()
+	pu.mutation.SetPosition(i)
+	return pu
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (pu *PixelUpdate) SetNillablePosition(i *int) *PixelUpdate {
+	if i != nil {
+		pu.SetPosition(*i)
+	}
+	return pu
+}
+
+// AddPosition adds i to the "position" field.
+func (pu *PixelUpdate) AddPosition(i int) *PixelUpdate {
+	pu.mutation.AddPosition(i)
+	return pu
+}
+
 // SetColor sets the "color" field.
 func (pu *PixelUpdate) SetColor(s string) *PixelUpdate {
 	pu.mutation.SetColor(s)
@@ -49,25 +73,117 @@ func (pu *PixelUpdate) SetUpdatedAt(t time.Time) *PixelUpdate {
 	return pu
 }
 
-// SetUserID sets the "user" edge to the User entity by ID.
-func (pu *PixelUpdate) SetUserID(id int64) *PixelUpdate {
-	pu.mutation.SetUserID(id)
+// SetSeq sets the "seq" field.
+func (pu *PixelUpdate) SetSeq(i int64) *PixelUpdate {
+	pu.mutation.ResetSeq()
+	pu.mutation.SetSeq(i)
+	return pu
+}
+
+// SetNillableSeq sets the "seq" field if the given value is not nil.
+func (pu *PixelUpdate) SetNillableSeq(i *int64) *PixelUpdate {
+	if i != nil {
+		pu.SetSeq(*i)
+	}
+	return pu
+}
+
+// AddSeq adds i to the "seq" field.
+func (pu *PixelUpdate) AddSeq(i int64) *PixelUpdate {
+	pu.mutation.AddSeq(i)
+	return pu
+}
+
+// SetUserID sets the "user_id" field.
+func (pu *PixelUpdate) SetUserID(i int64) *PixelUpdate {
+	pu.mutation.SetUserID(i)
+	return pu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (pu *PixelUpdate) SetNillableUserID(i *int64) *PixelUpdate {
+	if i != nil {
+		pu.SetUserID(*i)
+	}
+	return pu
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (pu *PixelUpdate) ClearUserID() *PixelUpdate {
+	pu.mutation.ClearUserID()
 	return pu
 }
 
-// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
-func (pu *PixelUpdate) SetNillableUserID(id *int64) *PixelUpdate {
-	if id != nil {
-		pu = pu.SetUserID(*id)
+// SetBoardID sets the "board_id" field.
+func (pu *PixelUpdate) SetBoardID(i int) *PixelUpdate {
+	pu.mutation.SetBoardID(i)
+	return pu
+}
+
+// SetNillableBoardID sets the "board_id" field if the given value is not nil.
+func (pu *PixelUpdate) SetNillableBoardID(i *int) *PixelUpdate {
+	if i != nil {
+		pu.SetBoardID(*i)
 	}
 	return pu
 }
 
+// ClearBoardID clears the value of the "board_id" field.
+func (pu *PixelUpdate) ClearBoardID() *PixelUpdate {
+	pu.mutation.ClearBoardID()
+	return pu
+}
+
+// SetTeamID sets the "team_id" field.
+func (pu *PixelUpdate) SetTeamID(i int) *PixelUpdate {
+	pu.mutation.SetTeamID(i)
+	return pu
+}
+
+// SetNillableTeamID sets the "team_id" field if the given value is not nil.
+func (pu *PixelUpdate) SetNillableTeamID(i *int) *PixelUpdate {
+	if i != nil {
+		pu.SetTeamID(*i)
+	}
+	return pu
+}
+
+// ClearTeamID clears the value of the "team_id" field.
+func (pu *PixelUpdate) ClearTeamID() *PixelUpdate {
+	pu.mutation.ClearTeamID()
+	return pu
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (pu *PixelUpdate) SetUser(u *User) *PixelUpdate {
 	return pu.SetUserID(u.ID)
 }
 
+// SetBoard sets the "board" edge to the Board entity.
+func (pu *PixelUpdate) SetBoard(b *Board) *PixelUpdate {
+	return pu.SetBoardID(b.ID)
+}
+
+// SetTeam sets the "team" edge to the Team entity.
+func (pu *PixelUpdate) SetTeam(t *Team) *PixelUpdate {
+	return pu.SetTeamID(t.ID)
+}
+
+// AddChangeIDs adds the "changes" edge to the PixelChange entity by IDs.
+func (pu *PixelUpdate) AddChangeIDs(ids ...int) *PixelUpdate {
+	pu.mutation.AddChangeIDs(ids...)
+	return pu
+}
+
+// AddChanges adds the "changes" edges to the PixelChange entity.
+func (pu *PixelUpdate) AddChanges(p ...*PixelChange) *PixelUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.AddChangeIDs(ids...)
+}
+
 // Mutation returns the PixelMutation object of the builder.
 func (pu *PixelUpdate) Mutation() *PixelMutation {
 	return pu.mutation
@@ -79,6 +195,39 @@ func (pu *PixelUpdate) ClearUser() *PixelUpdate {
 	return pu
 }
 
+// ClearBoard clears the "board" edge to the Board entity.
+func (pu *PixelUpdate) ClearBoard() *PixelUpdate {
+	pu.mutation.ClearBoard()
+	return pu
+}
+
+// ClearTeam clears the "team" edge to the Team entity.
+func (pu *PixelUpdate) ClearTeam() *PixelUpdate {
+	pu.mutation.ClearTeam()
+	return pu
+}
+
+// ClearChanges clears all "changes" edges to the PixelChange entity.
+func (pu *PixelUpdate) ClearChanges() *PixelUpdate {
+	pu.mutation.ClearChanges()
+	return pu
+}
+
+// RemoveChangeIDs removes the "changes" edge to PixelChange entities by IDs.
+func (pu *PixelUpdate) RemoveChangeIDs(ids ...int) *PixelUpdate {
+	pu.mutation.RemoveChangeIDs(ids...)
+	return pu
+}
+
+// RemoveChanges removes "changes" edges to PixelChange entities.
+func (pu *PixelUpdate) RemoveChanges(p ...*PixelChange) *PixelUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.RemoveChangeIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (pu *PixelUpdate) Save(ctx context.Context) (int, error) {
 	pu.defaults()
@@ -124,12 +273,24 @@ func (pu *PixelUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if value, ok := pu.mutation.Position(); ok {
+		_spec.SetField(pixel.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.AddedPosition(); ok {
+		_spec.AddField(pixel.FieldPosition, field.TypeInt, value)
+	}
 	if value, ok := pu.mutation.Color(); ok {
 		_spec.SetField(pixel.FieldColor, field.TypeString, value)
 	}
 	if value, ok := pu.mutation.UpdatedAt(); ok {
 		_spec.SetField(pixel.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := pu.mutation.Seq(); ok {
+		_spec.SetField(pixel.FieldSeq, field.TypeInt64, value)
+	}
+	if value, ok := pu.mutation.AddedSeq(); ok {
+		_spec.AddField(pixel.FieldSeq, field.TypeInt64, value)
+	}
 	if pu.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -159,6 +320,109 @@ func (pu *PixelUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if pu.mutation.BoardCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pixel.BoardTable,
+			Columns: []string{pixel.BoardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.BoardIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pixel.BoardTable,
+			Columns: []string{pixel.BoardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.TeamCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pixel.TeamTable,
+			Columns: []string{pixel.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.TeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pixel.TeamTable,
+			Columns: []string{pixel.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.ChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   pixel.ChangesTable,
+			Columns: []string{pixel.ChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pixelchange.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedChangesIDs(); len(nodes) > 0 && !pu.mutation.ChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   pixel.ChangesTable,
+			Columns: []string{pixel.ChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pixelchange.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.ChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   pixel.ChangesTable,
+			Columns: []string{pixel.ChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pixelchange.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{pixel.Label}
@@ -179,6 +443,27 @@ type PixelUpdateOne struct {
 	mutation *PixelMutation
 }
 
+// SetPosition sets the "position" field.
+func (puo *PixelUpdateOne) SetPosition(i int) *PixelUpdateOne {
+	puo.mutation.ResetPosition()
+	puo.mutation.SetPosition(i)
+	return puo
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (puo *PixelUpdateOne) SetNillablePosition(i *int) *PixelUpdateOne {
+	if i != nil {
+		puo.SetPosition(*i)
+	}
+	return puo
+}
+
+// AddPosition adds i to the "position" field.
+func (puo *PixelUpdateOne) AddPosition(i int) *PixelUpdateOne {
+	puo.mutation.AddPosition(i)
+	return puo
+}
+
 // SetColor sets the "color" field.
 func (puo *PixelUpdateOne) SetColor(s string) *PixelUpdateOne {
 	puo.mutation.SetColor(s)
@@ -199,25 +484,117 @@ func (puo *PixelUpdateOne) SetUpdatedAt(t time.Time) *PixelUpdateOne {
 	return puo
 }
 
-// SetUserID sets the "user" edge to the User entity by ID.
-func (puo *PixelUpdateOne) SetUserID(id int64) *PixelUpdateOne {
-	puo.mutation.SetUserID(id)
+// SetSeq sets the "seq" field.
+func (puo *PixelUpdateOne) SetSeq(i int64) *PixelUpdateOne {
+	puo.mutation.ResetSeq()
+	puo.mutation.SetSeq(i)
+	return puo
+}
+
+// SetNillableSeq sets the "seq" field if the given value is not nil.
+func (puo *PixelUpdateOne) SetNillableSeq(i *int64) *PixelUpdateOne {
+	if i != nil {
+		puo.SetSeq(*i)
+	}
+	return puo
+}
+
+// AddSeq adds i to the "seq" field.
+func (puo *PixelUpdateOne) AddSeq(i int64) *PixelUpdateOne {
+	puo.mutation.AddSeq(i)
+	return puo
+}
+
+// SetUserID sets the "user_id" field.
+func (puo *PixelUpdateOne) SetUserID(i int64) *PixelUpdateOne {
+	puo.mutation.SetUserID(i)
+	return puo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (puo *PixelUpdateOne) SetNillableUserID(i *int64) *PixelUpdateOne {
+	if i != nil {
+		puo.SetUserID(*i)
+	}
+	return puo
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (puo *PixelUpdateOne) ClearUserID() *PixelUpdateOne {
+	puo.mutation.ClearUserID()
 	return puo
 }
 
-// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
-func (puo *PixelUpdateOne) SetNillableUserID(id *int64) *PixelUpdateOne {
-	if id != nil {
-		puo = puo.SetUserID(*id)
+// SetBoardID sets the "board_id" field.
+func (puo *PixelUpdateOne) SetBoardID(i int) *PixelUpdateOne {
+	puo.mutation.SetBoardID(i)
+	return puo
+}
+
+// SetNillableBoardID sets the "board_id" field if the given value is not nil.
+func (puo *PixelUpdateOne) SetNillableBoardID(i *int) *PixelUpdateOne {
+	if i != nil {
+		puo.SetBoardID(*i)
 	}
 	return puo
 }
 
+// ClearBoardID clears the value of the "board_id" field.
+func (puo *PixelUpdateOne) ClearBoardID() *PixelUpdateOne {
+	puo.mutation.ClearBoardID()
+	return puo
+}
+
+// SetTeamID sets the "team_id" field.
+func (puo *PixelUpdateOne) SetTeamID(i int) *PixelUpdateOne {
+	puo.mutation.SetTeamID(i)
+	return puo
+}
+
+// SetNillableTeamID sets the "team_id" field if the given value is not nil.
+func (puo *PixelUpdateOne) SetNillableTeamID(i *int) *PixelUpdateOne {
+	if i != nil {
+		puo.SetTeamID(*i)
+	}
+	return puo
+}
+
+// ClearTeamID clears the value of the "team_id" field.
+func (puo *PixelUpdateOne) ClearTeamID() *PixelUpdateOne {
+	puo.mutation.ClearTeamID()
+	return puo
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (puo *PixelUpdateOne) SetUser(u *User) *PixelUpdateOne {
 	return puo.SetUserID(u.ID)
 }
 
+// SetBoard sets the "board" edge to the Board entity.
+func (puo *PixelUpdateOne) SetBoard(b *Board) *PixelUpdateOne {
+	return puo.SetBoardID(b.ID)
+}
+
+// SetTeam sets the "team" edge to the Team entity.
+func (puo *PixelUpdateOne) SetTeam(t *Team) *PixelUpdateOne {
+	return puo.SetTeamID(t.ID)
+}
+
+// AddChangeIDs adds the "changes" edge to the PixelChange entity by IDs.
+func (puo *PixelUpdateOne) AddChangeIDs(ids ...int) *PixelUpdateOne {
+	puo.mutation.AddChangeIDs(ids...)
+	return puo
+}
+
+// AddChanges adds the "changes" edges to the PixelChange entity.
+func (puo *PixelUpdateOne) AddChanges(p ...*PixelChange) *PixelUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.AddChangeIDs(ids...)
+}
+
 // Mutation returns the PixelMutation object of the builder.
 func (puo *PixelUpdateOne) Mutation() *PixelMutation {
 	return puo.mutation
@@ -229,6 +606,39 @@ func (puo *PixelUpdateOne) ClearUser() *PixelUpdateOne {
 	return puo
 }
 
+// ClearBoard clears the "board" edge to the Board entity.
+func (puo *PixelUpdateOne) ClearBoard() *PixelUpdateOne {
+	puo.mutation.ClearBoard()
+	return puo
+}
+
+// ClearTeam clears the "team" edge to the Team entity.
+func (puo *PixelUpdateOne) ClearTeam() *PixelUpdateOne {
+	puo.mutation.ClearTeam()
+	return puo
+}
+
+// ClearChanges clears all "changes" edges to the PixelChange entity.
+func (puo *PixelUpdateOne) ClearChanges() *PixelUpdateOne {
+	puo.mutation.ClearChanges()
+	return puo
+}
+
+// RemoveChangeIDs removes the "changes" edge to PixelChange entities by IDs.
+func (puo *PixelUpdateOne) RemoveChangeIDs(ids ...int) *PixelUpdateOne {
+	puo.mutation.RemoveChangeIDs(ids...)
+	return puo
+}
+
+// RemoveChanges removes "changes" edges to PixelChange entities.
+func (puo *PixelUpdateOne) RemoveChanges(p ...*PixelChange) *PixelUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.RemoveChangeIDs(ids...)
+}
+
 // Where appends a list predicates to the PixelUpdate builder.
 func (puo *PixelUpdateOne) Where(ps ...predicate.Pixel) *PixelUpdateOne {
 	puo.mutation.Where(ps...)
@@ -304,12 +714,24 @@ func (puo *PixelUpdateOne) sqlSave(ctx context.Context) (_node *Pixel, err error
 			}
 		}
 	}
+	if value, ok := puo.mutation.Position(); ok {
+		_spec.SetField(pixel.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.AddedPosition(); ok {
+		_spec.AddField(pixel.FieldPosition, field.TypeInt, value)
+	}
 	if value, ok := puo.mutation.Color(); ok {
 		_spec.SetField(pixel.FieldColor, field.TypeString, value)
 	}
 	if value, ok := puo.mutation.UpdatedAt(); ok {
 		_spec.SetField(pixel.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := puo.mutation.Seq(); ok {
+		_spec.SetField(pixel.FieldSeq, field.TypeInt64, value)
+	}
+	if value, ok := puo.mutation.AddedSeq(); ok {
+		_spec.AddField(pixel.FieldSeq, field.TypeInt64, value)
+	}
 	if puo.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -339,6 +761,109 @@ func (puo *PixelUpdateOne) sqlSave(ctx context.Context) (_node *Pixel, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if puo.mutation.BoardCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pixel.BoardTable,
+			Columns: []string{pixel.BoardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.BoardIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pixel.BoardTable,
+			Columns: []string{pixel.BoardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.TeamCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pixel.TeamTable,
+			Columns: []string{pixel.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.TeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pixel.TeamTable,
+			Columns: []string{pixel.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.ChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   pixel.ChangesTable,
+			Columns: []string{pixel.ChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pixelchange.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedChangesIDs(); len(nodes) > 0 && !puo.mutation.ChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   pixel.ChangesTable,
+			Columns: []string{pixel.ChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pixelchange.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.ChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   pixel.ChangesTable,
+			Columns: []string{pixel.ChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pixelchange.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Pixel{config: puo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,282 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"nevissGo/ent/board"
+	"nevissGo/ent/pixel"
+	"nevissGo/ent/pixelchange"
+	"nevissGo/ent/team"
+	"nevissGo/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// PixelChange is the model entity for the PixelChange schema.
+type PixelChange struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Position holds the value of the "position" field.
+	Position int `json:"position,omitempty"`
+	// OldColor holds the value of the "old_color" field.
+	OldColor string `json:"old_color,omitempty"`
+	// NewColor holds the value of the "new_color" field.
+	NewColor string `json:"new_color,omitempty"`
+	// Seq holds the value of the "seq" field.
+	Seq int64 `json:"seq,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// BoardID holds the value of the "board_id" field.
+	BoardID int `json:"board_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int64 `json:"user_id,omitempty"`
+	// TeamID holds the value of the "team_id" field.
+	TeamID *int `json:"team_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PixelChangeQuery when eager-loading is set.
+	Edges         PixelChangeEdges `json:"edges"`
+	pixel_changes *int
+	selectValues  sql.SelectValues
+}
+
+// PixelChangeEdges holds the relations/edges for other nodes in the graph.
+type PixelChangeEdges struct {
+	// Pixel holds the value of the pixel edge.
+	Pixel *Pixel `json:"pixel,omitempty"`
+	// Board holds the value of the board edge.
+	Board *Board `json:"board,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Team holds the value of the team edge.
+	Team *Team `json:"team,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// PixelOrErr returns the Pixel value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PixelChangeEdges) PixelOrErr() (*Pixel, error) {
+	if e.Pixel != nil {
+		return e.Pixel, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: pixel.Label}
+	}
+	return nil, &NotLoadedError{edge: "pixel"}
+}
+
+// BoardOrErr returns the Board value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PixelChangeEdges) BoardOrErr() (*Board, error) {
+	if e.Board != nil {
+		return e.Board, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: board.Label}
+	}
+	return nil, &NotLoadedError{edge: "board"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PixelChangeEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// TeamOrErr returns the Team value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PixelChangeEdges) TeamOrErr() (*Team, error) {
+	if e.Team != nil {
+		return e.Team, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: team.Label}
+	}
+	return nil, &NotLoadedError{edge: "team"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PixelChange) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case pixelchange.FieldID, pixelchange.FieldPosition, pixelchange.FieldSeq, pixelchange.FieldBoardID, pixelchange.FieldUserID, pixelchange.FieldTeamID:
+			values[i] = new(sql.NullInt64)
+		case pixelchange.FieldOldColor, pixelchange.FieldNewColor:
+			values[i] = new(sql.NullString)
+		case pixelchange.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case pixelchange.ForeignKeys[0]: // pixel_changes
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PixelChange fields.
+func (pc *PixelChange) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case pixelchange.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pc.ID = int(value.Int64)
+		case pixelchange.FieldPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				pc.Position = int(value.Int64)
+			}
+		case pixelchange.FieldOldColor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field old_color", values[i])
+			} else if value.Valid {
+				pc.OldColor = value.String
+			}
+		case pixelchange.FieldNewColor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field new_color", values[i])
+			} else if value.Valid {
+				pc.NewColor = value.String
+			}
+		case pixelchange.FieldSeq:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field seq", values[i])
+			} else if value.Valid {
+				pc.Seq = value.Int64
+			}
+		case pixelchange.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pc.CreatedAt = value.Time
+			}
+		case pixelchange.FieldBoardID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field board_id", values[i])
+			} else if value.Valid {
+				pc.BoardID = int(value.Int64)
+			}
+		case pixelchange.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				pc.UserID = value.Int64
+			}
+		case pixelchange.FieldTeamID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field team_id", values[i])
+			} else if value.Valid {
+				pc.TeamID = new(int)
+				*pc.TeamID = int(value.Int64)
+			}
+		case pixelchange.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field pixel_changes", value)
+			} else if value.Valid {
+				pc.pixel_changes = new(int)
+				*pc.pixel_changes = int(value.Int64)
+			}
+		default:
+			pc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PixelChange.
+// This includes values selected through modifiers, order, etc.
+func (pc *PixelChange) Value(name string) (ent.Value, error) {
+	return pc.selectValues.Get(name)
+}
+
+// QueryPixel queries the "pixel" edge of the PixelChange entity.
+func (pc *PixelChange) QueryPixel() *PixelQuery {
+	return NewPixelChangeClient(pc.config).QueryPixel(pc)
+}
+
+// QueryBoard queries the "board" edge of the PixelChange entity.
+func (pc *PixelChange) QueryBoard() *BoardQuery {
+	return NewPixelChangeClient(pc.config).QueryBoard(pc)
+}
+
+// QueryUser queries the "user" edge of the PixelChange entity.
+func (pc *PixelChange) QueryUser() *UserQuery {
+	return NewPixelChangeClient(pc.config).QueryUser(pc)
+}
+
+// QueryTeam queries the "team" edge of the PixelChange entity.
+func (pc *PixelChange) QueryTeam() *TeamQuery {
+	return NewPixelChangeClient(pc.config).QueryTeam(pc)
+}
+
+// Update returns a builder for updating this PixelChange.
+// Note that you need to call PixelChange.Unwrap() before calling this method if this PixelChange
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pc *PixelChange) Update() *PixelChangeUpdateOne {
+	return NewPixelChangeClient(pc.config).UpdateOne(pc)
+}
+
+// Unwrap unwraps the PixelChange entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pc *PixelChange) Unwrap() *PixelChange {
+	_tx, ok := pc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PixelChange is not a transactional entity")
+	}
+	pc.config.driver = _tx.drv
+	return pc
+}
+
+// String implements the fmt.Stringer.
+func (pc *PixelChange) String() string {
+	var builder strings.Builder
+	builder.WriteString("PixelChange(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pc.ID))
+	builder.WriteString("position=")
+	builder.WriteString(fmt.Sprintf("%v", pc.Position))
+	builder.WriteString(", ")
+	builder.WriteString("old_color=")
+	builder.WriteString(pc.OldColor)
+	builder.WriteString(", ")
+	builder.WriteString("new_color=")
+	builder.WriteString(pc.NewColor)
+	builder.WriteString(", ")
+	builder.WriteString("seq=")
+	builder.WriteString(fmt.Sprintf("%v", pc.Seq))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pc.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("board_id=")
+	builder.WriteString(fmt.Sprintf("%v", pc.BoardID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", pc.UserID))
+	builder.WriteString(", ")
+	if v := pc.TeamID; v != nil {
+		builder.WriteString("team_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PixelChanges is a parsable slice of PixelChange.
+type PixelChanges []*PixelChange
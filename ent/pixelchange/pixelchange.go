@@ -0,0 +1,214 @@
+// Code generated by ent, DO NOT EDIT.
+
+package pixelchange
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the pixelchange type in the database.
+	Label = "pixel_change"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldPosition holds the string denoting the position field in the database.
+	FieldPosition = "position"
+	// FieldOldColor holds the string denoting the old_color field in the database.
+	FieldOldColor = "old_color"
+	// FieldNewColor holds the string denoting the new_color field in the database.
+	FieldNewColor = "new_color"
+	// FieldSeq holds the string denoting the seq field in the database.
+	FieldSeq = "seq"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldBoardID holds the string denoting the board_id field in the database.
+	FieldBoardID = "board_changes"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_changes"
+	// FieldTeamID holds the string denoting the team_id field in the database.
+	FieldTeamID = "team_id"
+	// EdgePixel holds the string denoting the pixel edge name in mutations.
+	EdgePixel = "pixel"
+	// EdgeBoard holds the string denoting the board edge name in mutations.
+	EdgeBoard = "board"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// EdgeTeam holds the string denoting the team edge name in mutations.
+	EdgeTeam = "team"
+	// Table holds the table name of the pixelchange in the database.
+	Table = "pixel_changes"
+	// PixelTable is the table that holds the pixel relation/edge.
+	PixelTable = "pixel_changes"
+	// PixelInverseTable is the table name for the Pixel entity.
+	// It exists in this package in order to avoid circular dependency with the "pixel" package.
+	PixelInverseTable = "pixels"
+	// PixelColumn is the table column denoting the pixel relation/edge.
+	PixelColumn = "pixel_changes"
+	// BoardTable is the table that holds the board relation/edge.
+	BoardTable = "pixel_changes"
+	// BoardInverseTable is the table name for the Board entity.
+	// It exists in this package in order to avoid circular dependency with the "board" package.
+	BoardInverseTable = "boards"
+	// BoardColumn is the table column denoting the board relation/edge.
+	BoardColumn = "board_changes"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "pixel_changes"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_changes"
+	// TeamTable is the table that holds the team relation/edge.
+	TeamTable = "pixel_changes"
+	// TeamInverseTable is the table name for the Team entity.
+	// It exists in this package in order to avoid circular dependency with the "team" package.
+	TeamInverseTable = "teams"
+	// TeamColumn is the table column denoting the team relation/edge.
+	TeamColumn = "team_id"
+)
+
+// Columns holds all SQL columns for pixelchange fields.
+var Columns = []string{
+	FieldID,
+	FieldPosition,
+	FieldOldColor,
+	FieldNewColor,
+	FieldSeq,
+	FieldCreatedAt,
+	FieldBoardID,
+	FieldUserID,
+	FieldTeamID,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "pixel_changes"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"pixel_changes",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the PixelChange queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByPosition orders the results by the position field.
+func ByPosition(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPosition, opts...).ToFunc()
+}
+
+// ByOldColor orders the results by the old_color field.
+func ByOldColor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOldColor, opts...).ToFunc()
+}
+
+// ByNewColor orders the results by the new_color field.
+func ByNewColor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNewColor, opts...).ToFunc()
+}
+
+// BySeq orders the results by the seq field.
+func BySeq(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeq, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByBoardID orders the results by the board_id field.
+func ByBoardID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBoardID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByTeamID orders the results by the team_id field.
+func ByTeamID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTeamID, opts...).ToFunc()
+}
+
+// ByPixelField orders the results by pixel field.
+func ByPixelField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPixelStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByBoardField orders the results by board field.
+func ByBoardField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBoardStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByTeamField orders the results by team field.
+func ByTeamField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTeamStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newPixelStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PixelInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PixelTable, PixelColumn),
+	)
+}
+func newBoardStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BoardInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, BoardTable, BoardColumn),
+	)
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+	)
+}
+func newTeamStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TeamInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TeamTable, TeamColumn),
+	)
+}
@@ -0,0 +1,533 @@
+// Code generated by ent, DO NOT EDIT.
+
+package pixelchange
+
+import (
+	"nevissGo/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldLTE(FieldID, id))
+}
+
+// Position applies equality check predicate on the "position" field. It's identical to PositionEQ.
+func Position(v int) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldEQ(FieldPosition, v))
+}
+
+// OldColor applies equality check predicate on the "old_color" field. It's identical to OldColorEQ.
+func OldColor(v string) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldEQ(FieldOldColor, v))
+}
+
+// NewColor applies equality check predicate on the "new_color" field. It's identical to NewColorEQ.
+func NewColor(v string) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldEQ(FieldNewColor, v))
+}
+
+// Seq applies equality check predicate on the "seq" field. It's identical to SeqEQ.
+func Seq(v int64) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldEQ(FieldSeq, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// BoardID applies equality check predicate on the "board_id" field. It's identical to BoardIDEQ.
+func BoardID(v int) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldEQ(FieldBoardID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int64) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldEQ(FieldUserID, v))
+}
+
+// TeamID applies equality check predicate on the "team_id" field. It's identical to TeamIDEQ.
+func TeamID(v int) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldEQ(FieldTeamID, v))
+}
+
+// PositionEQ applies the EQ predicate on the "position" field.
+func PositionEQ(v int) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldEQ(FieldPosition, v))
+}
+
+// PositionNEQ applies the NEQ predicate on the "position" field.
+func PositionNEQ(v int) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldNEQ(FieldPosition, v))
+}
+
+// PositionIn applies the In predicate on the "position" field.
+func PositionIn(vs ...int) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldIn(FieldPosition, vs...))
+}
+
+// PositionNotIn applies the NotIn predicate on the "position" field.
+func PositionNotIn(vs ...int) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldNotIn(FieldPosition, vs...))
+}
+
+// PositionGT applies the GT predicate on the "position" field.
+func PositionGT(v int) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldGT(FieldPosition, v))
+}
+
+// PositionGTE applies the GTE predicate on the "position" field.
+func PositionGTE(v int) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldGTE(FieldPosition, v))
+}
+
+// PositionLT applies the LT predicate on the "position" field.
+func PositionLT(v int) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldLT(FieldPosition, v))
+}
+
+// PositionLTE applies the LTE predicate on the "position" field.
+func PositionLTE(v int) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldLTE(FieldPosition, v))
+}
+
+// OldColorEQ applies the EQ predicate on the "old_color" field.
+func OldColorEQ(v string) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldEQ(FieldOldColor, v))
+}
+
+// OldColorNEQ applies the NEQ predicate on the "old_color" field.
+func OldColorNEQ(v string) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldNEQ(FieldOldColor, v))
+}
+
+// OldColorIn applies the In predicate on the "old_color" field.
+func OldColorIn(vs ...string) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldIn(FieldOldColor, vs...))
+}
+
+// OldColorNotIn applies the NotIn predicate on the "old_color" field.
+func OldColorNotIn(vs ...string) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldNotIn(FieldOldColor, vs...))
+}
+
+// OldColorGT applies the GT predicate on the "old_color" field.
+func OldColorGT(v string) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldGT(FieldOldColor, v))
+}
+
+// OldColorGTE applies the GTE predicate on the "old_color" field.
+func OldColorGTE(v string) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldGTE(FieldOldColor, v))
+}
+
+// OldColorLT applies the LT predicate on the "old_color" field.
+func OldColorLT(v string) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldLT(FieldOldColor, v))
+}
+
+// OldColorLTE applies the LTE predicate on the "old_color" field.
+func OldColorLTE(v string) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldLTE(FieldOldColor, v))
+}
+
+// OldColorContains applies the Contains predicate on the "old_color" field.
+func OldColorContains(v string) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldContains(FieldOldColor, v))
+}
+
+// OldColorHasPrefix applies the HasPrefix predicate on the "old_color" field.
+func OldColorHasPrefix(v string) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldHasPrefix(FieldOldColor, v))
+}
+
+// OldColorHasSuffix applies the HasSuffix predicate on the "old_color" field.
+func OldColorHasSuffix(v string) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldHasSuffix(FieldOldColor, v))
+}
+
+// OldColorEqualFold applies the EqualFold predicate on the "old_color" field.
+func OldColorEqualFold(v string) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldEqualFold(FieldOldColor, v))
+}
+
+// OldColorContainsFold applies the ContainsFold predicate on the "old_color" field.
+func OldColorContainsFold(v string) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldContainsFold(FieldOldColor, v))
+}
+
+// NewColorEQ applies the EQ predicate on the "new_color" field.
+func NewColorEQ(v string) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldEQ(FieldNewColor, v))
+}
+
+// NewColorNEQ applies the NEQ predicate on the "new_color" field.
+func NewColorNEQ(v string) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldNEQ(FieldNewColor, v))
+}
+
+// NewColorIn applies the In predicate on the "new_color" field.
+func NewColorIn(vs ...string) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldIn(FieldNewColor, vs...))
+}
+
+// NewColorNotIn applies the NotIn predicate on the "new_color" field.
+func NewColorNotIn(vs ...string) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldNotIn(FieldNewColor, vs...))
+}
+
+// NewColorGT applies the GT predicate on the "new_color" field.
+func NewColorGT(v string) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldGT(FieldNewColor, v))
+}
+
+// NewColorGTE applies the GTE predicate on the "new_color" field.
+func NewColorGTE(v string) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldGTE(FieldNewColor, v))
+}
+
+// NewColorLT applies the LT predicate on the "new_color" field.
+func NewColorLT(v string) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldLT(FieldNewColor, v))
+}
+
+// NewColorLTE applies the LTE predicate on the "new_color" field.
+func NewColorLTE(v string) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldLTE(FieldNewColor, v))
+}
+
+// NewColorContains applies the Contains predicate on the "new_color" field.
+func NewColorContains(v string) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldContains(FieldNewColor, v))
+}
+
+// NewColorHasPrefix applies the HasPrefix predicate on the "new_color" field.
+func NewColorHasPrefix(v string) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldHasPrefix(FieldNewColor, v))
+}
+
+// NewColorHasSuffix applies the HasSuffix predicate on the "new_color" field.
+func NewColorHasSuffix(v string) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldHasSuffix(FieldNewColor, v))
+}
+
+// NewColorEqualFold applies the EqualFold predicate on the "new_color" field.
+func NewColorEqualFold(v string) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldEqualFold(FieldNewColor, v))
+}
+
+// NewColorContainsFold applies the ContainsFold predicate on the "new_color" field.
+func NewColorContainsFold(v string) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldContainsFold(FieldNewColor, v))
+}
+
+// SeqEQ applies the EQ predicate on the "seq" field.
+func SeqEQ(v int64) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldEQ(FieldSeq, v))
+}
+
+// SeqNEQ applies the NEQ predicate on the "seq" field.
+func SeqNEQ(v int64) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldNEQ(FieldSeq, v))
+}
+
+// SeqIn applies the In predicate on the "seq" field.
+func SeqIn(vs ...int64) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldIn(FieldSeq, vs...))
+}
+
+// SeqNotIn applies the NotIn predicate on the "seq" field.
+func SeqNotIn(vs ...int64) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldNotIn(FieldSeq, vs...))
+}
+
+// SeqGT applies the GT predicate on the "seq" field.
+func SeqGT(v int64) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldGT(FieldSeq, v))
+}
+
+// SeqGTE applies the GTE predicate on the "seq" field.
+func SeqGTE(v int64) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldGTE(FieldSeq, v))
+}
+
+// SeqLT applies the LT predicate on the "seq" field.
+func SeqLT(v int64) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldLT(FieldSeq, v))
+}
+
+// SeqLTE applies the LTE predicate on the "seq" field.
+func SeqLTE(v int64) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldLTE(FieldSeq, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// BoardIDEQ applies the EQ predicate on the "board_id" field.
+func BoardIDEQ(v int) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldEQ(FieldBoardID, v))
+}
+
+// BoardIDNEQ applies the NEQ predicate on the "board_id" field.
+func BoardIDNEQ(v int) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldNEQ(FieldBoardID, v))
+}
+
+// BoardIDIn applies the In predicate on the "board_id" field.
+func BoardIDIn(vs ...int) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldIn(FieldBoardID, vs...))
+}
+
+// BoardIDNotIn applies the NotIn predicate on the "board_id" field.
+func BoardIDNotIn(vs ...int) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldNotIn(FieldBoardID, vs...))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int64) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int64) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int64) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int64) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDIsNil applies the IsNil predicate on the "user_id" field.
+func UserIDIsNil() predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldIsNull(FieldUserID))
+}
+
+// UserIDNotNil applies the NotNil predicate on the "user_id" field.
+func UserIDNotNil() predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldNotNull(FieldUserID))
+}
+
+// TeamIDEQ applies the EQ predicate on the "team_id" field.
+func TeamIDEQ(v int) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldEQ(FieldTeamID, v))
+}
+
+// TeamIDNEQ applies the NEQ predicate on the "team_id" field.
+func TeamIDNEQ(v int) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldNEQ(FieldTeamID, v))
+}
+
+// TeamIDIn applies the In predicate on the "team_id" field.
+func TeamIDIn(vs ...int) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldIn(FieldTeamID, vs...))
+}
+
+// TeamIDNotIn applies the NotIn predicate on the "team_id" field.
+func TeamIDNotIn(vs ...int) predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldNotIn(FieldTeamID, vs...))
+}
+
+// TeamIDIsNil applies the IsNil predicate on the "team_id" field.
+func TeamIDIsNil() predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldIsNull(FieldTeamID))
+}
+
+// TeamIDNotNil applies the NotNil predicate on the "team_id" field.
+func TeamIDNotNil() predicate.PixelChange {
+	return predicate.PixelChange(sql.FieldNotNull(FieldTeamID))
+}
+
+// HasPixel applies the HasEdge predicate on the "pixel" edge.
+func HasPixel() predicate.PixelChange {
+	return predicate.PixelChange(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PixelTable, PixelColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPixelWith applies the HasEdge predicate on the "pixel" edge with a given conditions (other predicates).
+func HasPixelWith(preds ...predicate.Pixel) predicate.PixelChange {
+	return predicate.PixelChange(func(s *sql.Selector) {
+		step := newPixelStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBoard applies the HasEdge predicate on the "board" edge.
+func HasBoard() predicate.PixelChange {
+	return predicate.PixelChange(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BoardTable, BoardColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBoardWith applies the HasEdge predicate on the "board" edge with a given conditions (other predicates).
+func HasBoardWith(preds ...predicate.Board) predicate.PixelChange {
+	return predicate.PixelChange(func(s *sql.Selector) {
+		step := newBoardStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.PixelChange {
+	return predicate.PixelChange(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.PixelChange {
+	return predicate.PixelChange(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTeam applies the HasEdge predicate on the "team" edge.
+func HasTeam() predicate.PixelChange {
+	return predicate.PixelChange(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TeamTable, TeamColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTeamWith applies the HasEdge predicate on the "team" edge with a given conditions (other predicates).
+func HasTeamWith(preds ...predicate.Team) predicate.PixelChange {
+	return predicate.PixelChange(func(s *sql.Selector) {
+		step := newTeamStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PixelChange) predicate.PixelChange {
+	return predicate.PixelChange(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PixelChange) predicate.PixelChange {
+	return predicate.PixelChange(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PixelChange) predicate.PixelChange {
+	return predicate.PixelChange(sql.NotPredicates(p))
+}
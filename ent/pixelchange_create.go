@@ -0,0 +1,400 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"nevissGo/ent/board"
+	"nevissGo/ent/pixel"
+	"nevissGo/ent/pixelchange"
+	"nevissGo/ent/team"
+	"nevissGo/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PixelChangeCreate is the builder for creating a PixelChange entity.
+type PixelChangeCreate struct {
+	config
+	mutation *PixelChangeMutation
+	hooks    []Hook
+}
+
+// SetPosition sets the "position" field.
+func (pcc *PixelChangeCreate) SetPosition(i int) *PixelChangeCreate {
+	pcc.mutation.SetPosition(i)
+	return pcc
+}
+
+// SetOldColor sets the "old_color" field.
+func (pcc *PixelChangeCreate) SetOldColor(s string) *PixelChangeCreate {
+	pcc.mutation.SetOldColor(s)
+	return pcc
+}
+
+// SetNewColor sets the "new_color" field.
+func (pcc *PixelChangeCreate) SetNewColor(s string) *PixelChangeCreate {
+	pcc.mutation.SetNewColor(s)
+	return pcc
+}
+
+// SetSeq sets the "seq" field.
+func (pcc *PixelChangeCreate) SetSeq(i int64) *PixelChangeCreate {
+	pcc.mutation.SetSeq(i)
+	return pcc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pcc *PixelChangeCreate) SetCreatedAt(t time.Time) *PixelChangeCreate {
+	pcc.mutation.SetCreatedAt(t)
+	return pcc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pcc *PixelChangeCreate) SetNillableCreatedAt(t *time.Time) *PixelChangeCreate {
+	if t != nil {
+		pcc.SetCreatedAt(*t)
+	}
+	return pcc
+}
+
+// SetBoardID sets the "board_id" field.
+func (pcc *PixelChangeCreate) SetBoardID(i int) *PixelChangeCreate {
+	pcc.mutation.SetBoardID(i)
+	return pcc
+}
+
+// SetUserID sets the "user_id" field.
+func (pcc *PixelChangeCreate) SetUserID(i int64) *PixelChangeCreate {
+	pcc.mutation.SetUserID(i)
+	return pcc
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (pcc *PixelChangeCreate) SetNillableUserID(i *int64) *PixelChangeCreate {
+	if i != nil {
+		pcc.SetUserID(*i)
+	}
+	return pcc
+}
+
+// SetTeamID sets the "team_id" field.
+func (pcc *PixelChangeCreate) SetTeamID(i int) *PixelChangeCreate {
+	pcc.mutation.SetTeamID(i)
+	return pcc
+}
+
+// SetNillableTeamID sets the "team_id" field if the given value is not nil.
+func (pcc *PixelChangeCreate) SetNillableTeamID(i *int) *PixelChangeCreate {
+	if i != nil {
+		pcc.SetTeamID(*i)
+	}
+	return pcc
+}
+
+// SetPixelID sets the "pixel" edge to the Pixel entity by ID.
+func (pcc *PixelChangeCreate) SetPixelID(id int) *PixelChangeCreate {
+	pcc.mutation.SetPixelID(id)
+	return pcc
+}
+
+// SetNillablePixelID sets the "pixel" edge to the Pixel entity by ID if the given value is not nil.
+func (pcc *PixelChangeCreate) SetNillablePixelID(id *int) *PixelChangeCreate {
+	if id != nil {
+		pcc = pcc.SetPixelID(*id)
+	}
+	return pcc
+}
+
+// SetPixel sets the "pixel" edge to the Pixel entity.
+func (pcc *PixelChangeCreate) SetPixel(p *Pixel) *PixelChangeCreate {
+	return pcc.SetPixelID(p.ID)
+}
+
+// SetBoard sets the "board" edge to the Board entity.
+func (pcc *PixelChangeCreate) SetBoard(b *Board) *PixelChangeCreate {
+	return pcc.SetBoardID(b.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (pcc *PixelChangeCreate) SetUser(u *User) *PixelChangeCreate {
+	return pcc.SetUserID(u.ID)
+}
+
+// SetTeam sets the "team" edge to the Team entity.
+func (pcc *PixelChangeCreate) SetTeam(t *Team) *PixelChangeCreate {
+	return pcc.SetTeamID(t.ID)
+}
+
+// Mutation returns the PixelChangeMutation object of the builder.
+func (pcc *PixelChangeCreate) Mutation() *PixelChangeMutation {
+	return pcc.mutation
+}
+
+// Save creates the PixelChange in the database.
+func (pcc *PixelChangeCreate) Save(ctx context.Context) (*PixelChange, error) {
+	pcc.defaults()
+	return withHooks(ctx, pcc.sqlSave, pcc.mutation, pcc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pcc *PixelChangeCreate) SaveX(ctx context.Context) *PixelChange {
+	v, err := pcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pcc *PixelChangeCreate) Exec(ctx context.Context) error {
+	_, err := pcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcc *PixelChangeCreate) ExecX(ctx context.Context) {
+	if err := pcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pcc *PixelChangeCreate) defaults() {
+	if _, ok := pcc.mutation.CreatedAt(); !ok {
+		v := pixelchange.DefaultCreatedAt()
+		pcc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pcc *PixelChangeCreate) check() error {
+	if _, ok := pcc.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "PixelChange.position"`)}
+	}
+	if _, ok := pcc.mutation.OldColor(); !ok {
+		return &ValidationError{Name: "old_color", err: errors.New(`ent: missing required field "PixelChange.old_color"`)}
+	}
+	if _, ok := pcc.mutation.NewColor(); !ok {
+		return &ValidationError{Name: "new_color", err: errors.New(`ent: missing required field "PixelChange.new_color"`)}
+	}
+	if _, ok := pcc.mutation.Seq(); !ok {
+		return &ValidationError{Name: "seq", err: errors.New(`ent: missing required field "PixelChange.seq"`)}
+	}
+	if _, ok := pcc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PixelChange.created_at"`)}
+	}
+	if _, ok := pcc.mutation.BoardID(); !ok {
+		return &ValidationError{Name: "board_id", err: errors.New(`ent: missing required field "PixelChange.board_id"`)}
+	}
+	if len(pcc.mutation.BoardIDs()) == 0 {
+		return &ValidationError{Name: "board", err: errors.New(`ent: missing required edge "PixelChange.board"`)}
+	}
+	return nil
+}
+
+func (pcc *PixelChangeCreate) sqlSave(ctx context.Context) (*PixelChange, error) {
+	if err := pcc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	pcc.mutation.id = &_node.ID
+	pcc.mutation.done = true
+	return _node, nil
+}
+
+func (pcc *PixelChangeCreate) createSpec() (*PixelChange, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PixelChange{config: pcc.config}
+		_spec = sqlgraph.NewCreateSpec(pixelchange.Table, sqlgraph.NewFieldSpec(pixelchange.FieldID, field.TypeInt))
+	)
+	if value, ok := pcc.mutation.Position(); ok {
+		_spec.SetField(pixelchange.FieldPosition, field.TypeInt, value)
+		_node.Position = value
+	}
+	if value, ok := pcc.mutation.OldColor(); ok {
+		_spec.SetField(pixelchange.FieldOldColor, field.TypeString, value)
+		_node.OldColor = value
+	}
+	if value, ok := pcc.mutation.NewColor(); ok {
+		_spec.SetField(pixelchange.FieldNewColor, field.TypeString, value)
+		_node.NewColor = value
+	}
+	if value, ok := pcc.mutation.Seq(); ok {
+		_spec.SetField(pixelchange.FieldSeq, field.TypeInt64, value)
+		_node.Seq = value
+	}
+	if value, ok := pcc.mutation.CreatedAt(); ok {
+		_spec.SetField(pixelchange.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := pcc.mutation.PixelIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pixelchange.PixelTable,
+			Columns: []string{pixelchange.PixelColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pixel.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.pixel_changes = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pcc.mutation.BoardIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pixelchange.BoardTable,
+			Columns: []string{pixelchange.BoardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.BoardID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pcc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pixelchange.UserTable,
+			Columns: []string{pixelchange.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pcc.mutation.TeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pixelchange.TeamTable,
+			Columns: []string{pixelchange.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TeamID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PixelChangeCreateBulk is the builder for creating many PixelChange entities in bulk.
+type PixelChangeCreateBulk struct {
+	config
+	err      error
+	builders []*PixelChangeCreate
+}
+
+// Save creates the PixelChange entities in the database.
+func (pccb *PixelChangeCreateBulk) Save(ctx context.Context) ([]*PixelChange, error) {
+	if pccb.err != nil {
+		return nil, pccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pccb.builders))
+	nodes := make([]*PixelChange, len(pccb.builders))
+	mutators := make([]Mutator, len(pccb.builders))
+	for i := range pccb.builders {
+		func(i int, root context.Context) {
+			builder := pccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PixelChangeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pccb *PixelChangeCreateBulk) SaveX(ctx context.Context) []*PixelChange {
+	v, err := pccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pccb *PixelChangeCreateBulk) Exec(ctx context.Context) error {
+	_, err := pccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pccb *PixelChangeCreateBulk) ExecX(ctx context.Context) {
+	if err := pccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
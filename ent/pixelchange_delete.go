@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"nevissGo/ent/pixelchange"
+	"nevissGo/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PixelChangeDelete is the builder for deleting a PixelChange entity.
+type PixelChangeDelete struct {
+	config
+	hooks    []Hook
+	mutation *PixelChangeMutation
+}
+
+// Where appends a list predicates to the PixelChangeDelete builder.
+func (pcd *PixelChangeDelete) Where(ps ...predicate.PixelChange) *PixelChangeDelete {
+	pcd.mutation.Where(ps...)
+	return pcd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (pcd *PixelChangeDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, pcd.sqlExec, pcd.mutation, pcd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcd *PixelChangeDelete) ExecX(ctx context.Context) int {
+	n, err := pcd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (pcd *PixelChangeDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(pixelchange.Table, sqlgraph.NewFieldSpec(pixelchange.FieldID, field.TypeInt))
+	if ps := pcd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, pcd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	pcd.mutation.done = true
+	return affected, err
+}
+
+// PixelChangeDeleteOne is the builder for deleting a single PixelChange entity.
+type PixelChangeDeleteOne struct {
+	pcd *PixelChangeDelete
+}
+
+// Where appends a list predicates to the PixelChangeDelete builder.
+func (pcdo *PixelChangeDeleteOne) Where(ps ...predicate.PixelChange) *PixelChangeDeleteOne {
+	pcdo.pcd.mutation.Where(ps...)
+	return pcdo
+}
+
+// Exec executes the deletion query.
+func (pcdo *PixelChangeDeleteOne) Exec(ctx context.Context) error {
+	n, err := pcdo.pcd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{pixelchange.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcdo *PixelChangeDeleteOne) ExecX(ctx context.Context) {
+	if err := pcdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,842 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"nevissGo/ent/board"
+	"nevissGo/ent/pixel"
+	"nevissGo/ent/pixelchange"
+	"nevissGo/ent/predicate"
+	"nevissGo/ent/team"
+	"nevissGo/ent/user"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PixelChangeQuery is the builder for querying PixelChange entities.
+type PixelChangeQuery struct {
+	config
+	ctx        *QueryContext
+	order      []pixelchange.OrderOption
+	inters     []Interceptor
+	predicates []predicate.PixelChange
+	withPixel  *PixelQuery
+	withBoard  *BoardQuery
+	withUser   *UserQuery
+	withTeam   *TeamQuery
+	withFKs    bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PixelChangeQuery builder.
+func (pcq *PixelChangeQuery) Where(ps ...predicate.PixelChange) *PixelChangeQuery {
+	pcq.predicates = append(pcq.predicates, ps...)
+	return pcq
+}
+
+// Limit the number of records to be returned by this query.
+func (pcq *PixelChangeQuery) Limit(limit int) *PixelChangeQuery {
+	pcq.ctx.Limit = &limit
+	return pcq
+}
+
+// Offset to start from.
+func (pcq *PixelChangeQuery) Offset(offset int) *PixelChangeQuery {
+	pcq.ctx.Offset = &offset
+	return pcq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (pcq *PixelChangeQuery) Unique(unique bool) *PixelChangeQuery {
+	pcq.ctx.Unique = &unique
+	return pcq
+}
+
+// Order specifies how the records should be ordered.
+func (pcq *PixelChangeQuery) Order(o ...pixelchange.OrderOption) *PixelChangeQuery {
+	pcq.order = append(pcq.order, o...)
+	return pcq
+}
+
+// QueryPixel chains the current query on the "pixel" edge.
+func (pcq *PixelChangeQuery) QueryPixel() *PixelQuery {
+	query := (&PixelClient{config: pcq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pixelchange.Table, pixelchange.FieldID, selector),
+			sqlgraph.To(pixel.Table, pixel.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, pixelchange.PixelTable, pixelchange.PixelColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBoard chains the current query on the "board" edge.
+func (pcq *PixelChangeQuery) QueryBoard() *BoardQuery {
+	query := (&BoardClient{config: pcq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pixelchange.Table, pixelchange.FieldID, selector),
+			sqlgraph.To(board.Table, board.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, pixelchange.BoardTable, pixelchange.BoardColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (pcq *PixelChangeQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: pcq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pixelchange.Table, pixelchange.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, pixelchange.UserTable, pixelchange.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTeam chains the current query on the "team" edge.
+func (pcq *PixelChangeQuery) QueryTeam() *TeamQuery {
+	query := (&TeamClient{config: pcq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pixelchange.Table, pixelchange.FieldID, selector),
+			sqlgraph.To(team.Table, team.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, pixelchange.TeamTable, pixelchange.TeamColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first PixelChange entity from the query.
+// Returns a *NotFoundError when no PixelChange was found.
+func (pcq *PixelChangeQuery) First(ctx context.Context) (*PixelChange, error) {
+	nodes, err := pcq.Limit(1).All(setContextOp(ctx, pcq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{pixelchange.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (pcq *PixelChangeQuery) FirstX(ctx context.Context) *PixelChange {
+	node, err := pcq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first PixelChange ID from the query.
+// Returns a *NotFoundError when no PixelChange ID was found.
+func (pcq *PixelChangeQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = pcq.Limit(1).IDs(setContextOp(ctx, pcq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{pixelchange.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (pcq *PixelChangeQuery) FirstIDX(ctx context.Context) int {
+	id, err := pcq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single PixelChange entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one PixelChange entity is found.
+// Returns a *NotFoundError when no PixelChange entities are found.
+func (pcq *PixelChangeQuery) Only(ctx context.Context) (*PixelChange, error) {
+	nodes, err := pcq.Limit(2).All(setContextOp(ctx, pcq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{pixelchange.Label}
+	default:
+		return nil, &NotSingularError{pixelchange.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (pcq *PixelChangeQuery) OnlyX(ctx context.Context) *PixelChange {
+	node, err := pcq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only PixelChange ID in the query.
+// Returns a *NotSingularError when more than one PixelChange ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (pcq *PixelChangeQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = pcq.Limit(2).IDs(setContextOp(ctx, pcq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{pixelchange.Label}
+	default:
+		err = &NotSingularError{pixelchange.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (pcq *PixelChangeQuery) OnlyIDX(ctx context.Context) int {
+	id, err := pcq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of PixelChanges.
+func (pcq *PixelChangeQuery) All(ctx context.Context) ([]*PixelChange, error) {
+	ctx = setContextOp(ctx, pcq.ctx, ent.OpQueryAll)
+	if err := pcq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*PixelChange, *PixelChangeQuery]()
+	return withInterceptors[[]*PixelChange](ctx, pcq, qr, pcq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (pcq *PixelChangeQuery) AllX(ctx context.Context) []*PixelChange {
+	nodes, err := pcq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of PixelChange IDs.
+func (pcq *PixelChangeQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if pcq.ctx.Unique == nil && pcq.path != nil {
+		pcq.Unique(true)
+	}
+	ctx = setContextOp(ctx, pcq.ctx, ent.OpQueryIDs)
+	if err = pcq.Select(pixelchange.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (pcq *PixelChangeQuery) IDsX(ctx context.Context) []int {
+	ids, err := pcq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (pcq *PixelChangeQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, pcq.ctx, ent.OpQueryCount)
+	if err := pcq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, pcq, querierCount[*PixelChangeQuery](), pcq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (pcq *PixelChangeQuery) CountX(ctx context.Context) int {
+	count, err := pcq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (pcq *PixelChangeQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, pcq.ctx, ent.OpQueryExist)
+	switch _, err := pcq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (pcq *PixelChangeQuery) ExistX(ctx context.Context) bool {
+	exist, err := pcq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PixelChangeQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (pcq *PixelChangeQuery) Clone() *PixelChangeQuery {
+	if pcq == nil {
+		return nil
+	}
+	return &PixelChangeQuery{
+		config:     pcq.config,
+		ctx:        pcq.ctx.Clone(),
+		order:      append([]pixelchange.OrderOption{}, pcq.order...),
+		inters:     append([]Interceptor{}, pcq.inters...),
+		predicates: append([]predicate.PixelChange{}, pcq.predicates...),
+		withPixel:  pcq.withPixel.Clone(),
+		withBoard:  pcq.withBoard.Clone(),
+		withUser:   pcq.withUser.Clone(),
+		withTeam:   pcq.withTeam.Clone(),
+		// clone intermediate query.
+		sql:  pcq.sql.Clone(),
+		path: pcq.path,
+	}
+}
+
+// WithPixel tells the query-builder to eager-load the nodes that are connected to
+// the "pixel" edge. The optional arguments are used to configure the query builder of the edge.
+func (pcq *PixelChangeQuery) WithPixel(opts ...func(*PixelQuery)) *PixelChangeQuery {
+	query := (&PixelClient{config: pcq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pcq.withPixel = query
+	return pcq
+}
+
+// WithBoard tells the query-builder to eager-load the nodes that are connected to
+// the "board" edge. The optional arguments are used to configure the query builder of the edge.
+func (pcq *PixelChangeQuery) WithBoard(opts ...func(*BoardQuery)) *PixelChangeQuery {
+	query := (&BoardClient{config: pcq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pcq.withBoard = query
+	return pcq
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (pcq *PixelChangeQuery) WithUser(opts ...func(*UserQuery)) *PixelChangeQuery {
+	query := (&UserClient{config: pcq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pcq.withUser = query
+	return pcq
+}
+
+// WithTeam tells the query-builder to eager-load the nodes that are connected to
+// the "team" edge. The optional arguments are used to configure the query builder of the edge.
+func (pcq *PixelChangeQuery) WithTeam(opts ...func(*TeamQuery)) *PixelChangeQuery {
+	query := (&TeamClient{config: pcq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pcq.withTeam = query
+	return pcq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Position int `json:"position,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.PixelChange.Query().
+//		GroupBy(pixelchange.FieldPosition).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (pcq *PixelChangeQuery) GroupBy(field string, fields ...string) *PixelChangeGroupBy {
+	pcq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &PixelChangeGroupBy{build: pcq}
+	grbuild.flds = &pcq.ctx.Fields
+	grbuild.label = pixelchange.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Position int `json:"position,omitempty"`
+//	}
+//
+//	client.PixelChange.Query().
+//		Select(pixelchange.FieldPosition).
+//		Scan(ctx, &v)
+func (pcq *PixelChangeQuery) Select(fields ...string) *PixelChangeSelect {
+	pcq.ctx.Fields = append(pcq.ctx.Fields, fields...)
+	sbuild := &PixelChangeSelect{PixelChangeQuery: pcq}
+	sbuild.label = pixelchange.Label
+	sbuild.flds, sbuild.scan = &pcq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a PixelChangeSelect configured with the given aggregations.
+func (pcq *PixelChangeQuery) Aggregate(fns ...AggregateFunc) *PixelChangeSelect {
+	return pcq.Select().Aggregate(fns...)
+}
+
+func (pcq *PixelChangeQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range pcq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, pcq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range pcq.ctx.Fields {
+		if !pixelchange.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if pcq.path != nil {
+		prev, err := pcq.path(ctx)
+		if err != nil {
+			return err
+		}
+		pcq.sql = prev
+	}
+	return nil
+}
+
+func (pcq *PixelChangeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*PixelChange, error) {
+	var (
+		nodes       = []*PixelChange{}
+		withFKs     = pcq.withFKs
+		_spec       = pcq.querySpec()
+		loadedTypes = [4]bool{
+			pcq.withPixel != nil,
+			pcq.withBoard != nil,
+			pcq.withUser != nil,
+			pcq.withTeam != nil,
+		}
+	)
+	if pcq.withPixel != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, pixelchange.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*PixelChange).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &PixelChange{config: pcq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, pcq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := pcq.withPixel; query != nil {
+		if err := pcq.loadPixel(ctx, query, nodes, nil,
+			func(n *PixelChange, e *Pixel) { n.Edges.Pixel = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pcq.withBoard; query != nil {
+		if err := pcq.loadBoard(ctx, query, nodes, nil,
+			func(n *PixelChange, e *Board) { n.Edges.Board = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pcq.withUser; query != nil {
+		if err := pcq.loadUser(ctx, query, nodes, nil,
+			func(n *PixelChange, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pcq.withTeam; query != nil {
+		if err := pcq.loadTeam(ctx, query, nodes, nil,
+			func(n *PixelChange, e *Team) { n.Edges.Team = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (pcq *PixelChangeQuery) loadPixel(ctx context.Context, query *PixelQuery, nodes []*PixelChange, init func(*PixelChange), assign func(*PixelChange, *Pixel)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*PixelChange)
+	for i := range nodes {
+		if nodes[i].pixel_changes == nil {
+			continue
+		}
+		fk := *nodes[i].pixel_changes
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(pixel.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "pixel_changes" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (pcq *PixelChangeQuery) loadBoard(ctx context.Context, query *BoardQuery, nodes []*PixelChange, init func(*PixelChange), assign func(*PixelChange, *Board)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*PixelChange)
+	for i := range nodes {
+		fk := nodes[i].BoardID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(board.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "board_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (pcq *PixelChangeQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*PixelChange, init func(*PixelChange), assign func(*PixelChange, *User)) error {
+	ids := make([]int64, 0, len(nodes))
+	nodeids := make(map[int64][]*PixelChange)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (pcq *PixelChangeQuery) loadTeam(ctx context.Context, query *TeamQuery, nodes []*PixelChange, init func(*PixelChange), assign func(*PixelChange, *Team)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*PixelChange)
+	for i := range nodes {
+		if nodes[i].TeamID == nil {
+			continue
+		}
+		fk := *nodes[i].TeamID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(team.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "team_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (pcq *PixelChangeQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := pcq.querySpec()
+	_spec.Node.Columns = pcq.ctx.Fields
+	if len(pcq.ctx.Fields) > 0 {
+		_spec.Unique = pcq.ctx.Unique != nil && *pcq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, pcq.driver, _spec)
+}
+
+func (pcq *PixelChangeQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(pixelchange.Table, pixelchange.Columns, sqlgraph.NewFieldSpec(pixelchange.FieldID, field.TypeInt))
+	_spec.From = pcq.sql
+	if unique := pcq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if pcq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := pcq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, pixelchange.FieldID)
+		for i := range fields {
+			if fields[i] != pixelchange.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if pcq.withBoard != nil {
+			_spec.Node.AddColumnOnce(pixelchange.FieldBoardID)
+		}
+		if pcq.withUser != nil {
+			_spec.Node.AddColumnOnce(pixelchange.FieldUserID)
+		}
+		if pcq.withTeam != nil {
+			_spec.Node.AddColumnOnce(pixelchange.FieldTeamID)
+		}
+	}
+	if ps := pcq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := pcq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := pcq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := pcq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (pcq *PixelChangeQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(pcq.driver.Dialect())
+	t1 := builder.Table(pixelchange.Table)
+	columns := pcq.ctx.Fields
+	if len(columns) == 0 {
+		columns = pixelchange.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if pcq.sql != nil {
+		selector = pcq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if pcq.ctx.Unique != nil && *pcq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range pcq.predicates {
+		p(selector)
+	}
+	for _, p := range pcq.order {
+		p(selector)
+	}
+	if offset := pcq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := pcq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// PixelChangeGroupBy is the group-by builder for PixelChange entities.
+type PixelChangeGroupBy struct {
+	selector
+	build *PixelChangeQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (pcgb *PixelChangeGroupBy) Aggregate(fns ...AggregateFunc) *PixelChangeGroupBy {
+	pcgb.fns = append(pcgb.fns, fns...)
+	return pcgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pcgb *PixelChangeGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pcgb.build.ctx, ent.OpQueryGroupBy)
+	if err := pcgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PixelChangeQuery, *PixelChangeGroupBy](ctx, pcgb.build, pcgb, pcgb.build.inters, v)
+}
+
+func (pcgb *PixelChangeGroupBy) sqlScan(ctx context.Context, root *PixelChangeQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(pcgb.fns))
+	for _, fn := range pcgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*pcgb.flds)+len(pcgb.fns))
+		for _, f := range *pcgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*pcgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pcgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// PixelChangeSelect is the builder for selecting fields of PixelChange entities.
+type PixelChangeSelect struct {
+	*PixelChangeQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (pcs *PixelChangeSelect) Aggregate(fns ...AggregateFunc) *PixelChangeSelect {
+	pcs.fns = append(pcs.fns, fns...)
+	return pcs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pcs *PixelChangeSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pcs.ctx, ent.OpQuerySelect)
+	if err := pcs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PixelChangeQuery, *PixelChangeSelect](ctx, pcs.PixelChangeQuery, pcs, pcs.inters, v)
+}
+
+func (pcs *PixelChangeSelect) sqlScan(ctx context.Context, root *PixelChangeQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(pcs.fns))
+	for _, fn := range pcs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*pcs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pcs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
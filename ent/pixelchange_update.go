@@ -0,0 +1,251 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"nevissGo/ent/pixelchange"
+	"nevissGo/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PixelChangeUpdate is the builder for updating PixelChange entities.
+type PixelChangeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PixelChangeMutation
+}
+
+// Where appends a list predicates to the PixelChangeUpdate builder.
+func (pcu *PixelChangeUpdate) Where(ps ...predicate.PixelChange) *PixelChangeUpdate {
+	pcu.mutation.Where(ps...)
+	return pcu
+}
+
+// SetPosition sets the "position" field.
+func (pcu *PixelChangeUpdate) SetPosition(i int) *PixelChangeUpdate {
+	pcu.mutation.ResetPosition()
+	pcu.mutation.SetPosition(i)
+	return pcu
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (pcu *PixelChangeUpdate) SetNillablePosition(i *int) *PixelChangeUpdate {
+	if i != nil {
+		pcu.SetPosition(*i)
+	}
+	return pcu
+}
+
+// AddPosition adds i to the "position" field.
+func (pcu *PixelChangeUpdate) AddPosition(i int) *PixelChangeUpdate {
+	pcu.mutation.AddPosition(i)
+	return pcu
+}
+
+// Mutation returns the PixelChangeMutation object of the builder.
+func (pcu *PixelChangeUpdate) Mutation() *PixelChangeMutation {
+	return pcu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pcu *PixelChangeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, pcu.sqlSave, pcu.mutation, pcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcu *PixelChangeUpdate) SaveX(ctx context.Context) int {
+	affected, err := pcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pcu *PixelChangeUpdate) Exec(ctx context.Context) error {
+	_, err := pcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcu *PixelChangeUpdate) ExecX(ctx context.Context) {
+	if err := pcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pcu *PixelChangeUpdate) check() error {
+	if pcu.mutation.BoardCleared() && len(pcu.mutation.BoardIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PixelChange.board"`)
+	}
+	return nil
+}
+
+func (pcu *PixelChangeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pcu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(pixelchange.Table, pixelchange.Columns, sqlgraph.NewFieldSpec(pixelchange.FieldID, field.TypeInt))
+	if ps := pcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pcu.mutation.Position(); ok {
+		_spec.SetField(pixelchange.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := pcu.mutation.AddedPosition(); ok {
+		_spec.AddField(pixelchange.FieldPosition, field.TypeInt, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pixelchange.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pcu.mutation.done = true
+	return n, nil
+}
+
+// PixelChangeUpdateOne is the builder for updating a single PixelChange entity.
+type PixelChangeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PixelChangeMutation
+}
+
+// SetPosition sets the "position" field.
+func (pcuo *PixelChangeUpdateOne) SetPosition(i int) *PixelChangeUpdateOne {
+	pcuo.mutation.ResetPosition()
+	pcuo.mutation.SetPosition(i)
+	return pcuo
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (pcuo *PixelChangeUpdateOne) SetNillablePosition(i *int) *PixelChangeUpdateOne {
+	if i != nil {
+		pcuo.SetPosition(*i)
+	}
+	return pcuo
+}
+
+// AddPosition adds i to the "position" field.
+func (pcuo *PixelChangeUpdateOne) AddPosition(i int) *PixelChangeUpdateOne {
+	pcuo.mutation.AddPosition(i)
+	return pcuo
+}
+
+// Mutation returns the PixelChangeMutation object of the builder.
+func (pcuo *PixelChangeUpdateOne) Mutation() *PixelChangeMutation {
+	return pcuo.mutation
+}
+
+// Where appends a list predicates to the PixelChangeUpdate builder.
+func (pcuo *PixelChangeUpdateOne) Where(ps ...predicate.PixelChange) *PixelChangeUpdateOne {
+	pcuo.mutation.Where(ps...)
+	return pcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pcuo *PixelChangeUpdateOne) Select(field string, fields ...string) *PixelChangeUpdateOne {
+	pcuo.fields = append([]string{field}, fields...)
+	return pcuo
+}
+
+// Save executes the query and returns the updated PixelChange entity.
+func (pcuo *PixelChangeUpdateOne) Save(ctx context.Context) (*PixelChange, error) {
+	return withHooks(ctx, pcuo.sqlSave, pcuo.mutation, pcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcuo *PixelChangeUpdateOne) SaveX(ctx context.Context) *PixelChange {
+	node, err := pcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pcuo *PixelChangeUpdateOne) Exec(ctx context.Context) error {
+	_, err := pcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcuo *PixelChangeUpdateOne) ExecX(ctx context.Context) {
+	if err := pcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pcuo *PixelChangeUpdateOne) check() error {
+	if pcuo.mutation.BoardCleared() && len(pcuo.mutation.BoardIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PixelChange.board"`)
+	}
+	return nil
+}
+
+func (pcuo *PixelChangeUpdateOne) sqlSave(ctx context.Context) (_node *PixelChange, err error) {
+	if err := pcuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(pixelchange.Table, pixelchange.Columns, sqlgraph.NewFieldSpec(pixelchange.FieldID, field.TypeInt))
+	id, ok := pcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PixelChange.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, pixelchange.FieldID)
+		for _, f := range fields {
+			if !pixelchange.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != pixelchange.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pcuo.mutation.Position(); ok {
+		_spec.SetField(pixelchange.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := pcuo.mutation.AddedPosition(); ok {
+		_spec.AddField(pixelchange.FieldPosition, field.TypeInt, value)
+	}
+	_node = &PixelChange{config: pcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pixelchange.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pcuo.mutation.done = true
+	return _node, nil
+}
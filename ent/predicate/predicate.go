@@ -6,11 +6,29 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Board is the predicate function for board builders.
+type Board func(*sql.Selector)
+
 // Hype is the predicate function for hype builders.
 type Hype func(*sql.Selector)
 
+// HypeLedger is the predicate function for hypeledger builders.
+type HypeLedger func(*sql.Selector)
+
+// OutboxEvent is the predicate function for outboxevent builders.
+type OutboxEvent func(*sql.Selector)
+
 // Pixel is the predicate function for pixel builders.
 type Pixel func(*sql.Selector)
 
+// PixelChange is the predicate function for pixelchange builders.
+type PixelChange func(*sql.Selector)
+
+// Region is the predicate function for region builders.
+type Region func(*sql.Selector)
+
+// Team is the predicate function for team builders.
+type Team func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
@@ -0,0 +1,241 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"nevissGo/ent/board"
+	"nevissGo/ent/region"
+	"nevissGo/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Region is the model entity for the Region schema.
+type Region struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// X holds the value of the "x" field.
+	X int `json:"x,omitempty"`
+	// Y holds the value of the "y" field.
+	Y int `json:"y,omitempty"`
+	// Width holds the value of the "width" field.
+	Width int `json:"width,omitempty"`
+	// Height holds the value of the "height" field.
+	Height int `json:"height,omitempty"`
+	// Mask holds the value of the "mask" field.
+	Mask string `json:"mask,omitempty"`
+	// BoardID holds the value of the "board_id" field.
+	BoardID int `json:"board_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RegionQuery when eager-loading is set.
+	Edges        RegionEdges `json:"edges"`
+	user_regions *int64
+	selectValues sql.SelectValues
+}
+
+// RegionEdges holds the relations/edges for other nodes in the graph.
+type RegionEdges struct {
+	// Board holds the value of the board edge.
+	Board *Board `json:"board,omitempty"`
+	// Creator holds the value of the creator edge.
+	Creator *User `json:"creator,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// BoardOrErr returns the Board value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RegionEdges) BoardOrErr() (*Board, error) {
+	if e.Board != nil {
+		return e.Board, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: board.Label}
+	}
+	return nil, &NotLoadedError{edge: "board"}
+}
+
+// CreatorOrErr returns the Creator value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RegionEdges) CreatorOrErr() (*User, error) {
+	if e.Creator != nil {
+		return e.Creator, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "creator"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Region) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case region.FieldID, region.FieldX, region.FieldY, region.FieldWidth, region.FieldHeight, region.FieldBoardID:
+			values[i] = new(sql.NullInt64)
+		case region.FieldName, region.FieldMask:
+			values[i] = new(sql.NullString)
+		case region.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case region.ForeignKeys[0]: // user_regions
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Region fields.
+func (r *Region) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case region.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			r.ID = int(value.Int64)
+		case region.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				r.Name = value.String
+			}
+		case region.FieldX:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field x", values[i])
+			} else if value.Valid {
+				r.X = int(value.Int64)
+			}
+		case region.FieldY:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field y", values[i])
+			} else if value.Valid {
+				r.Y = int(value.Int64)
+			}
+		case region.FieldWidth:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field width", values[i])
+			} else if value.Valid {
+				r.Width = int(value.Int64)
+			}
+		case region.FieldHeight:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field height", values[i])
+			} else if value.Valid {
+				r.Height = int(value.Int64)
+			}
+		case region.FieldMask:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mask", values[i])
+			} else if value.Valid {
+				r.Mask = value.String
+			}
+		case region.FieldBoardID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field board_id", values[i])
+			} else if value.Valid {
+				r.BoardID = int(value.Int64)
+			}
+		case region.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				r.CreatedAt = value.Time
+			}
+		case region.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_regions", value)
+			} else if value.Valid {
+				r.user_regions = new(int64)
+				*r.user_regions = int64(value.Int64)
+			}
+		default:
+			r.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Region.
+// This includes values selected through modifiers, order, etc.
+func (r *Region) Value(name string) (ent.Value, error) {
+	return r.selectValues.Get(name)
+}
+
+// QueryBoard queries the "board" edge of the Region entity.
+func (r *Region) QueryBoard() *BoardQuery {
+	return NewRegionClient(r.config).QueryBoard(r)
+}
+
+// QueryCreator queries the "creator" edge of the Region entity.
+func (r *Region) QueryCreator() *UserQuery {
+	return NewRegionClient(r.config).QueryCreator(r)
+}
+
+// Update returns a builder for updating this Region.
+// Note that you need to call Region.Unwrap() before calling this method if this Region
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (r *Region) Update() *RegionUpdateOne {
+	return NewRegionClient(r.config).UpdateOne(r)
+}
+
+// Unwrap unwraps the Region entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (r *Region) Unwrap() *Region {
+	_tx, ok := r.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Region is not a transactional entity")
+	}
+	r.config.driver = _tx.drv
+	return r
+}
+
+// String implements the fmt.Stringer.
+func (r *Region) String() string {
+	var builder strings.Builder
+	builder.WriteString("Region(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", r.ID))
+	builder.WriteString("name=")
+	builder.WriteString(r.Name)
+	builder.WriteString(", ")
+	builder.WriteString("x=")
+	builder.WriteString(fmt.Sprintf("%v", r.X))
+	builder.WriteString(", ")
+	builder.WriteString("y=")
+	builder.WriteString(fmt.Sprintf("%v", r.Y))
+	builder.WriteString(", ")
+	builder.WriteString("width=")
+	builder.WriteString(fmt.Sprintf("%v", r.Width))
+	builder.WriteString(", ")
+	builder.WriteString("height=")
+	builder.WriteString(fmt.Sprintf("%v", r.Height))
+	builder.WriteString(", ")
+	builder.WriteString("mask=")
+	builder.WriteString(r.Mask)
+	builder.WriteString(", ")
+	builder.WriteString("board_id=")
+	builder.WriteString(fmt.Sprintf("%v", r.BoardID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(r.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Regions is a parsable slice of Region.
+type Regions []*Region
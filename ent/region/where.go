@@ -0,0 +1,517 @@
+// Code generated by ent, DO NOT EDIT.
+
+package region
+
+import (
+	"nevissGo/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Region {
+	return predicate.Region(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Region {
+	return predicate.Region(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Region {
+	return predicate.Region(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Region {
+	return predicate.Region(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Region {
+	return predicate.Region(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Region {
+	return predicate.Region(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Region {
+	return predicate.Region(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Region {
+	return predicate.Region(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Region {
+	return predicate.Region(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Region {
+	return predicate.Region(sql.FieldEQ(FieldName, v))
+}
+
+// X applies equality check predicate on the "x" field. It's identical to XEQ.
+func X(v int) predicate.Region {
+	return predicate.Region(sql.FieldEQ(FieldX, v))
+}
+
+// Y applies equality check predicate on the "y" field. It's identical to YEQ.
+func Y(v int) predicate.Region {
+	return predicate.Region(sql.FieldEQ(FieldY, v))
+}
+
+// Width applies equality check predicate on the "width" field. It's identical to WidthEQ.
+func Width(v int) predicate.Region {
+	return predicate.Region(sql.FieldEQ(FieldWidth, v))
+}
+
+// Height applies equality check predicate on the "height" field. It's identical to HeightEQ.
+func Height(v int) predicate.Region {
+	return predicate.Region(sql.FieldEQ(FieldHeight, v))
+}
+
+// Mask applies equality check predicate on the "mask" field. It's identical to MaskEQ.
+func Mask(v string) predicate.Region {
+	return predicate.Region(sql.FieldEQ(FieldMask, v))
+}
+
+// BoardID applies equality check predicate on the "board_id" field. It's identical to BoardIDEQ.
+func BoardID(v int) predicate.Region {
+	return predicate.Region(sql.FieldEQ(FieldBoardID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Region {
+	return predicate.Region(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Region {
+	return predicate.Region(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Region {
+	return predicate.Region(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Region {
+	return predicate.Region(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Region {
+	return predicate.Region(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Region {
+	return predicate.Region(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Region {
+	return predicate.Region(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Region {
+	return predicate.Region(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Region {
+	return predicate.Region(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Region {
+	return predicate.Region(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Region {
+	return predicate.Region(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Region {
+	return predicate.Region(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Region {
+	return predicate.Region(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Region {
+	return predicate.Region(sql.FieldContainsFold(FieldName, v))
+}
+
+// XEQ applies the EQ predicate on the "x" field.
+func XEQ(v int) predicate.Region {
+	return predicate.Region(sql.FieldEQ(FieldX, v))
+}
+
+// XNEQ applies the NEQ predicate on the "x" field.
+func XNEQ(v int) predicate.Region {
+	return predicate.Region(sql.FieldNEQ(FieldX, v))
+}
+
+// XIn applies the In predicate on the "x" field.
+func XIn(vs ...int) predicate.Region {
+	return predicate.Region(sql.FieldIn(FieldX, vs...))
+}
+
+// XNotIn applies the NotIn predicate on the "x" field.
+func XNotIn(vs ...int) predicate.Region {
+	return predicate.Region(sql.FieldNotIn(FieldX, vs...))
+}
+
+// XGT applies the GT predicate on the "x" field.
+func XGT(v int) predicate.Region {
+	return predicate.Region(sql.FieldGT(FieldX, v))
+}
+
+// XGTE applies the GTE predicate on the "x" field.
+func XGTE(v int) predicate.Region {
+	return predicate.Region(sql.FieldGTE(FieldX, v))
+}
+
+// XLT applies the LT predicate on the "x" field.
+func XLT(v int) predicate.Region {
+	return predicate.Region(sql.FieldLT(FieldX, v))
+}
+
+// XLTE applies the LTE predicate on the "x" field.
+func XLTE(v int) predicate.Region {
+	return predicate.Region(sql.FieldLTE(FieldX, v))
+}
+
+// YEQ applies the EQ predicate on the "y" field.
+func YEQ(v int) predicate.Region {
+	return predicate.Region(sql.FieldEQ(FieldY, v))
+}
+
+// YNEQ applies the NEQ predicate on the "y" field.
+func YNEQ(v int) predicate.Region {
+	return predicate.Region(sql.FieldNEQ(FieldY, v))
+}
+
+// YIn applies the In predicate on the "y" field.
+func YIn(vs ...int) predicate.Region {
+	return predicate.Region(sql.FieldIn(FieldY, vs...))
+}
+
+// YNotIn applies the NotIn predicate on the "y" field.
+func YNotIn(vs ...int) predicate.Region {
+	return predicate.Region(sql.FieldNotIn(FieldY, vs...))
+}
+
+// YGT applies the GT predicate on the "y" field.
+func YGT(v int) predicate.Region {
+	return predicate.Region(sql.FieldGT(FieldY, v))
+}
+
+// YGTE applies the GTE predicate on the "y" field.
+func YGTE(v int) predicate.Region {
+	return predicate.Region(sql.FieldGTE(FieldY, v))
+}
+
+// YLT applies the LT predicate on the "y" field.
+func YLT(v int) predicate.Region {
+	return predicate.Region(sql.FieldLT(FieldY, v))
+}
+
+// YLTE applies the LTE predicate on the "y" field.
+func YLTE(v int) predicate.Region {
+	return predicate.Region(sql.FieldLTE(FieldY, v))
+}
+
+// WidthEQ applies the EQ predicate on the "width" field.
+func WidthEQ(v int) predicate.Region {
+	return predicate.Region(sql.FieldEQ(FieldWidth, v))
+}
+
+// WidthNEQ applies the NEQ predicate on the "width" field.
+func WidthNEQ(v int) predicate.Region {
+	return predicate.Region(sql.FieldNEQ(FieldWidth, v))
+}
+
+// WidthIn applies the In predicate on the "width" field.
+func WidthIn(vs ...int) predicate.Region {
+	return predicate.Region(sql.FieldIn(FieldWidth, vs...))
+}
+
+// WidthNotIn applies the NotIn predicate on the "width" field.
+func WidthNotIn(vs ...int) predicate.Region {
+	return predicate.Region(sql.FieldNotIn(FieldWidth, vs...))
+}
+
+// WidthGT applies the GT predicate on the "width" field.
+func WidthGT(v int) predicate.Region {
+	return predicate.Region(sql.FieldGT(FieldWidth, v))
+}
+
+// WidthGTE applies the GTE predicate on the "width" field.
+func WidthGTE(v int) predicate.Region {
+	return predicate.Region(sql.FieldGTE(FieldWidth, v))
+}
+
+// WidthLT applies the LT predicate on the "width" field.
+func WidthLT(v int) predicate.Region {
+	return predicate.Region(sql.FieldLT(FieldWidth, v))
+}
+
+// WidthLTE applies the LTE predicate on the "width" field.
+func WidthLTE(v int) predicate.Region {
+	return predicate.Region(sql.FieldLTE(FieldWidth, v))
+}
+
+// HeightEQ applies the EQ predicate on the "height" field.
+func HeightEQ(v int) predicate.Region {
+	return predicate.Region(sql.FieldEQ(FieldHeight, v))
+}
+
+// HeightNEQ applies the NEQ predicate on the "height" field.
+func HeightNEQ(v int) predicate.Region {
+	return predicate.Region(sql.FieldNEQ(FieldHeight, v))
+}
+
+// HeightIn applies the In predicate on the "height" field.
+func HeightIn(vs ...int) predicate.Region {
+	return predicate.Region(sql.FieldIn(FieldHeight, vs...))
+}
+
+// HeightNotIn applies the NotIn predicate on the "height" field.
+func HeightNotIn(vs ...int) predicate.Region {
+	return predicate.Region(sql.FieldNotIn(FieldHeight, vs...))
+}
+
+// HeightGT applies the GT predicate on the "height" field.
+func HeightGT(v int) predicate.Region {
+	return predicate.Region(sql.FieldGT(FieldHeight, v))
+}
+
+// HeightGTE applies the GTE predicate on the "height" field.
+func HeightGTE(v int) predicate.Region {
+	return predicate.Region(sql.FieldGTE(FieldHeight, v))
+}
+
+// HeightLT applies the LT predicate on the "height" field.
+func HeightLT(v int) predicate.Region {
+	return predicate.Region(sql.FieldLT(FieldHeight, v))
+}
+
+// HeightLTE applies the LTE predicate on the "height" field.
+func HeightLTE(v int) predicate.Region {
+	return predicate.Region(sql.FieldLTE(FieldHeight, v))
+}
+
+// MaskEQ applies the EQ predicate on the "mask" field.
+func MaskEQ(v string) predicate.Region {
+	return predicate.Region(sql.FieldEQ(FieldMask, v))
+}
+
+// MaskNEQ applies the NEQ predicate on the "mask" field.
+func MaskNEQ(v string) predicate.Region {
+	return predicate.Region(sql.FieldNEQ(FieldMask, v))
+}
+
+// MaskIn applies the In predicate on the "mask" field.
+func MaskIn(vs ...string) predicate.Region {
+	return predicate.Region(sql.FieldIn(FieldMask, vs...))
+}
+
+// MaskNotIn applies the NotIn predicate on the "mask" field.
+func MaskNotIn(vs ...string) predicate.Region {
+	return predicate.Region(sql.FieldNotIn(FieldMask, vs...))
+}
+
+// MaskGT applies the GT predicate on the "mask" field.
+func MaskGT(v string) predicate.Region {
+	return predicate.Region(sql.FieldGT(FieldMask, v))
+}
+
+// MaskGTE applies the GTE predicate on the "mask" field.
+func MaskGTE(v string) predicate.Region {
+	return predicate.Region(sql.FieldGTE(FieldMask, v))
+}
+
+// MaskLT applies the LT predicate on the "mask" field.
+func MaskLT(v string) predicate.Region {
+	return predicate.Region(sql.FieldLT(FieldMask, v))
+}
+
+// MaskLTE applies the LTE predicate on the "mask" field.
+func MaskLTE(v string) predicate.Region {
+	return predicate.Region(sql.FieldLTE(FieldMask, v))
+}
+
+// MaskContains applies the Contains predicate on the "mask" field.
+func MaskContains(v string) predicate.Region {
+	return predicate.Region(sql.FieldContains(FieldMask, v))
+}
+
+// MaskHasPrefix applies the HasPrefix predicate on the "mask" field.
+func MaskHasPrefix(v string) predicate.Region {
+	return predicate.Region(sql.FieldHasPrefix(FieldMask, v))
+}
+
+// MaskHasSuffix applies the HasSuffix predicate on the "mask" field.
+func MaskHasSuffix(v string) predicate.Region {
+	return predicate.Region(sql.FieldHasSuffix(FieldMask, v))
+}
+
+// MaskIsNil applies the IsNil predicate on the "mask" field.
+func MaskIsNil() predicate.Region {
+	return predicate.Region(sql.FieldIsNull(FieldMask))
+}
+
+// MaskNotNil applies the NotNil predicate on the "mask" field.
+func MaskNotNil() predicate.Region {
+	return predicate.Region(sql.FieldNotNull(FieldMask))
+}
+
+// MaskEqualFold applies the EqualFold predicate on the "mask" field.
+func MaskEqualFold(v string) predicate.Region {
+	return predicate.Region(sql.FieldEqualFold(FieldMask, v))
+}
+
+// MaskContainsFold applies the ContainsFold predicate on the "mask" field.
+func MaskContainsFold(v string) predicate.Region {
+	return predicate.Region(sql.FieldContainsFold(FieldMask, v))
+}
+
+// BoardIDEQ applies the EQ predicate on the "board_id" field.
+func BoardIDEQ(v int) predicate.Region {
+	return predicate.Region(sql.FieldEQ(FieldBoardID, v))
+}
+
+// BoardIDNEQ applies the NEQ predicate on the "board_id" field.
+func BoardIDNEQ(v int) predicate.Region {
+	return predicate.Region(sql.FieldNEQ(FieldBoardID, v))
+}
+
+// BoardIDIn applies the In predicate on the "board_id" field.
+func BoardIDIn(vs ...int) predicate.Region {
+	return predicate.Region(sql.FieldIn(FieldBoardID, vs...))
+}
+
+// BoardIDNotIn applies the NotIn predicate on the "board_id" field.
+func BoardIDNotIn(vs ...int) predicate.Region {
+	return predicate.Region(sql.FieldNotIn(FieldBoardID, vs...))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Region {
+	return predicate.Region(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Region {
+	return predicate.Region(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Region {
+	return predicate.Region(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Region {
+	return predicate.Region(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Region {
+	return predicate.Region(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Region {
+	return predicate.Region(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Region {
+	return predicate.Region(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Region {
+	return predicate.Region(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasBoard applies the HasEdge predicate on the "board" edge.
+func HasBoard() predicate.Region {
+	return predicate.Region(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BoardTable, BoardColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBoardWith applies the HasEdge predicate on the "board" edge with a given conditions (other predicates).
+func HasBoardWith(preds ...predicate.Board) predicate.Region {
+	return predicate.Region(func(s *sql.Selector) {
+		step := newBoardStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCreator applies the HasEdge predicate on the "creator" edge.
+func HasCreator() predicate.Region {
+	return predicate.Region(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CreatorTable, CreatorColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCreatorWith applies the HasEdge predicate on the "creator" edge with a given conditions (other predicates).
+func HasCreatorWith(preds ...predicate.User) predicate.Region {
+	return predicate.Region(func(s *sql.Selector) {
+		step := newCreatorStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Region) predicate.Region {
+	return predicate.Region(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Region) predicate.Region {
+	return predicate.Region(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Region) predicate.Region {
+	return predicate.Region(sql.NotPredicates(p))
+}
@@ -0,0 +1,382 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"nevissGo/ent/board"
+	"nevissGo/ent/region"
+	"nevissGo/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RegionCreate is the builder for creating a Region entity.
+type RegionCreate struct {
+	config
+	mutation *RegionMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (rc *RegionCreate) SetName(s string) *RegionCreate {
+	rc.mutation.SetName(s)
+	return rc
+}
+
+// SetX sets the "x" field.
+func (rc *RegionCreate) SetX(i int) *RegionCreate {
+	rc.mutation.SetX(i)
+	return rc
+}
+
+// SetY sets the "y" field.
+func (rc *RegionCreate) SetY(i int) *RegionCreate {
+	rc.mutation.SetY(i)
+	return rc
+}
+
+// SetWidth sets the "width" field.
+func (rc *RegionCreate) SetWidth(i int) *RegionCreate {
+	rc.mutation.SetWidth(i)
+	return rc
+}
+
+// SetHeight sets the "height" field.
+func (rc *RegionCreate) SetHeight(i int) *RegionCreate {
+	rc.mutation.SetHeight(i)
+	return rc
+}
+
+// SetMask sets the "mask" field.
+func (rc *RegionCreate) SetMask(s string) *RegionCreate {
+	rc.mutation.SetMask(s)
+	return rc
+}
+
+// SetNillableMask sets the "mask" field if the given value is not nil.
+func (rc *RegionCreate) SetNillableMask(s *string) *RegionCreate {
+	if s != nil {
+		rc.SetMask(*s)
+	}
+	return rc
+}
+
+// SetBoardID sets the "board_id" field.
+func (rc *RegionCreate) SetBoardID(i int) *RegionCreate {
+	rc.mutation.SetBoardID(i)
+	return rc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rc *RegionCreate) SetCreatedAt(t time.Time) *RegionCreate {
+	rc.mutation.SetCreatedAt(t)
+	return rc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rc *RegionCreate) SetNillableCreatedAt(t *time.Time) *RegionCreate {
+	if t != nil {
+		rc.SetCreatedAt(*t)
+	}
+	return rc
+}
+
+// SetBoard sets the "board" edge to the Board entity.
+func (rc *RegionCreate) SetBoard(b *Board) *RegionCreate {
+	return rc.SetBoardID(b.ID)
+}
+
+// SetCreatorID sets the "creator" edge to the User entity by ID.
+func (rc *RegionCreate) SetCreatorID(id int64) *RegionCreate {
+	rc.mutation.SetCreatorID(id)
+	return rc
+}
+
+// SetNillableCreatorID sets the "creator" edge to the User entity by ID if the given value is not nil.
+func (rc *RegionCreate) SetNillableCreatorID(id *int64) *RegionCreate {
+	if id != nil {
+		rc = rc.SetCreatorID(*id)
+	}
+	return rc
+}
+
+// SetCreator sets the "creator" edge to the User entity.
+func (rc *RegionCreate) SetCreator(u *User) *RegionCreate {
+	return rc.SetCreatorID(u.ID)
+}
+
+// Mutation returns the RegionMutation object of the builder.
+func (rc *RegionCreate) Mutation() *RegionMutation {
+	return rc.mutation
+}
+
+// Save creates the Region in the database.
+func (rc *RegionCreate) Save(ctx context.Context) (*Region, error) {
+	rc.defaults()
+	return withHooks(ctx, rc.sqlSave, rc.mutation, rc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rc *RegionCreate) SaveX(ctx context.Context) *Region {
+	v, err := rc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rc *RegionCreate) Exec(ctx context.Context) error {
+	_, err := rc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rc *RegionCreate) ExecX(ctx context.Context) {
+	if err := rc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rc *RegionCreate) defaults() {
+	if _, ok := rc.mutation.CreatedAt(); !ok {
+		v := region.DefaultCreatedAt()
+		rc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rc *RegionCreate) check() error {
+	if _, ok := rc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Region.name"`)}
+	}
+	if v, ok := rc.mutation.Name(); ok {
+		if err := region.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Region.name": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.X(); !ok {
+		return &ValidationError{Name: "x", err: errors.New(`ent: missing required field "Region.x"`)}
+	}
+	if v, ok := rc.mutation.X(); ok {
+		if err := region.XValidator(v); err != nil {
+			return &ValidationError{Name: "x", err: fmt.Errorf(`ent: validator failed for field "Region.x": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.Y(); !ok {
+		return &ValidationError{Name: "y", err: errors.New(`ent: missing required field "Region.y"`)}
+	}
+	if v, ok := rc.mutation.Y(); ok {
+		if err := region.YValidator(v); err != nil {
+			return &ValidationError{Name: "y", err: fmt.Errorf(`ent: validator failed for field "Region.y": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.Width(); !ok {
+		return &ValidationError{Name: "width", err: errors.New(`ent: missing required field "Region.width"`)}
+	}
+	if v, ok := rc.mutation.Width(); ok {
+		if err := region.WidthValidator(v); err != nil {
+			return &ValidationError{Name: "width", err: fmt.Errorf(`ent: validator failed for field "Region.width": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.Height(); !ok {
+		return &ValidationError{Name: "height", err: errors.New(`ent: missing required field "Region.height"`)}
+	}
+	if v, ok := rc.mutation.Height(); ok {
+		if err := region.HeightValidator(v); err != nil {
+			return &ValidationError{Name: "height", err: fmt.Errorf(`ent: validator failed for field "Region.height": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.BoardID(); !ok {
+		return &ValidationError{Name: "board_id", err: errors.New(`ent: missing required field "Region.board_id"`)}
+	}
+	if _, ok := rc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Region.created_at"`)}
+	}
+	if len(rc.mutation.BoardIDs()) == 0 {
+		return &ValidationError{Name: "board", err: errors.New(`ent: missing required edge "Region.board"`)}
+	}
+	return nil
+}
+
+func (rc *RegionCreate) sqlSave(ctx context.Context) (*Region, error) {
+	if err := rc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	rc.mutation.id = &_node.ID
+	rc.mutation.done = true
+	return _node, nil
+}
+
+func (rc *RegionCreate) createSpec() (*Region, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Region{config: rc.config}
+		_spec = sqlgraph.NewCreateSpec(region.Table, sqlgraph.NewFieldSpec(region.FieldID, field.TypeInt))
+	)
+	if value, ok := rc.mutation.Name(); ok {
+		_spec.SetField(region.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := rc.mutation.X(); ok {
+		_spec.SetField(region.FieldX, field.TypeInt, value)
+		_node.X = value
+	}
+	if value, ok := rc.mutation.Y(); ok {
+		_spec.SetField(region.FieldY, field.TypeInt, value)
+		_node.Y = value
+	}
+	if value, ok := rc.mutation.Width(); ok {
+		_spec.SetField(region.FieldWidth, field.TypeInt, value)
+		_node.Width = value
+	}
+	if value, ok := rc.mutation.Height(); ok {
+		_spec.SetField(region.FieldHeight, field.TypeInt, value)
+		_node.Height = value
+	}
+	if value, ok := rc.mutation.Mask(); ok {
+		_spec.SetField(region.FieldMask, field.TypeString, value)
+		_node.Mask = value
+	}
+	if value, ok := rc.mutation.CreatedAt(); ok {
+		_spec.SetField(region.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := rc.mutation.BoardIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   region.BoardTable,
+			Columns: []string{region.BoardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.BoardID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rc.mutation.CreatorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   region.CreatorTable,
+			Columns: []string{region.CreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_regions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// RegionCreateBulk is the builder for creating many Region entities in bulk.
+type RegionCreateBulk struct {
+	config
+	err      error
+	builders []*RegionCreate
+}
+
+// Save creates the Region entities in the database.
+func (rcb *RegionCreateBulk) Save(ctx context.Context) ([]*Region, error) {
+	if rcb.err != nil {
+		return nil, rcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rcb.builders))
+	nodes := make([]*Region, len(rcb.builders))
+	mutators := make([]Mutator, len(rcb.builders))
+	for i := range rcb.builders {
+		func(i int, root context.Context) {
+			builder := rcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RegionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcb *RegionCreateBulk) SaveX(ctx context.Context) []*Region {
+	v, err := rcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rcb *RegionCreateBulk) Exec(ctx context.Context) error {
+	_, err := rcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcb *RegionCreateBulk) ExecX(ctx context.Context) {
+	if err := rcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"nevissGo/ent/predicate"
+	"nevissGo/ent/region"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RegionDelete is the builder for deleting a Region entity.
+type RegionDelete struct {
+	config
+	hooks    []Hook
+	mutation *RegionMutation
+}
+
+// Where appends a list predicates to the RegionDelete builder.
+func (rd *RegionDelete) Where(ps ...predicate.Region) *RegionDelete {
+	rd.mutation.Where(ps...)
+	return rd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rd *RegionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, rd.sqlExec, rd.mutation, rd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rd *RegionDelete) ExecX(ctx context.Context) int {
+	n, err := rd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rd *RegionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(region.Table, sqlgraph.NewFieldSpec(region.FieldID, field.TypeInt))
+	if ps := rd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	rd.mutation.done = true
+	return affected, err
+}
+
+// RegionDeleteOne is the builder for deleting a single Region entity.
+type RegionDeleteOne struct {
+	rd *RegionDelete
+}
+
+// Where appends a list predicates to the RegionDelete builder.
+func (rdo *RegionDeleteOne) Where(ps ...predicate.Region) *RegionDeleteOne {
+	rdo.rd.mutation.Where(ps...)
+	return rdo
+}
+
+// Exec executes the deletion query.
+func (rdo *RegionDeleteOne) Exec(ctx context.Context) error {
+	n, err := rdo.rd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{region.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rdo *RegionDeleteOne) ExecX(ctx context.Context) {
+	if err := rdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,689 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"nevissGo/ent/board"
+	"nevissGo/ent/predicate"
+	"nevissGo/ent/region"
+	"nevissGo/ent/user"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RegionQuery is the builder for querying Region entities.
+type RegionQuery struct {
+	config
+	ctx         *QueryContext
+	order       []region.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.Region
+	withBoard   *BoardQuery
+	withCreator *UserQuery
+	withFKs     bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the RegionQuery builder.
+func (rq *RegionQuery) Where(ps ...predicate.Region) *RegionQuery {
+	rq.predicates = append(rq.predicates, ps...)
+	return rq
+}
+
+// Limit the number of records to be returned by this query.
+func (rq *RegionQuery) Limit(limit int) *RegionQuery {
+	rq.ctx.Limit = &limit
+	return rq
+}
+
+// Offset to start from.
+func (rq *RegionQuery) Offset(offset int) *RegionQuery {
+	rq.ctx.Offset = &offset
+	return rq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (rq *RegionQuery) Unique(unique bool) *RegionQuery {
+	rq.ctx.Unique = &unique
+	return rq
+}
+
+// Order specifies how the records should be ordered.
+func (rq *RegionQuery) Order(o ...region.OrderOption) *RegionQuery {
+	rq.order = append(rq.order, o...)
+	return rq
+}
+
+// QueryBoard chains the current query on the "board" edge.
+func (rq *RegionQuery) QueryBoard() *BoardQuery {
+	query := (&BoardClient{config: rq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(region.Table, region.FieldID, selector),
+			sqlgraph.To(board.Table, board.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, region.BoardTable, region.BoardColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCreator chains the current query on the "creator" edge.
+func (rq *RegionQuery) QueryCreator() *UserQuery {
+	query := (&UserClient{config: rq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(region.Table, region.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, region.CreatorTable, region.CreatorColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first Region entity from the query.
+// Returns a *NotFoundError when no Region was found.
+func (rq *RegionQuery) First(ctx context.Context) (*Region, error) {
+	nodes, err := rq.Limit(1).All(setContextOp(ctx, rq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{region.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (rq *RegionQuery) FirstX(ctx context.Context) *Region {
+	node, err := rq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Region ID from the query.
+// Returns a *NotFoundError when no Region ID was found.
+func (rq *RegionQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = rq.Limit(1).IDs(setContextOp(ctx, rq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{region.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (rq *RegionQuery) FirstIDX(ctx context.Context) int {
+	id, err := rq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single Region entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one Region entity is found.
+// Returns a *NotFoundError when no Region entities are found.
+func (rq *RegionQuery) Only(ctx context.Context) (*Region, error) {
+	nodes, err := rq.Limit(2).All(setContextOp(ctx, rq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{region.Label}
+	default:
+		return nil, &NotSingularError{region.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (rq *RegionQuery) OnlyX(ctx context.Context) *Region {
+	node, err := rq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only Region ID in the query.
+// Returns a *NotSingularError when more than one Region ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (rq *RegionQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = rq.Limit(2).IDs(setContextOp(ctx, rq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{region.Label}
+	default:
+		err = &NotSingularError{region.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (rq *RegionQuery) OnlyIDX(ctx context.Context) int {
+	id, err := rq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Regions.
+func (rq *RegionQuery) All(ctx context.Context) ([]*Region, error) {
+	ctx = setContextOp(ctx, rq.ctx, ent.OpQueryAll)
+	if err := rq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*Region, *RegionQuery]()
+	return withInterceptors[[]*Region](ctx, rq, qr, rq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (rq *RegionQuery) AllX(ctx context.Context) []*Region {
+	nodes, err := rq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Region IDs.
+func (rq *RegionQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if rq.ctx.Unique == nil && rq.path != nil {
+		rq.Unique(true)
+	}
+	ctx = setContextOp(ctx, rq.ctx, ent.OpQueryIDs)
+	if err = rq.Select(region.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (rq *RegionQuery) IDsX(ctx context.Context) []int {
+	ids, err := rq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (rq *RegionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, rq.ctx, ent.OpQueryCount)
+	if err := rq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, rq, querierCount[*RegionQuery](), rq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (rq *RegionQuery) CountX(ctx context.Context) int {
+	count, err := rq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (rq *RegionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, rq.ctx, ent.OpQueryExist)
+	switch _, err := rq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (rq *RegionQuery) ExistX(ctx context.Context) bool {
+	exist, err := rq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the RegionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (rq *RegionQuery) Clone() *RegionQuery {
+	if rq == nil {
+		return nil
+	}
+	return &RegionQuery{
+		config:      rq.config,
+		ctx:         rq.ctx.Clone(),
+		order:       append([]region.OrderOption{}, rq.order...),
+		inters:      append([]Interceptor{}, rq.inters...),
+		predicates:  append([]predicate.Region{}, rq.predicates...),
+		withBoard:   rq.withBoard.Clone(),
+		withCreator: rq.withCreator.Clone(),
+		// clone intermediate query.
+		sql:  rq.sql.Clone(),
+		path: rq.path,
+	}
+}
+
+// WithBoard tells the query-builder to eager-load the nodes that are connected to
+// the "board" edge. The optional arguments are used to configure the query builder of the edge.
+func (rq *RegionQuery) WithBoard(opts ...func(*BoardQuery)) *RegionQuery {
+	query := (&BoardClient{config: rq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rq.withBoard = query
+	return rq
+}
+
+// WithCreator tells the query-builder to eager-load the nodes that are connected to
+// the "creator" edge. The optional arguments are used to configure the query builder of the edge.
+func (rq *RegionQuery) WithCreator(opts ...func(*UserQuery)) *RegionQuery {
+	query := (&UserClient{config: rq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rq.withCreator = query
+	return rq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Region.Query().
+//		GroupBy(region.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (rq *RegionQuery) GroupBy(field string, fields ...string) *RegionGroupBy {
+	rq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &RegionGroupBy{build: rq}
+	grbuild.flds = &rq.ctx.Fields
+	grbuild.label = region.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.Region.Query().
+//		Select(region.FieldName).
+//		Scan(ctx, &v)
+func (rq *RegionQuery) Select(fields ...string) *RegionSelect {
+	rq.ctx.Fields = append(rq.ctx.Fields, fields...)
+	sbuild := &RegionSelect{RegionQuery: rq}
+	sbuild.label = region.Label
+	sbuild.flds, sbuild.scan = &rq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a RegionSelect configured with the given aggregations.
+func (rq *RegionQuery) Aggregate(fns ...AggregateFunc) *RegionSelect {
+	return rq.Select().Aggregate(fns...)
+}
+
+func (rq *RegionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range rq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, rq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range rq.ctx.Fields {
+		if !region.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if rq.path != nil {
+		prev, err := rq.path(ctx)
+		if err != nil {
+			return err
+		}
+		rq.sql = prev
+	}
+	return nil
+}
+
+func (rq *RegionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Region, error) {
+	var (
+		nodes       = []*Region{}
+		withFKs     = rq.withFKs
+		_spec       = rq.querySpec()
+		loadedTypes = [2]bool{
+			rq.withBoard != nil,
+			rq.withCreator != nil,
+		}
+	)
+	if rq.withCreator != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, region.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*Region).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &Region{config: rq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, rq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := rq.withBoard; query != nil {
+		if err := rq.loadBoard(ctx, query, nodes, nil,
+			func(n *Region, e *Board) { n.Edges.Board = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := rq.withCreator; query != nil {
+		if err := rq.loadCreator(ctx, query, nodes, nil,
+			func(n *Region, e *User) { n.Edges.Creator = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (rq *RegionQuery) loadBoard(ctx context.Context, query *BoardQuery, nodes []*Region, init func(*Region), assign func(*Region, *Board)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Region)
+	for i := range nodes {
+		fk := nodes[i].BoardID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(board.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "board_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (rq *RegionQuery) loadCreator(ctx context.Context, query *UserQuery, nodes []*Region, init func(*Region), assign func(*Region, *User)) error {
+	ids := make([]int64, 0, len(nodes))
+	nodeids := make(map[int64][]*Region)
+	for i := range nodes {
+		if nodes[i].user_regions == nil {
+			continue
+		}
+		fk := *nodes[i].user_regions
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_regions" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (rq *RegionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := rq.querySpec()
+	_spec.Node.Columns = rq.ctx.Fields
+	if len(rq.ctx.Fields) > 0 {
+		_spec.Unique = rq.ctx.Unique != nil && *rq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, rq.driver, _spec)
+}
+
+func (rq *RegionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(region.Table, region.Columns, sqlgraph.NewFieldSpec(region.FieldID, field.TypeInt))
+	_spec.From = rq.sql
+	if unique := rq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if rq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := rq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, region.FieldID)
+		for i := range fields {
+			if fields[i] != region.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if rq.withBoard != nil {
+			_spec.Node.AddColumnOnce(region.FieldBoardID)
+		}
+	}
+	if ps := rq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := rq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := rq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := rq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (rq *RegionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(rq.driver.Dialect())
+	t1 := builder.Table(region.Table)
+	columns := rq.ctx.Fields
+	if len(columns) == 0 {
+		columns = region.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if rq.sql != nil {
+		selector = rq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if rq.ctx.Unique != nil && *rq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range rq.predicates {
+		p(selector)
+	}
+	for _, p := range rq.order {
+		p(selector)
+	}
+	if offset := rq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := rq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// RegionGroupBy is the group-by builder for Region entities.
+type RegionGroupBy struct {
+	selector
+	build *RegionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rgb *RegionGroupBy) Aggregate(fns ...AggregateFunc) *RegionGroupBy {
+	rgb.fns = append(rgb.fns, fns...)
+	return rgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rgb *RegionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rgb.build.ctx, ent.OpQueryGroupBy)
+	if err := rgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RegionQuery, *RegionGroupBy](ctx, rgb.build, rgb, rgb.build.inters, v)
+}
+
+func (rgb *RegionGroupBy) sqlScan(ctx context.Context, root *RegionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(rgb.fns))
+	for _, fn := range rgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*rgb.flds)+len(rgb.fns))
+		for _, f := range *rgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*rgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// RegionSelect is the builder for selecting fields of Region entities.
+type RegionSelect struct {
+	*RegionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (rs *RegionSelect) Aggregate(fns ...AggregateFunc) *RegionSelect {
+	rs.fns = append(rs.fns, fns...)
+	return rs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rs *RegionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rs.ctx, ent.OpQuerySelect)
+	if err := rs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RegionQuery, *RegionSelect](ctx, rs.RegionQuery, rs, rs.inters, v)
+}
+
+func (rs *RegionSelect) sqlScan(ctx context.Context, root *RegionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(rs.fns))
+	for _, fn := range rs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*rs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
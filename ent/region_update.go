@@ -0,0 +1,767 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"nevissGo/ent/board"
+	"nevissGo/ent/predicate"
+	"nevissGo/ent/region"
+	"nevissGo/ent/user"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RegionUpdate is the builder for updating Region entities.
+type RegionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RegionMutation
+}
+
+// Where appends a list predicates to the RegionUpdate builder.
+func (ru *RegionUpdate) Where(ps ...predicate.Region) *RegionUpdate {
+	ru.mutation.Where(ps...)
+	return ru
+}
+
+// SetName sets the "name" field.
+func (ru *RegionUpdate) SetName(s string) *RegionUpdate {
+	ru.mutation.SetName(s)
+	return ru
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ru *RegionUpdate) SetNillableName(s *string) *RegionUpdate {
+	if s != nil {
+		ru.SetName(*s)
+	}
+	return ru
+}
+
+// SetX sets the "x" field.
+func (ru *RegionUpdate) SetX(i int) *RegionUpdate {
+	ru.mutation.ResetX()
+	ru.mutation.SetX(i)
+	return ru
+}
+
+// SetNillableX sets the "x" field if the given value is not nil.
+func (ru *RegionUpdate) SetNillableX(i *int) *RegionUpdate {
+	if i != nil {
+		ru.SetX(*i)
+	}
+	return ru
+}
+
+// AddX adds i to the "x" field.
+func (ru *RegionUpdate) AddX(i int) *RegionUpdate {
+	ru.mutation.AddX(i)
+	return ru
+}
+
+// SetY sets the "y" field.
+func (ru *RegionUpdate) SetY(i int) *RegionUpdate {
+	ru.mutation.ResetY()
+	ru.mutation.SetY(i)
+	return ru
+}
+
+// SetNillableY sets the "y" field if the given value is not nil.
+func (ru *RegionUpdate) SetNillableY(i *int) *RegionUpdate {
+	if i != nil {
+		ru.SetY(*i)
+	}
+	return ru
+}
+
+// AddY adds i to the "y" field.
+func (ru *RegionUpdate) AddY(i int) *RegionUpdate {
+	ru.mutation.AddY(i)
+	return ru
+}
+
+// SetWidth sets the "width" field.
+func (ru *RegionUpdate) SetWidth(i int) *RegionUpdate {
+	ru.mutation.ResetWidth()
+	ru.mutation.SetWidth(i)
+	return ru
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (ru *RegionUpdate) SetNillableWidth(i *int) *RegionUpdate {
+	if i != nil {
+		ru.SetWidth(*i)
+	}
+	return ru
+}
+
+// AddWidth adds i to the "width" field.
+func (ru *RegionUpdate) AddWidth(i int) *RegionUpdate {
+	ru.mutation.AddWidth(i)
+	return ru
+}
+
+// SetHeight sets the "height" field.
+func (ru *RegionUpdate) SetHeight(i int) *RegionUpdate {
+	ru.mutation.ResetHeight()
+	ru.mutation.SetHeight(i)
+	return ru
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (ru *RegionUpdate) SetNillableHeight(i *int) *RegionUpdate {
+	if i != nil {
+		ru.SetHeight(*i)
+	}
+	return ru
+}
+
+// AddHeight adds i to the "height" field.
+func (ru *RegionUpdate) AddHeight(i int) *RegionUpdate {
+	ru.mutation.AddHeight(i)
+	return ru
+}
+
+// SetMask sets the "mask" field.
+func (ru *RegionUpdate) SetMask(s string) *RegionUpdate {
+	ru.mutation.SetMask(s)
+	return ru
+}
+
+// SetNillableMask sets the "mask" field if the given value is not nil.
+func (ru *RegionUpdate) SetNillableMask(s *string) *RegionUpdate {
+	if s != nil {
+		ru.SetMask(*s)
+	}
+	return ru
+}
+
+// ClearMask clears the value of the "mask" field.
+func (ru *RegionUpdate) ClearMask() *RegionUpdate {
+	ru.mutation.ClearMask()
+	return ru
+}
+
+// SetBoardID sets the "board_id" field.
+func (ru *RegionUpdate) SetBoardID(i int) *RegionUpdate {
+	ru.mutation.SetBoardID(i)
+	return ru
+}
+
+// SetNillableBoardID sets the "board_id" field if the given value is not nil.
+func (ru *RegionUpdate) SetNillableBoardID(i *int) *RegionUpdate {
+	if i != nil {
+		ru.SetBoardID(*i)
+	}
+	return ru
+}
+
+// SetBoard sets the "board" edge to the Board entity.
+func (ru *RegionUpdate) SetBoard(b *Board) *RegionUpdate {
+	return ru.SetBoardID(b.ID)
+}
+
+// SetCreatorID sets the "creator" edge to the User entity by ID.
+func (ru *RegionUpdate) SetCreatorID(id int64) *RegionUpdate {
+	ru.mutation.SetCreatorID(id)
+	return ru
+}
+
+// SetNillableCreatorID sets the "creator" edge to the User entity by ID if the given value is not nil.
+func (ru *RegionUpdate) SetNillableCreatorID(id *int64) *RegionUpdate {
+	if id != nil {
+		ru = ru.SetCreatorID(*id)
+	}
+	return ru
+}
+
+// SetCreator sets the "creator" edge to the User entity.
+func (ru *RegionUpdate) SetCreator(u *User) *RegionUpdate {
+	return ru.SetCreatorID(u.ID)
+}
+
+// Mutation returns the RegionMutation object of the builder.
+func (ru *RegionUpdate) Mutation() *RegionMutation {
+	return ru.mutation
+}
+
+// ClearBoard clears the "board" edge to the Board entity.
+func (ru *RegionUpdate) ClearBoard() *RegionUpdate {
+	ru.mutation.ClearBoard()
+	return ru
+}
+
+// ClearCreator clears the "creator" edge to the User entity.
+func (ru *RegionUpdate) ClearCreator() *RegionUpdate {
+	ru.mutation.ClearCreator()
+	return ru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ru *RegionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ru.sqlSave, ru.mutation, ru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ru *RegionUpdate) SaveX(ctx context.Context) int {
+	affected, err := ru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ru *RegionUpdate) Exec(ctx context.Context) error {
+	_, err := ru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ru *RegionUpdate) ExecX(ctx context.Context) {
+	if err := ru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ru *RegionUpdate) check() error {
+	if v, ok := ru.mutation.Name(); ok {
+		if err := region.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Region.name": %w`, err)}
+		}
+	}
+	if v, ok := ru.mutation.X(); ok {
+		if err := region.XValidator(v); err != nil {
+			return &ValidationError{Name: "x", err: fmt.Errorf(`ent: validator failed for field "Region.x": %w`, err)}
+		}
+	}
+	if v, ok := ru.mutation.Y(); ok {
+		if err := region.YValidator(v); err != nil {
+			return &ValidationError{Name: "y", err: fmt.Errorf(`ent: validator failed for field "Region.y": %w`, err)}
+		}
+	}
+	if v, ok := ru.mutation.Width(); ok {
+		if err := region.WidthValidator(v); err != nil {
+			return &ValidationError{Name: "width", err: fmt.Errorf(`ent: validator failed for field "Region.width": %w`, err)}
+		}
+	}
+	if v, ok := ru.mutation.Height(); ok {
+		if err := region.HeightValidator(v); err != nil {
+			return &ValidationError{Name: "height", err: fmt.Errorf(`ent: validator failed for field "Region.height": %w`, err)}
+		}
+	}
+	if ru.mutation.BoardCleared() && len(ru.mutation.BoardIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Region.board"`)
+	}
+	return nil
+}
+
+func (ru *RegionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(region.Table, region.Columns, sqlgraph.NewFieldSpec(region.FieldID, field.TypeInt))
+	if ps := ru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ru.mutation.Name(); ok {
+		_spec.SetField(region.FieldName, field.TypeString, value)
+	}
+	if value, ok := ru.mutation.X(); ok {
+		_spec.SetField(region.FieldX, field.TypeInt, value)
+	}
+	if value, ok := ru.mutation.AddedX(); ok {
+		_spec.AddField(region.FieldX, field.TypeInt, value)
+	}
+	if value, ok := ru.mutation.Y(); ok {
+		_spec.SetField(region.FieldY, field.TypeInt, value)
+	}
+	if value, ok := ru.mutation.AddedY(); ok {
+		_spec.AddField(region.FieldY, field.TypeInt, value)
+	}
+	if value, ok := ru.mutation.Width(); ok {
+		_spec.SetField(region.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := ru.mutation.AddedWidth(); ok {
+		_spec.AddField(region.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := ru.mutation.Height(); ok {
+		_spec.SetField(region.FieldHeight, field.TypeInt, value)
+	}
+	if value, ok := ru.mutation.AddedHeight(); ok {
+		_spec.AddField(region.FieldHeight, field.TypeInt, value)
+	}
+	if value, ok := ru.mutation.Mask(); ok {
+		_spec.SetField(region.FieldMask, field.TypeString, value)
+	}
+	if ru.mutation.MaskCleared() {
+		_spec.ClearField(region.FieldMask, field.TypeString)
+	}
+	if ru.mutation.BoardCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   region.BoardTable,
+			Columns: []string{region.BoardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.BoardIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   region.BoardTable,
+			Columns: []string{region.BoardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ru.mutation.CreatorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   region.CreatorTable,
+			Columns: []string{region.CreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.CreatorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   region.CreatorTable,
+			Columns: []string{region.CreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{region.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ru.mutation.done = true
+	return n, nil
+}
+
+// RegionUpdateOne is the builder for updating a single Region entity.
+type RegionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RegionMutation
+}
+
+// SetName sets the "name" field.
+func (ruo *RegionUpdateOne) SetName(s string) *RegionUpdateOne {
+	ruo.mutation.SetName(s)
+	return ruo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ruo *RegionUpdateOne) SetNillableName(s *string) *RegionUpdateOne {
+	if s != nil {
+		ruo.SetName(*s)
+	}
+	return ruo
+}
+
+// SetX sets the "x" field.
+func (ruo *RegionUpdateOne) SetX(i int) *RegionUpdateOne {
+	ruo.mutation.ResetX()
+	ruo.mutation.SetX(i)
+	return ruo
+}
+
+// SetNillableX sets the "x" field if the given value is not nil.
+func (ruo *RegionUpdateOne) SetNillableX(i *int) *RegionUpdateOne {
+	if i != nil {
+		ruo.SetX(*i)
+	}
+	return ruo
+}
+
+// AddX adds i to the "x" field.
+func (ruo *RegionUpdateOne) AddX(i int) *RegionUpdateOne {
+	ruo.mutation.AddX(i)
+	return ruo
+}
+
+// SetY sets the "y" field.
+func (ruo *RegionUpdateOne) SetY(i int) *RegionUpdateOne {
+	ruo.mutation.ResetY()
+	ruo.mutation.SetY(i)
+	return ruo
+}
+
+// SetNillableY sets the "y" field if the given value is not nil.
+func (ruo *RegionUpdateOne) SetNillableY(i *int) *RegionUpdateOne {
+	if i != nil {
+		ruo.SetY(*i)
+	}
+	return ruo
+}
+
+// AddY adds i to the "y" field.
+func (ruo *RegionUpdateOne) AddY(i int) *RegionUpdateOne {
+	ruo.mutation.AddY(i)
+	return ruo
+}
+
+// SetWidth sets the "width" field.
+func (ruo *RegionUpdateOne) SetWidth(i int) *RegionUpdateOne {
+	ruo.mutation.ResetWidth()
+	ruo.mutation.SetWidth(i)
+	return ruo
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (ruo *RegionUpdateOne) SetNillableWidth(i *int) *RegionUpdateOne {
+	if i != nil {
+		ruo.SetWidth(*i)
+	}
+	return ruo
+}
+
+// AddWidth adds i to the "width" field.
+func (ruo *RegionUpdateOne) AddWidth(i int) *RegionUpdateOne {
+	ruo.mutation.AddWidth(i)
+	return ruo
+}
+
+// SetHeight sets the "height" field.
+func (ruo *RegionUpdateOne) SetHeight(i int) *RegionUpdateOne {
+	ruo.mutation.ResetHeight()
+	ruo.mutation.SetHeight(i)
+	return ruo
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (ruo *RegionUpdateOne) SetNillableHeight(i *int) *RegionUpdateOne {
+	if i != nil {
+		ruo.SetHeight(*i)
+	}
+	return ruo
+}
+
+// AddHeight adds i to the "height" field.
+func (ruo *RegionUpdateOne) AddHeight(i int) *RegionUpdateOne {
+	ruo.mutation.AddHeight(i)
+	return ruo
+}
+
+// SetMask sets the "mask" field.
+func (ruo *RegionUpdateOne) SetMask(s string) *RegionUpdateOne {
+	ruo.mutation.SetMask(s)
+	return ruo
+}
+
+// SetNillableMask sets the "mask" field if the given value is not nil.
+func (ruo *RegionUpdateOne) SetNillableMask(s *string) *RegionUpdateOne {
+	if s != nil {
+		ruo.SetMask(*s)
+	}
+	return ruo
+}
+
+// ClearMask clears the value of the "mask" field.
+func (ruo *RegionUpdateOne) ClearMask() *RegionUpdateOne {
+	ruo.mutation.ClearMask()
+	return ruo
+}
+
+// SetBoardID sets the "board_id" field.
+func (ruo *RegionUpdateOne) SetBoardID(i int) *RegionUpdateOne {
+	ruo.mutation.SetBoardID(i)
+	return ruo
+}
+
+// SetNillableBoardID sets the "board_id" field if the given value is not nil.
+func (ruo *RegionUpdateOne) SetNillableBoardID(i *int) *RegionUpdateOne {
+	if i != nil {
+		ruo.SetBoardID(*i)
+	}
+	return ruo
+}
+
+// SetBoard sets the "board" edge to the Board entity.
+func (ruo *RegionUpdateOne) SetBoard(b *Board) *RegionUpdateOne {
+	return ruo.SetBoardID(b.ID)
+}
+
+// SetCreatorID sets the "creator" edge to the User entity by ID.
+func (ruo *RegionUpdateOne) SetCreatorID(id int64) *RegionUpdateOne {
+	ruo.mutation.SetCreatorID(id)
+	return ruo
+}
+
+// SetNillableCreatorID sets the "creator" edge to the User entity by ID if the given value is not nil.
+func (ruo *RegionUpdateOne) SetNillableCreatorID(id *int64) *RegionUpdateOne {
+	if id != nil {
+		ruo = ruo.SetCreatorID(*id)
+	}
+	return ruo
+}
+
+// SetCreator sets the "creator" edge to the User entity.
+func (ruo *RegionUpdateOne) SetCreator(u *User) *RegionUpdateOne {
+	return ruo.SetCreatorID(u.ID)
+}
+
+// Mutation returns the RegionMutation object of the builder.
+func (ruo *RegionUpdateOne) Mutation() *RegionMutation {
+	return ruo.mutation
+}
+
+// ClearBoard clears the "board" edge to the Board entity.
+func (ruo *RegionUpdateOne) ClearBoard() *RegionUpdateOne {
+	ruo.mutation.ClearBoard()
+	return ruo
+}
+
+// ClearCreator clears the "creator" edge to the User entity.
+func (ruo *RegionUpdateOne) ClearCreator() *RegionUpdateOne {
+	ruo.mutation.ClearCreator()
+	return ruo
+}
+
+// Where appends a list predicates to the RegionUpdate builder.
+func (ruo *RegionUpdateOne) Where(ps ...predicate.Region) *RegionUpdateOne {
+	ruo.mutation.Where(ps...)
+	return ruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ruo *RegionUpdateOne) Select(field string, fields ...string) *RegionUpdateOne {
+	ruo.fields = append([]string{field}, fields...)
+	return ruo
+}
+
+// Save executes the query and returns the updated Region entity.
+func (ruo *RegionUpdateOne) Save(ctx context.Context) (*Region, error) {
+	return withHooks(ctx, ruo.sqlSave, ruo.mutation, ruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ruo *RegionUpdateOne) SaveX(ctx context.Context) *Region {
+	node, err := ruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ruo *RegionUpdateOne) Exec(ctx context.Context) error {
+	_, err := ruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ruo *RegionUpdateOne) ExecX(ctx context.Context) {
+	if err := ruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ruo *RegionUpdateOne) check() error {
+	if v, ok := ruo.mutation.Name(); ok {
+		if err := region.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Region.name": %w`, err)}
+		}
+	}
+	if v, ok := ruo.mutation.X(); ok {
+		if err := region.XValidator(v); err != nil {
+			return &ValidationError{Name: "x", err: fmt.Errorf(`ent: validator failed for field "Region.x": %w`, err)}
+		}
+	}
+	if v, ok := ruo.mutation.Y(); ok {
+		if err := region.YValidator(v); err != nil {
+			return &ValidationError{Name: "y", err: fmt.Errorf(`ent: validator failed for field "Region.y": %w`, err)}
+		}
+	}
+	if v, ok := ruo.mutation.Width(); ok {
+		if err := region.WidthValidator(v); err != nil {
+			return &ValidationError{Name: "width", err: fmt.Errorf(`ent: validator failed for field "Region.width": %w`, err)}
+		}
+	}
+	if v, ok := ruo.mutation.Height(); ok {
+		if err := region.HeightValidator(v); err != nil {
+			return &ValidationError{Name: "height", err: fmt.Errorf(`ent: validator failed for field "Region.height": %w`, err)}
+		}
+	}
+	if ruo.mutation.BoardCleared() && len(ruo.mutation.BoardIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Region.board"`)
+	}
+	return nil
+}
+
+func (ruo *RegionUpdateOne) sqlSave(ctx context.Context) (_node *Region, err error) {
+	if err := ruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(region.Table, region.Columns, sqlgraph.NewFieldSpec(region.FieldID, field.TypeInt))
+	id, ok := ruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Region.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, region.FieldID)
+		for _, f := range fields {
+			if !region.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != region.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ruo.mutation.Name(); ok {
+		_spec.SetField(region.FieldName, field.TypeString, value)
+	}
+	if value, ok := ruo.mutation.X(); ok {
+		_spec.SetField(region.FieldX, field.TypeInt, value)
+	}
+	if value, ok := ruo.mutation.AddedX(); ok {
+		_spec.AddField(region.FieldX, field.TypeInt, value)
+	}
+	if value, ok := ruo.mutation.Y(); ok {
+		_spec.SetField(region.FieldY, field.TypeInt, value)
+	}
+	if value, ok := ruo.mutation.AddedY(); ok {
+		_spec.AddField(region.FieldY, field.TypeInt, value)
+	}
+	if value, ok := ruo.mutation.Width(); ok {
+		_spec.SetField(region.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := ruo.mutation.AddedWidth(); ok {
+		_spec.AddField(region.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := ruo.mutation.Height(); ok {
+		_spec.SetField(region.FieldHeight, field.TypeInt, value)
+	}
+	if value, ok := ruo.mutation.AddedHeight(); ok {
+		_spec.AddField(region.FieldHeight, field.TypeInt, value)
+	}
+	if value, ok := ruo.mutation.Mask(); ok {
+		_spec.SetField(region.FieldMask, field.TypeString, value)
+	}
+	if ruo.mutation.MaskCleared() {
+		_spec.ClearField(region.FieldMask, field.TypeString)
+	}
+	if ruo.mutation.BoardCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   region.BoardTable,
+			Columns: []string{region.BoardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.BoardIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   region.BoardTable,
+			Columns: []string{region.BoardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ruo.mutation.CreatorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   region.CreatorTable,
+			Columns: []string{region.CreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.CreatorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   region.CreatorTable,
+			Columns: []string{region.CreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Region{config: ruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{region.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ruo.mutation.done = true
+	return _node, nil
+}
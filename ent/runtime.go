@@ -3,9 +3,15 @@
 package ent
 
 import (
+	"nevissGo/ent/board"
 	"nevissGo/ent/hype"
+	"nevissGo/ent/hypeledger"
+	"nevissGo/ent/outboxevent"
 	"nevissGo/ent/pixel"
+	"nevissGo/ent/pixelchange"
+	"nevissGo/ent/region"
 	"nevissGo/ent/schema"
+	"nevissGo/ent/team"
 	"time"
 )
 
@@ -13,6 +19,52 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	boardFields := schema.Board{}.Fields()
+	_ = boardFields
+	// boardDescName is the schema descriptor for name field.
+	boardDescName := boardFields[0].Descriptor()
+	// board.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	board.NameValidator = boardDescName.Validators[0].(func(string) error)
+	// boardDescWidth is the schema descriptor for width field.
+	boardDescWidth := boardFields[1].Descriptor()
+	// board.WidthValidator is a validator for the "width" field. It is called by the builders before save.
+	board.WidthValidator = boardDescWidth.Validators[0].(func(int) error)
+	// boardDescHeight is the schema descriptor for height field.
+	boardDescHeight := boardFields[2].Descriptor()
+	// board.HeightValidator is a validator for the "height" field. It is called by the builders before save.
+	board.HeightValidator = boardDescHeight.Validators[0].(func(int) error)
+	// boardDescCooldown is the schema descriptor for cooldown field.
+	boardDescCooldown := boardFields[3].Descriptor()
+	// board.DefaultCooldown holds the default value on creation for the cooldown field.
+	board.DefaultCooldown = time.Duration(boardDescCooldown.Default.(int64))
+	// boardDescUserCooldown is the schema descriptor for user_cooldown field.
+	boardDescUserCooldown := boardFields[4].Descriptor()
+	// board.DefaultUserCooldown holds the default value on creation for the user_cooldown field.
+	board.DefaultUserCooldown = time.Duration(boardDescUserCooldown.Default.(int64))
+	// boardDescHypeCost is the schema descriptor for hype_cost field.
+	boardDescHypeCost := boardFields[5].Descriptor()
+	// board.DefaultHypeCost holds the default value on creation for the hype_cost field.
+	board.DefaultHypeCost = boardDescHypeCost.Default.(int)
+	// board.HypeCostValidator is a validator for the "hype_cost" field. It is called by the builders before save.
+	board.HypeCostValidator = boardDescHypeCost.Validators[0].(func(int) error)
+	// boardDescPalette is the schema descriptor for palette field.
+	boardDescPalette := boardFields[6].Descriptor()
+	// board.DefaultPalette holds the default value on creation for the palette field.
+	board.DefaultPalette = boardDescPalette.Default.(string)
+	// boardDescOpen is the schema descriptor for open field.
+	boardDescOpen := boardFields[7].Descriptor()
+	// board.DefaultOpen holds the default value on creation for the open field.
+	board.DefaultOpen = boardDescOpen.Default.(bool)
+	// boardDescSeq is the schema descriptor for seq field.
+	boardDescSeq := boardFields[8].Descriptor()
+	// board.DefaultSeq holds the default value on creation for the seq field.
+	board.DefaultSeq = boardDescSeq.Default.(int64)
+	// board.SeqValidator is a validator for the "seq" field. It is called by the builders before save.
+	board.SeqValidator = boardDescSeq.Validators[0].(func(int64) error)
+	// boardDescCreatedAt is the schema descriptor for created_at field.
+	boardDescCreatedAt := boardFields[9].Descriptor()
+	// board.DefaultCreatedAt holds the default value on creation for the created_at field.
+	board.DefaultCreatedAt = boardDescCreatedAt.Default.(func() time.Time)
 	hypeFields := schema.Hype{}.Fields()
 	_ = hypeFields
 	// hypeDescLastUpdatedAt is the schema descriptor for last_updated_at field.
@@ -25,12 +77,102 @@ func init() {
 	hypeDescHypePerMinute := hypeFields[3].Descriptor()
 	// hype.DefaultHypePerMinute holds the default value on creation for the hype_per_minute field.
 	hype.DefaultHypePerMinute = hypeDescHypePerMinute.Default.(int)
+	// hypeDescCustomLimits is the schema descriptor for custom_limits field.
+	hypeDescCustomLimits := hypeFields[4].Descriptor()
+	// hype.DefaultCustomLimits holds the default value on creation for the custom_limits field.
+	hype.DefaultCustomLimits = hypeDescCustomLimits.Default.(bool)
+	// hypeDescVersion is the schema descriptor for version field.
+	hypeDescVersion := hypeFields[5].Descriptor()
+	// hype.DefaultVersion holds the default value on creation for the version field.
+	hype.DefaultVersion = hypeDescVersion.Default.(int)
+	hypeledgerFields := schema.HypeLedger{}.Fields()
+	_ = hypeledgerFields
+	// hypeledgerDescReason is the schema descriptor for reason field.
+	hypeledgerDescReason := hypeledgerFields[3].Descriptor()
+	// hypeledger.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	hypeledger.ReasonValidator = hypeledgerDescReason.Validators[0].(func(string) error)
+	// hypeledgerDescCreatedAt is the schema descriptor for created_at field.
+	hypeledgerDescCreatedAt := hypeledgerFields[4].Descriptor()
+	// hypeledger.DefaultCreatedAt holds the default value on creation for the created_at field.
+	hypeledger.DefaultCreatedAt = hypeledgerDescCreatedAt.Default.(func() time.Time)
+	outboxeventFields := schema.OutboxEvent{}.Fields()
+	_ = outboxeventFields
+	// outboxeventDescName is the schema descriptor for name field.
+	outboxeventDescName := outboxeventFields[2].Descriptor()
+	// outboxevent.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	outboxevent.NameValidator = outboxeventDescName.Validators[0].(func(string) error)
+	// outboxeventDescAttempts is the schema descriptor for attempts field.
+	outboxeventDescAttempts := outboxeventFields[4].Descriptor()
+	// outboxevent.DefaultAttempts holds the default value on creation for the attempts field.
+	outboxevent.DefaultAttempts = outboxeventDescAttempts.Default.(int)
+	// outboxevent.AttemptsValidator is a validator for the "attempts" field. It is called by the builders before save.
+	outboxevent.AttemptsValidator = outboxeventDescAttempts.Validators[0].(func(int) error)
+	// outboxeventDescNextAttemptAt is the schema descriptor for next_attempt_at field.
+	outboxeventDescNextAttemptAt := outboxeventFields[5].Descriptor()
+	// outboxevent.DefaultNextAttemptAt holds the default value on creation for the next_attempt_at field.
+	outboxevent.DefaultNextAttemptAt = outboxeventDescNextAttemptAt.Default.(func() time.Time)
+	// outboxeventDescCreatedAt is the schema descriptor for created_at field.
+	outboxeventDescCreatedAt := outboxeventFields[6].Descriptor()
+	// outboxevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	outboxevent.DefaultCreatedAt = outboxeventDescCreatedAt.Default.(func() time.Time)
 	pixelFields := schema.Pixel{}.Fields()
 	_ = pixelFields
+	// pixelDescPosition is the schema descriptor for position field.
+	pixelDescPosition := pixelFields[1].Descriptor()
+	// pixel.DefaultPosition holds the default value on creation for the position field.
+	pixel.DefaultPosition = pixelDescPosition.Default.(int)
 	// pixelDescUpdatedAt is the schema descriptor for updated_at field.
-	pixelDescUpdatedAt := pixelFields[2].Descriptor()
+	pixelDescUpdatedAt := pixelFields[3].Descriptor()
 	// pixel.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	pixel.DefaultUpdatedAt = pixelDescUpdatedAt.Default.(func() time.Time)
 	// pixel.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	pixel.UpdateDefaultUpdatedAt = pixelDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// pixelDescSeq is the schema descriptor for seq field.
+	pixelDescSeq := pixelFields[4].Descriptor()
+	// pixel.DefaultSeq holds the default value on creation for the seq field.
+	pixel.DefaultSeq = pixelDescSeq.Default.(int64)
+	pixelchangeFields := schema.PixelChange{}.Fields()
+	_ = pixelchangeFields
+	// pixelchangeDescCreatedAt is the schema descriptor for created_at field.
+	pixelchangeDescCreatedAt := pixelchangeFields[4].Descriptor()
+	// pixelchange.DefaultCreatedAt holds the default value on creation for the created_at field.
+	pixelchange.DefaultCreatedAt = pixelchangeDescCreatedAt.Default.(func() time.Time)
+	regionFields := schema.Region{}.Fields()
+	_ = regionFields
+	// regionDescName is the schema descriptor for name field.
+	regionDescName := regionFields[0].Descriptor()
+	// region.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	region.NameValidator = regionDescName.Validators[0].(func(string) error)
+	// regionDescX is the schema descriptor for x field.
+	regionDescX := regionFields[1].Descriptor()
+	// region.XValidator is a validator for the "x" field. It is called by the builders before save.
+	region.XValidator = regionDescX.Validators[0].(func(int) error)
+	// regionDescY is the schema descriptor for y field.
+	regionDescY := regionFields[2].Descriptor()
+	// region.YValidator is a validator for the "y" field. It is called by the builders before save.
+	region.YValidator = regionDescY.Validators[0].(func(int) error)
+	// regionDescWidth is the schema descriptor for width field.
+	regionDescWidth := regionFields[3].Descriptor()
+	// region.WidthValidator is a validator for the "width" field. It is called by the builders before save.
+	region.WidthValidator = regionDescWidth.Validators[0].(func(int) error)
+	// regionDescHeight is the schema descriptor for height field.
+	regionDescHeight := regionFields[4].Descriptor()
+	// region.HeightValidator is a validator for the "height" field. It is called by the builders before save.
+	region.HeightValidator = regionDescHeight.Validators[0].(func(int) error)
+	// regionDescCreatedAt is the schema descriptor for created_at field.
+	regionDescCreatedAt := regionFields[7].Descriptor()
+	// region.DefaultCreatedAt holds the default value on creation for the created_at field.
+	region.DefaultCreatedAt = regionDescCreatedAt.Default.(func() time.Time)
+	teamFields := schema.Team{}.Fields()
+	_ = teamFields
+	// teamDescName is the schema descriptor for name field.
+	teamDescName := teamFields[0].Descriptor()
+	// team.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	team.NameValidator = teamDescName.Validators[0].(func(string) error)
+	// teamDescCreatedAt is the schema descriptor for created_at field.
+	teamDescCreatedAt := teamFields[2].Descriptor()
+	// team.DefaultCreatedAt holds the default value on creation for the created_at field.
+	team.DefaultCreatedAt = teamDescCreatedAt.Default.(func() time.Time)
+	userFields := schema.User{}.Fields()
+	_ = userFields
 }
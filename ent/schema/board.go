@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"time"
+)
+
+// Board holds the schema definition for the Board entity.
+type Board struct {
+	ent.Schema
+}
+
+// Fields of the Board.
+func (Board) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").NotEmpty(),
+		field.Int("width").Positive(),
+		field.Int("height").Positive(),
+		field.Int64("cooldown").GoType(time.Duration(0)).Default(0),
+		// user_cooldown is how long a user has to wait between any two paints
+		// on the board. Zero disables it.
+		field.Int64("user_cooldown").GoType(time.Duration(0)).Default(0),
+		field.Int("hype_cost").Default(1).NonNegative(),
+		field.String("palette").Default("default"),
+		field.Bool("open").Default(true),
+		// seq is the last sequence number given to a change of the board's
+		// pixels. Changes bump it in their transaction, which keeps the board
+		// row locked until they commit.
+		field.Int64("seq").Default(0).NonNegative(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the Board.
+func (Board) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("pixels", Pixel.Type),
+		edge.To("changes", PixelChange.Type),
+		edge.To("regions", Region.Type),
+		edge.From("owner", User.Type).
+			Ref("boards").
+			Unique(),
+	}
+}
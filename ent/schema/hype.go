@@ -17,6 +17,11 @@ func (Hype) Fields() []ent.Field {
 		field.Int("max_hype"),
 		field.Time("last_updated_at").Default(time.Now).UpdateDefault(time.Now),
 		field.Int("hype_per_minute").Default(2),
+		// custom_limits is set for users whose max_hype and hype_per_minute
+		// were overridden; everyone else follows the configured defaults.
+		field.Bool("custom_limits").Default(false),
+		// version is bumped on every write so updates can compare-and-set.
+		field.Int("version").Default(0),
 	}
 }
 
@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// HypeLedger holds the schema definition for the HypeLedger entity. Every
+// hype adjustment that is not a regular paint or refill is recorded here with
+// its reason.
+type HypeLedger struct {
+	ent.Schema
+}
+
+// Fields of the HypeLedger.
+func (HypeLedger) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("kind").Values("grant", "refund", "bonus", "limits").Immutable(),
+		field.Int("amount").Immutable(),
+		field.Int("balance").Immutable(),
+		field.String("reason").NotEmpty().Immutable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the HypeLedger.
+func (HypeLedger) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("hype_ledger").
+			Unique().
+			Required().
+			Immutable(),
+		edge.From("actor", User.Type).
+			Ref("hype_adjustments").
+			Unique().
+			Immutable(),
+	}
+}
+
+// Indexes of the HypeLedger.
+func (HypeLedger) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at").Edges("user"),
+	}
+}
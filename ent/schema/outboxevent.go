@@ -0,0 +1,28 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"time"
+)
+
+// OutboxEvent holds the schema definition for the OutboxEvent entity. Events
+// are stored in the transaction of the change they announce and deleted once
+// they were sent to clients.
+type OutboxEvent struct {
+	ent.Schema
+}
+
+// Fields of the OutboxEvent.
+func (OutboxEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("kind").Values("broadcast", "team"),
+		// target is the team of team events.
+		field.String("target").Optional(),
+		field.String("name").NotEmpty(),
+		field.Bytes("payload"),
+		field.Int("attempts").Default(0).NonNegative(),
+		field.Time("next_attempt_at").Default(time.Now),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"time"
 )
 
@@ -16,8 +17,13 @@ type Pixel struct {
 func (Pixel) Fields() []ent.Field {
 	return []ent.Field{
 		field.Int("id").StructTag(`json:"oid,omitempty"`),
+		field.Int("position").Default(0),
 		field.String("color"),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+		field.Int64("seq").Default(0),
+		field.Int64("user_id").Optional().StorageKey("user_pixels"),
+		field.Int("board_id").Optional().StorageKey("board_pixels"),
+		field.Int("team_id").Optional().Nillable(),
 	}
 
 }
@@ -27,6 +33,24 @@ func (Pixel) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("user", User.Type).
 			Ref("pixels").
+			Field("user_id").
 			Unique(),
+		edge.From("board", Board.Type).
+			Ref("pixels").
+			Field("board_id").
+			Unique(),
+		edge.From("team", Team.Type).
+			Ref("pixels").
+			Field("team_id").
+			Unique(),
+		edge.To("changes", PixelChange.Type),
+	}
+}
+
+// Indexes of the Pixel.
+func (Pixel) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("position").Edges("board").Unique(),
+		index.Fields("seq").Edges("board").Unique(),
 	}
 }
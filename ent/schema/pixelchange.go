@@ -0,0 +1,65 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// PixelChange holds the schema definition for the PixelChange entity. Rows are
+// only ever appended, one for every paint. Only resizing a board rewrites
+// their positions.
+type PixelChange struct {
+	ent.Schema
+}
+
+// Fields of the PixelChange.
+func (PixelChange) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("position"),
+		field.String("old_color").Immutable(),
+		field.String("new_color").Immutable(),
+		field.Int64("seq").Immutable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Int("board_id").Immutable().StorageKey("board_changes"),
+		field.Int64("user_id").Optional().Immutable().StorageKey("user_changes"),
+		field.Int("team_id").Optional().Nillable().Immutable(),
+	}
+}
+
+// Edges of the PixelChange.
+func (PixelChange) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("pixel", Pixel.Type).
+			Ref("changes").
+			Unique().
+			Immutable(),
+		edge.From("board", Board.Type).
+			Ref("changes").
+			Field("board_id").
+			Unique().
+			Required().
+			Immutable(),
+		edge.From("user", User.Type).
+			Ref("changes").
+			Field("user_id").
+			Unique().
+			Immutable(),
+		edge.From("team", Team.Type).
+			Ref("changes").
+			Field("team_id").
+			Unique().
+			Immutable(),
+	}
+}
+
+// Indexes of the PixelChange.
+func (PixelChange) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("position").Edges("board"),
+		index.Fields("seq").Edges("board"),
+		index.Fields("created_at").Edges("user"),
+	}
+}
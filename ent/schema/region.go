@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"time"
+)
+
+// Region holds the schema definition for the Region entity. Pixels inside a
+// region can not be painted.
+type Region struct {
+	ent.Schema
+}
+
+// Fields of the Region.
+func (Region) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").NotEmpty(),
+		field.Int("x").NonNegative(),
+		field.Int("y").NonNegative(),
+		field.Int("width").Positive(),
+		field.Int("height").Positive(),
+		// mask narrows the rectangle down to an arbitrary shape: one '0' or
+		// '1' per pixel of the rectangle, row by row. Empty protects it all.
+		field.Text("mask").Optional(),
+		field.Int("board_id"),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the Region.
+func (Region) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("board", Board.Type).
+			Ref("regions").
+			Field("board_id").
+			Unique().
+			Required(),
+		edge.From("creator", User.Type).
+			Ref("regions").
+			Unique(),
+	}
+}
@@ -0,0 +1,32 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"time"
+)
+
+// Team holds the schema definition for the Team entity. Players join a team
+// with its invite code and paint on its behalf.
+type Team struct {
+	ent.Schema
+}
+
+// Fields of the Team.
+func (Team) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").NotEmpty(),
+		field.String("invite_code").Unique(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the Team.
+func (Team) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("members", User.Type),
+		edge.To("pixels", Pixel.Type),
+		edge.To("changes", PixelChange.Type),
+	}
+}
@@ -16,6 +16,10 @@ func (User) Fields() []ent.Field {
 		field.Int64("id"),
 		field.String("display_name"),
 		field.String("game_id"),
+		field.Int("team_id").Optional().Nillable(),
+		field.Enum("role").Values("user", "admin").Default("user"),
+		field.Time("banned_at").Optional().Nillable(),
+		field.String("ban_reason").Optional(),
 	}
 }
 
@@ -23,6 +27,15 @@ func (User) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("pixels", Pixel.Type),
 		edge.To("hype", Hype.Type).Unique(),
+		edge.To("boards", Board.Type),
+		edge.To("changes", PixelChange.Type),
+		edge.To("hype_ledger", HypeLedger.Type),
+		edge.To("hype_adjustments", HypeLedger.Type),
+		edge.To("regions", Region.Type),
+		edge.From("team", Team.Type).
+			Ref("members").
+			Field("team_id").
+			Unique(),
 	}
 }
 
@@ -0,0 +1,186 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"nevissGo/ent/team"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Team is the model entity for the Team schema.
+type Team struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// InviteCode holds the value of the "invite_code" field.
+	InviteCode string `json:"invite_code,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TeamQuery when eager-loading is set.
+	Edges        TeamEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TeamEdges holds the relations/edges for other nodes in the graph.
+type TeamEdges struct {
+	// Members holds the value of the members edge.
+	Members []*User `json:"members,omitempty"`
+	// Pixels holds the value of the pixels edge.
+	Pixels []*Pixel `json:"pixels,omitempty"`
+	// Changes holds the value of the changes edge.
+	Changes []*PixelChange `json:"changes,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// MembersOrErr returns the Members value or an error if the edge
+// was not loaded in eager-loading.
+func (e TeamEdges) MembersOrErr() ([]*User, error) {
+	if e.loadedTypes[0] {
+		return e.Members, nil
+	}
+	return nil, &NotLoadedError{edge: "members"}
+}
+
+// PixelsOrErr returns the Pixels value or an error if the edge
+// was not loaded in eager-loading.
+func (e TeamEdges) PixelsOrErr() ([]*Pixel, error) {
+	if e.loadedTypes[1] {
+		return e.Pixels, nil
+	}
+	return nil, &NotLoadedError{edge: "pixels"}
+}
+
+// ChangesOrErr returns the Changes value or an error if the edge
+// was not loaded in eager-loading.
+func (e TeamEdges) ChangesOrErr() ([]*PixelChange, error) {
+	if e.loadedTypes[2] {
+		return e.Changes, nil
+	}
+	return nil, &NotLoadedError{edge: "changes"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Team) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case team.FieldID:
+			values[i] = new(sql.NullInt64)
+		case team.FieldName, team.FieldInviteCode:
+			values[i] = new(sql.NullString)
+		case team.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Team fields.
+func (t *Team) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case team.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			t.ID = int(value.Int64)
+		case team.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				t.Name = value.String
+			}
+		case team.FieldInviteCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field invite_code", values[i])
+			} else if value.Valid {
+				t.InviteCode = value.String
+			}
+		case team.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				t.CreatedAt = value.Time
+			}
+		default:
+			t.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Team.
+// This includes values selected through modifiers, order, etc.
+func (t *Team) Value(name string) (ent.Value, error) {
+	return t.selectValues.Get(name)
+}
+
+// QueryMembers queries the "members" edge of the Team entity.
+func (t *Team) QueryMembers() *UserQuery {
+	return NewTeamClient(t.config).QueryMembers(t)
+}
+
+// QueryPixels queries the "pixels" edge of the Team entity.
+func (t *Team) QueryPixels() *PixelQuery {
+	return NewTeamClient(t.config).QueryPixels(t)
+}
+
+// QueryChanges queries the "changes" edge of the Team entity.
+func (t *Team) QueryChanges() *PixelChangeQuery {
+	return NewTeamClient(t.config).QueryChanges(t)
+}
+
+// Update returns a builder for updating this Team.
+// Note that you need to call Team.Unwrap() before calling this method if this Team
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (t *Team) Update() *TeamUpdateOne {
+	return NewTeamClient(t.config).UpdateOne(t)
+}
+
+// Unwrap unwraps the Team entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (t *Team) Unwrap() *Team {
+	_tx, ok := t.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Team is not a transactional entity")
+	}
+	t.config.driver = _tx.drv
+	return t
+}
+
+// String implements the fmt.Stringer.
+func (t *Team) String() string {
+	var builder strings.Builder
+	builder.WriteString("Team(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", t.ID))
+	builder.WriteString("name=")
+	builder.WriteString(t.Name)
+	builder.WriteString(", ")
+	builder.WriteString("invite_code=")
+	builder.WriteString(t.InviteCode)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(t.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Teams is a parsable slice of Team.
+type Teams []*Team
@@ -0,0 +1,758 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"nevissGo/ent/pixel"
+	"nevissGo/ent/pixelchange"
+	"nevissGo/ent/predicate"
+	"nevissGo/ent/team"
+	"nevissGo/ent/user"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TeamUpdate is the builder for updating Team entities.
+type TeamUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TeamMutation
+}
+
+// Where appends a list predicates to the TeamUpdate builder.
+func (tu *TeamUpdate) Where(ps ...predicate.Team) *TeamUpdate {
+	tu.mutation.Where(ps...)
+	return tu
+}
+
+// SetName sets the "name" field.
+func (tu *TeamUpdate) SetName(s string) *TeamUpdate {
+	tu.mutation.SetName(s)
+	return tu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (tu *TeamUpdate) SetNillableName(s *string) *TeamUpdate {
+	if s != nil {
+		tu.SetName(*s)
+	}
+	return tu
+}
+
+// SetInviteCode sets the "invite_code" field.
+func (tu *TeamUpdate) SetInviteCode(s string) *TeamUpdate {
+	tu.mutation.SetInviteCode(s)
+	return tu
+}
+
+// SetNillableInviteCode sets the "invite_code" field if the given value is not nil.
+func (tu *TeamUpdate) SetNillableInviteCode(s *string) *TeamUpdate {
+	if s != nil {
+		tu.SetInviteCode(*s)
+	}
+	return tu
+}
+
+// AddMemberIDs adds the "members" edge to the User entity by IDs.
+func (tu *TeamUpdate) AddMemberIDs(ids ...int64) *TeamUpdate {
+	tu.mutation.AddMemberIDs(ids...)
+	return tu
+}
+
+// AddMembers adds the "members" edges to the User entity.
+func (tu *TeamUpdate) AddMembers(u ...*User) *TeamUpdate {
+	ids := make([]int64, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return tu.AddMemberIDs(ids...)
+}
+
+// AddPixelIDs adds the "pixels" edge to the Pixel entity by IDs.
+func (tu *TeamUpdate) AddPixelIDs(ids ...int) *TeamUpdate {
+	tu.mutation.AddPixelIDs(ids...)
+	return tu
+}
+
+// AddPixels adds the "pixels" edges to the Pixel entity.
+func (tu *TeamUpdate) AddPixels(p ...*Pixel) *TeamUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return tu.AddPixelIDs(ids...)
+}
+
+// AddChangeIDs adds the "changes" edge to the PixelChange entity by IDs.
+func (tu *TeamUpdate) AddChangeIDs(ids ...int) *TeamUpdate {
+	tu.mutation.AddChangeIDs(ids...)
+	return tu
+}
+
+// AddChanges adds the "changes" edges to the PixelChange entity.
+func (tu *TeamUpdate) AddChanges(p ...*PixelChange) *TeamUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return tu.AddChangeIDs(ids...)
+}
+
+// Mutation returns the TeamMutation object of the builder.
+func (tu *TeamUpdate) Mutation() *TeamMutation {
+	return tu.mutation
+}
+
+// ClearMembers clears all "members" edges to the User entity.
+func (tu *TeamUpdate) ClearMembers() *TeamUpdate {
+	tu.mutation.ClearMembers()
+	return tu
+}
+
+// RemoveMemberIDs removes the "members" edge to User entities by IDs.
+func (tu *TeamUpdate) RemoveMemberIDs(ids ...int64) *TeamUpdate {
+	tu.mutation.RemoveMemberIDs(ids...)
+	return tu
+}
+
+// RemoveMembers removes "members" edges to User entities.
+func (tu *TeamUpdate) RemoveMembers(u ...*User) *TeamUpdate {
+	ids := make([]int64, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return tu.RemoveMemberIDs(ids...)
+}
+
+// ClearPixels clears all "pixels" edges to the Pixel entity.
+func (tu *TeamUpdate) ClearPixels() *TeamUpdate {
+	tu.mutation.ClearPixels()
+	return tu
+}
+
+// RemovePixelIDs removes the "pixels" edge to Pixel entities by IDs.
+func (tu *TeamUpdate) RemovePixelIDs(ids ...int) *TeamUpdate {
+	tu.mutation.RemovePixelIDs(ids...)
+	return tu
+}
+
+// RemovePixels removes "pixels" edges to Pixel entities.
+func (tu *TeamUpdate) RemovePixels(p ...*Pixel) *TeamUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return tu.RemovePixelIDs(ids...)
+}
+
+// ClearChanges clears all "changes" edges to the PixelChange entity.
+func (tu *TeamUpdate) ClearChanges() *TeamUpdate {
+	tu.mutation.ClearChanges()
+	return tu
+}
+
+// RemoveChangeIDs removes the "changes" edge to PixelChange entities by IDs.
+func (tu *TeamUpdate) RemoveChangeIDs(ids ...int) *TeamUpdate {
+	tu.mutation.RemoveChangeIDs(ids...)
+	return tu
+}
+
+// RemoveChanges removes "changes" edges to PixelChange entities.
+func (tu *TeamUpdate) RemoveChanges(p ...*PixelChange) *TeamUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return tu.RemoveChangeIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tu *TeamUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, tu.sqlSave, tu.mutation, tu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tu *TeamUpdate) SaveX(ctx context.Context) int {
+	affected, err := tu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tu *TeamUpdate) Exec(ctx context.Context) error {
+	_, err := tu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tu *TeamUpdate) ExecX(ctx context.Context) {
+	if err := tu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tu *TeamUpdate) check() error {
+	if v, ok := tu.mutation.Name(); ok {
+		if err := team.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Team.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (tu *TeamUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(team.Table, team.Columns, sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt))
+	if ps := tu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tu.mutation.Name(); ok {
+		_spec.SetField(team.FieldName, field.TypeString, value)
+	}
+	if value, ok := tu.mutation.InviteCode(); ok {
+		_spec.SetField(team.FieldInviteCode, field.TypeString, value)
+	}
+	if tu.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.MembersTable,
+			Columns: []string{team.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedMembersIDs(); len(nodes) > 0 && !tu.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.MembersTable,
+			Columns: []string{team.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.MembersTable,
+			Columns: []string{team.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.PixelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.PixelsTable,
+			Columns: []string{team.PixelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pixel.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedPixelsIDs(); len(nodes) > 0 && !tu.mutation.PixelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.PixelsTable,
+			Columns: []string{team.PixelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pixel.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.PixelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.PixelsTable,
+			Columns: []string{team.PixelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pixel.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.ChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.ChangesTable,
+			Columns: []string{team.ChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pixelchange.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedChangesIDs(); len(nodes) > 0 && !tu.mutation.ChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.ChangesTable,
+			Columns: []string{team.ChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pixelchange.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.ChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.ChangesTable,
+			Columns: []string{team.ChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pixelchange.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{team.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tu.mutation.done = true
+	return n, nil
+}
+
+// TeamUpdateOne is the builder for updating a single Team entity.
+type TeamUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TeamMutation
+}
+
+// SetName sets the "name" field.
+func (tuo *TeamUpdateOne) SetName(s string) *TeamUpdateOne {
+	tuo.mutation.SetName(s)
+	return tuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (tuo *TeamUpdateOne) SetNillableName(s *string) *TeamUpdateOne {
+	if s != nil {
+		tuo.SetName(*s)
+	}
+	return tuo
+}
+
+// SetInviteCode sets the "invite_code" field.
+func (tuo *TeamUpdateOne) SetInviteCode(s string) *TeamUpdateOne {
+	tuo.mutation.SetInviteCode(s)
+	return tuo
+}
+
+// SetNillableInviteCode sets the "invite_code" field if the given value is not nil.
+func (tuo *TeamUpdateOne) SetNillableInviteCode(s *string) *TeamUpdateOne {
+	if s != nil {
+		tuo.SetInviteCode(*s)
+	}
+	return tuo
+}
+
+// AddMemberIDs adds the "members" edge to the User entity by IDs.
+func (tuo *TeamUpdateOne) AddMemberIDs(ids ...int64) *TeamUpdateOne {
+	tuo.mutation.AddMemberIDs(ids...)
+	return tuo
+}
+
+// AddMembers adds the "members" edges to the User entity.
+func (tuo *TeamUpdateOne) AddMembers(u ...*User) *TeamUpdateOne {
+	ids := make([]int64, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return tuo.AddMemberIDs(ids...)
+}
+
+// AddPixelIDs adds the "pixels" edge to the Pixel entity by IDs.
+func (tuo *TeamUpdateOne) AddPixelIDs(ids ...int) *TeamUpdateOne {
+	tuo.mutation.AddPixelIDs(ids...)
+	return tuo
+}
+
+// AddPixels adds the "pixels" edges to the Pixel entity.
+func (tuo *TeamUpdateOne) AddPixels(p ...*Pixel) *TeamUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return tuo.AddPixelIDs(ids...)
+}
+
+// AddChangeIDs adds the "changes" edge to the PixelChange entity by IDs.
+func (tuo *TeamUpdateOne) AddChangeIDs(ids ...int) *TeamUpdateOne {
+	tuo.mutation.AddChangeIDs(ids...)
+	return tuo
+}
+
+// AddChanges adds the "changes" edges to the PixelChange entity.
+func (tuo *TeamUpdateOne) AddChanges(p ...*PixelChange) *TeamUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return tuo.AddChangeIDs(ids...)
+}
+
+// Mutation returns the TeamMutation object of the builder.
+func (tuo *TeamUpdateOne) Mutation() *TeamMutation {
+	return tuo.mutation
+}
+
+// ClearMembers clears all "members" edges to the User entity.
+func (tuo *TeamUpdateOne) ClearMembers() *TeamUpdateOne {
+	tuo.mutation.ClearMembers()
+	return tuo
+}
+
+// RemoveMemberIDs removes the "members" edge to User entities by IDs.
+func (tuo *TeamUpdateOne) RemoveMemberIDs(ids ...int64) *TeamUpdateOne {
+	tuo.mutation.RemoveMemberIDs(ids...)
+	return tuo
+}
+
+// RemoveMembers removes "members" edges to User entities.
+func (tuo *TeamUpdateOne) RemoveMembers(u ...*User) *TeamUpdateOne {
+	ids := make([]int64, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return tuo.RemoveMemberIDs(ids...)
+}
+
+// ClearPixels clears all "pixels" edges to the Pixel entity.
+func (tuo *TeamUpdateOne) ClearPixels() *TeamUpdateOne {
+	tuo.mutation.ClearPixels()
+	return tuo
+}
+
+// RemovePixelIDs removes the "pixels" edge to Pixel entities by IDs.
+func (tuo *TeamUpdateOne) RemovePixelIDs(ids ...int) *TeamUpdateOne {
+	tuo.mutation.RemovePixelIDs(ids...)
+	return tuo
+}
+
+// RemovePixels removes "pixels" edges to Pixel entities.
+func (tuo *TeamUpdateOne) RemovePixels(p ...*Pixel) *TeamUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return tuo.RemovePixelIDs(ids...)
+}
+
+// ClearChanges clears all "changes" edges to the PixelChange entity.
+func (tuo *TeamUpdateOne) ClearChanges() *TeamUpdateOne {
+	tuo.mutation.ClearChanges()
+	return tuo
+}
+
+// RemoveChangeIDs removes the "changes" edge to PixelChange entities by IDs.
+func (tuo *TeamUpdateOne) RemoveChangeIDs(ids ...int) *TeamUpdateOne {
+	tuo.mutation.RemoveChangeIDs(ids...)
+	return tuo
+}
+
+// RemoveChanges removes "changes" edges to PixelChange entities.
+func (tuo *TeamUpdateOne) RemoveChanges(p ...*PixelChange) *TeamUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return tuo.RemoveChangeIDs(ids...)
+}
+
+// Where appends a list predicates to the TeamUpdate builder.
+func (tuo *TeamUpdateOne) Where(ps ...predicate.Team) *TeamUpdateOne {
+	tuo.mutation.Where(ps...)
+	return tuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tuo *TeamUpdateOne) Select(field string, fields ...string) *TeamUpdateOne {
+	tuo.fields = append([]string{field}, fields...)
+	return tuo
+}
+
+// Save executes the query and returns the updated Team entity.
+func (tuo *TeamUpdateOne) Save(ctx context.Context) (*Team, error) {
+	return withHooks(ctx, tuo.sqlSave, tuo.mutation, tuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tuo *TeamUpdateOne) SaveX(ctx context.Context) *Team {
+	node, err := tuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tuo *TeamUpdateOne) Exec(ctx context.Context) error {
+	_, err := tuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tuo *TeamUpdateOne) ExecX(ctx context.Context) {
+	if err := tuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tuo *TeamUpdateOne) check() error {
+	if v, ok := tuo.mutation.Name(); ok {
+		if err := team.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Team.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (tuo *TeamUpdateOne) sqlSave(ctx context.Context) (_node *Team, err error) {
+	if err := tuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(team.Table, team.Columns, sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt))
+	id, ok := tuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Team.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, team.FieldID)
+		for _, f := range fields {
+			if !team.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != team.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tuo.mutation.Name(); ok {
+		_spec.SetField(team.FieldName, field.TypeString, value)
+	}
+	if value, ok := tuo.mutation.InviteCode(); ok {
+		_spec.SetField(team.FieldInviteCode, field.TypeString, value)
+	}
+	if tuo.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.MembersTable,
+			Columns: []string{team.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedMembersIDs(); len(nodes) > 0 && !tuo.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.MembersTable,
+			Columns: []string{team.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.MembersTable,
+			Columns: []string{team.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.PixelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.PixelsTable,
+			Columns: []string{team.PixelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pixel.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedPixelsIDs(); len(nodes) > 0 && !tuo.mutation.PixelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.PixelsTable,
+			Columns: []string{team.PixelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pixel.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.PixelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.PixelsTable,
+			Columns: []string{team.PixelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pixel.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.ChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.ChangesTable,
+			Columns: []string{team.ChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pixelchange.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedChangesIDs(); len(nodes) > 0 && !tuo.mutation.ChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.ChangesTable,
+			Columns: []string{team.ChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pixelchange.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.ChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.ChangesTable,
+			Columns: []string{team.ChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pixelchange.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Team{config: tuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{team.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tuo.mutation.done = true
+	return _node, nil
+}
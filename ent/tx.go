@@ -12,10 +12,22 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// Board is the client for interacting with the Board builders.
+	Board *BoardClient
 	// Hype is the client for interacting with the Hype builders.
 	Hype *HypeClient
+	// HypeLedger is the client for interacting with the HypeLedger builders.
+	HypeLedger *HypeLedgerClient
+	// OutboxEvent is the client for interacting with the OutboxEvent builders.
+	OutboxEvent *OutboxEventClient
 	// Pixel is the client for interacting with the Pixel builders.
 	Pixel *PixelClient
+	// PixelChange is the client for interacting with the PixelChange builders.
+	PixelChange *PixelChangeClient
+	// Region is the client for interacting with the Region builders.
+	Region *RegionClient
+	// Team is the client for interacting with the Team builders.
+	Team *TeamClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 
@@ -149,8 +161,14 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.Board = NewBoardClient(tx.config)
 	tx.Hype = NewHypeClient(tx.config)
+	tx.HypeLedger = NewHypeLedgerClient(tx.config)
+	tx.OutboxEvent = NewOutboxEventClient(tx.config)
 	tx.Pixel = NewPixelClient(tx.config)
+	tx.PixelChange = NewPixelChangeClient(tx.config)
+	tx.Region = NewRegionClient(tx.config)
+	tx.Team = NewTeamClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 }
 
@@ -161,7 +179,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Hype.QueryXXX(), the query will be executed
+// applies a query, for example: Board.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
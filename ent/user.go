@@ -5,8 +5,10 @@ package ent
 import (
 	"fmt"
 	"nevissGo/ent/hype"
+	"nevissGo/ent/team"
 	"nevissGo/ent/user"
 	"strings"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -21,6 +23,14 @@ type User struct {
 	DisplayName string `json:"display_name,omitempty"`
 	// GameID holds the value of the "game_id" field.
 	GameID string `json:"game_id,omitempty"`
+	// TeamID holds the value of the "team_id" field.
+	TeamID *int `json:"team_id,omitempty"`
+	// Role holds the value of the "role" field.
+	Role user.Role `json:"role,omitempty"`
+	// BannedAt holds the value of the "banned_at" field.
+	BannedAt *time.Time `json:"banned_at,omitempty"`
+	// BanReason holds the value of the "ban_reason" field.
+	BanReason string `json:"ban_reason,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserQuery when eager-loading is set.
 	Edges        UserEdges `json:"edges"`
@@ -33,9 +43,21 @@ type UserEdges struct {
 	Pixels []*Pixel `json:"pixels,omitempty"`
 	// Hype holds the value of the hype edge.
 	Hype *Hype `json:"hype,omitempty"`
+	// Boards holds the value of the boards edge.
+	Boards []*Board `json:"boards,omitempty"`
+	// Changes holds the value of the changes edge.
+	Changes []*PixelChange `json:"changes,omitempty"`
+	// HypeLedger holds the value of the hype_ledger edge.
+	HypeLedger []*HypeLedger `json:"hype_ledger,omitempty"`
+	// HypeAdjustments holds the value of the hype_adjustments edge.
+	HypeAdjustments []*HypeLedger `json:"hype_adjustments,omitempty"`
+	// Regions holds the value of the regions edge.
+	Regions []*Region `json:"regions,omitempty"`
+	// Team holds the value of the team edge.
+	Team *Team `json:"team,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [8]bool
 }
 
 // PixelsOrErr returns the Pixels value or an error if the edge
@@ -58,15 +80,73 @@ func (e UserEdges) HypeOrErr() (*Hype, error) {
 	return nil, &NotLoadedError{edge: "hype"}
 }
 
+// BoardsOrErr returns the Boards value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) BoardsOrErr() ([]*Board, error) {
+	if e.loadedTypes[2] {
+		return e.Boards, nil
+	}
+	return nil, &NotLoadedError{edge: "boards"}
+}
+
+// ChangesOrErr returns the Changes value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ChangesOrErr() ([]*PixelChange, error) {
+	if e.loadedTypes[3] {
+		return e.Changes, nil
+	}
+	return nil, &NotLoadedError{edge: "changes"}
+}
+
+// HypeLedgerOrErr returns the HypeLedger value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) HypeLedgerOrErr() ([]*HypeLedger, error) {
+	if e.loadedTypes[4] {
+		return e.HypeLedger, nil
+	}
+	return nil, &NotLoadedError{edge: "hype_ledger"}
+}
+
+// HypeAdjustmentsOrErr returns the HypeAdjustments value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) HypeAdjustmentsOrErr() ([]*HypeLedger, error) {
+	if e.loadedTypes[5] {
+		return e.HypeAdjustments, nil
+	}
+	return nil, &NotLoadedError{edge: "hype_adjustments"}
+}
+
+// RegionsOrErr returns the Regions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) RegionsOrErr() ([]*Region, error) {
+	if e.loadedTypes[6] {
+		return e.Regions, nil
+	}
+	return nil, &NotLoadedError{edge: "regions"}
+}
+
+// TeamOrErr returns the Team value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserEdges) TeamOrErr() (*Team, error) {
+	if e.Team != nil {
+		return e.Team, nil
+	} else if e.loadedTypes[7] {
+		return nil, &NotFoundError{label: team.Label}
+	}
+	return nil, &NotLoadedError{edge: "team"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case user.FieldID:
+		case user.FieldID, user.FieldTeamID:
 			values[i] = new(sql.NullInt64)
-		case user.FieldDisplayName, user.FieldGameID:
+		case user.FieldDisplayName, user.FieldGameID, user.FieldRole, user.FieldBanReason:
 			values[i] = new(sql.NullString)
+		case user.FieldBannedAt:
+			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -100,6 +180,32 @@ func (u *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				u.GameID = value.String
 			}
+		case user.FieldTeamID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field team_id", values[i])
+			} else if value.Valid {
+				u.TeamID = new(int)
+				*u.TeamID = int(value.Int64)
+			}
+		case user.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				u.Role = user.Role(value.String)
+			}
+		case user.FieldBannedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field banned_at", values[i])
+			} else if value.Valid {
+				u.BannedAt = new(time.Time)
+				*u.BannedAt = value.Time
+			}
+		case user.FieldBanReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ban_reason", values[i])
+			} else if value.Valid {
+				u.BanReason = value.String
+			}
 		default:
 			u.selectValues.Set(columns[i], values[i])
 		}
@@ -123,6 +229,36 @@ func (u *User) QueryHype() *HypeQuery {
 	return NewUserClient(u.config).QueryHype(u)
 }
 
+// QueryBoards queries the "boards" edge of the User entity.
+func (u *User) QueryBoards() *BoardQuery {
+	return NewUserClient(u.config).QueryBoards(u)
+}
+
+// QueryChanges queries the "changes" edge of the User entity.
+func (u *User) QueryChanges() *PixelChangeQuery {
+	return NewUserClient(u.config).QueryChanges(u)
+}
+
+// QueryHypeLedger queries the "hype_ledger" edge of the User entity.
+func (u *User) QueryHypeLedger() *HypeLedgerQuery {
+	return NewUserClient(u.config).QueryHypeLedger(u)
+}
+
+// QueryHypeAdjustments queries the "hype_adjustments" edge of the User entity.
+func (u *User) QueryHypeAdjustments() *HypeLedgerQuery {
+	return NewUserClient(u.config).QueryHypeAdjustments(u)
+}
+
+// QueryRegions queries the "regions" edge of the User entity.
+func (u *User) QueryRegions() *RegionQuery {
+	return NewUserClient(u.config).QueryRegions(u)
+}
+
+// QueryTeam queries the "team" edge of the User entity.
+func (u *User) QueryTeam() *TeamQuery {
+	return NewUserClient(u.config).QueryTeam(u)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -151,6 +287,22 @@ func (u *User) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("game_id=")
 	builder.WriteString(u.GameID)
+	builder.WriteString(", ")
+	if v := u.TeamID; v != nil {
+		builder.WriteString("team_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("role=")
+	builder.WriteString(fmt.Sprintf("%v", u.Role))
+	builder.WriteString(", ")
+	if v := u.BannedAt; v != nil {
+		builder.WriteString("banned_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("ban_reason=")
+	builder.WriteString(u.BanReason)
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,6 +3,8 @@
 package user
 
 import (
+	"fmt"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -16,10 +18,30 @@ const (
 	FieldDisplayName = "display_name"
 	// FieldGameID holds the string denoting the game_id field in the database.
 	FieldGameID = "game_id"
+	// FieldTeamID holds the string denoting the team_id field in the database.
+	FieldTeamID = "team_id"
+	// FieldRole holds the string denoting the role field in the database.
+	FieldRole = "role"
+	// FieldBannedAt holds the string denoting the banned_at field in the database.
+	FieldBannedAt = "banned_at"
+	// FieldBanReason holds the string denoting the ban_reason field in the database.
+	FieldBanReason = "ban_reason"
 	// EdgePixels holds the string denoting the pixels edge name in mutations.
 	EdgePixels = "pixels"
 	// EdgeHype holds the string denoting the hype edge name in mutations.
 	EdgeHype = "hype"
+	// EdgeBoards holds the string denoting the boards edge name in mutations.
+	EdgeBoards = "boards"
+	// EdgeChanges holds the string denoting the changes edge name in mutations.
+	EdgeChanges = "changes"
+	// EdgeHypeLedger holds the string denoting the hype_ledger edge name in mutations.
+	EdgeHypeLedger = "hype_ledger"
+	// EdgeHypeAdjustments holds the string denoting the hype_adjustments edge name in mutations.
+	EdgeHypeAdjustments = "hype_adjustments"
+	// EdgeRegions holds the string denoting the regions edge name in mutations.
+	EdgeRegions = "regions"
+	// EdgeTeam holds the string denoting the team edge name in mutations.
+	EdgeTeam = "team"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 	// PixelsTable is the table that holds the pixels relation/edge.
@@ -36,6 +58,48 @@ const (
 	HypeInverseTable = "hypes"
 	// HypeColumn is the table column denoting the hype relation/edge.
 	HypeColumn = "user_hype"
+	// BoardsTable is the table that holds the boards relation/edge.
+	BoardsTable = "boards"
+	// BoardsInverseTable is the table name for the Board entity.
+	// It exists in this package in order to avoid circular dependency with the "board" package.
+	BoardsInverseTable = "boards"
+	// BoardsColumn is the table column denoting the boards relation/edge.
+	BoardsColumn = "user_boards"
+	// ChangesTable is the table that holds the changes relation/edge.
+	ChangesTable = "pixel_changes"
+	// ChangesInverseTable is the table name for the PixelChange entity.
+	// It exists in this package in order to avoid circular dependency with the "pixelchange" package.
+	ChangesInverseTable = "pixel_changes"
+	// ChangesColumn is the table column denoting the changes relation/edge.
+	ChangesColumn = "user_changes"
+	// HypeLedgerTable is the table that holds the hype_ledger relation/edge.
+	HypeLedgerTable = "hype_ledgers"
+	// HypeLedgerInverseTable is the table name for the HypeLedger entity.
+	// It exists in this package in order to avoid circular dependency with the "hypeledger" package.
+	HypeLedgerInverseTable = "hype_ledgers"
+	// HypeLedgerColumn is the table column denoting the hype_ledger relation/edge.
+	HypeLedgerColumn = "user_hype_ledger"
+	// HypeAdjustmentsTable is the table that holds the hype_adjustments relation/edge.
+	HypeAdjustmentsTable = "hype_ledgers"
+	// HypeAdjustmentsInverseTable is the table name for the HypeLedger entity.
+	// It exists in this package in order to avoid circular dependency with the "hypeledger" package.
+	HypeAdjustmentsInverseTable = "hype_ledgers"
+	// HypeAdjustmentsColumn is the table column denoting the hype_adjustments relation/edge.
+	HypeAdjustmentsColumn = "user_hype_adjustments"
+	// RegionsTable is the table that holds the regions relation/edge.
+	RegionsTable = "regions"
+	// RegionsInverseTable is the table name for the Region entity.
+	// It exists in this package in order to avoid circular dependency with the "region" package.
+	RegionsInverseTable = "regions"
+	// RegionsColumn is the table column denoting the regions relation/edge.
+	RegionsColumn = "user_regions"
+	// TeamTable is the table that holds the team relation/edge.
+	TeamTable = "users"
+	// TeamInverseTable is the table name for the Team entity.
+	// It exists in this package in order to avoid circular dependency with the "team" package.
+	TeamInverseTable = "teams"
+	// TeamColumn is the table column denoting the team relation/edge.
+	TeamColumn = "team_id"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -43,6 +107,10 @@ var Columns = []string{
 	FieldID,
 	FieldDisplayName,
 	FieldGameID,
+	FieldTeamID,
+	FieldRole,
+	FieldBannedAt,
+	FieldBanReason,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -55,6 +123,32 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Role defines the type for the "role" enum field.
+type Role string
+
+// RoleUser is the default value of the Role enum.
+const DefaultRole = RoleUser
+
+// Role values.
+const (
+	RoleUser  Role = "user"
+	RoleAdmin Role = "admin"
+)
+
+func (r Role) String() string {
+	return string(r)
+}
+
+// RoleValidator is a validator for the "role" field enum values. It is called by the builders before save.
+func RoleValidator(r Role) error {
+	switch r {
+	case RoleUser, RoleAdmin:
+		return nil
+	default:
+		return fmt.Errorf("user: invalid enum value for role field: %q", r)
+	}
+}
+
 // OrderOption defines the ordering options for the User queries.
 type OrderOption func(*sql.Selector)
 
@@ -73,6 +167,26 @@ func ByGameID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldGameID, opts...).ToFunc()
 }
 
+// ByTeamID orders the results by the team_id field.
+func ByTeamID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTeamID, opts...).ToFunc()
+}
+
+// ByRole orders the results by the role field.
+func ByRole(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRole, opts...).ToFunc()
+}
+
+// ByBannedAt orders the results by the banned_at field.
+func ByBannedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBannedAt, opts...).ToFunc()
+}
+
+// ByBanReason orders the results by the ban_reason field.
+func ByBanReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBanReason, opts...).ToFunc()
+}
+
 // ByPixelsCount orders the results by pixels count.
 func ByPixelsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -93,6 +207,83 @@ func ByHypeField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newHypeStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByBoardsCount orders the results by boards count.
+func ByBoardsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBoardsStep(), opts...)
+	}
+}
+
+// ByBoards orders the results by boards terms.
+func ByBoards(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBoardsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByChangesCount orders the results by changes count.
+func ByChangesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newChangesStep(), opts...)
+	}
+}
+
+// ByChanges orders the results by changes terms.
+func ByChanges(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newChangesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByHypeLedgerCount orders the results by hype_ledger count.
+func ByHypeLedgerCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newHypeLedgerStep(), opts...)
+	}
+}
+
+// ByHypeLedger orders the results by hype_ledger terms.
+func ByHypeLedger(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newHypeLedgerStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByHypeAdjustmentsCount orders the results by hype_adjustments count.
+func ByHypeAdjustmentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newHypeAdjustmentsStep(), opts...)
+	}
+}
+
+// ByHypeAdjustments orders the results by hype_adjustments terms.
+func ByHypeAdjustments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newHypeAdjustmentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRegionsCount orders the results by regions count.
+func ByRegionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRegionsStep(), opts...)
+	}
+}
+
+// ByRegions orders the results by regions terms.
+func ByRegions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRegionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTeamField orders the results by team field.
+func ByTeamField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTeamStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newPixelsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -107,3 +298,45 @@ func newHypeStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2O, false, HypeTable, HypeColumn),
 	)
 }
+func newBoardsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BoardsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BoardsTable, BoardsColumn),
+	)
+}
+func newChangesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ChangesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ChangesTable, ChangesColumn),
+	)
+}
+func newHypeLedgerStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(HypeLedgerInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, HypeLedgerTable, HypeLedgerColumn),
+	)
+}
+func newHypeAdjustmentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(HypeAdjustmentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, HypeAdjustmentsTable, HypeAdjustmentsColumn),
+	)
+}
+func newRegionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RegionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RegionsTable, RegionsColumn),
+	)
+}
+func newTeamStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TeamInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TeamTable, TeamColumn),
+	)
+}
@@ -6,9 +6,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"nevissGo/ent/board"
 	"nevissGo/ent/hype"
+	"nevissGo/ent/hypeledger"
 	"nevissGo/ent/pixel"
+	"nevissGo/ent/pixelchange"
+	"nevissGo/ent/region"
+	"nevissGo/ent/team"
 	"nevissGo/ent/user"
+	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -33,6 +39,62 @@ func (uc *UserCreate) SetGameID(s string) *UserCreate {
 	return uc
 }
 
+// SetTeamID sets the "team_id" field.
+func (uc *UserCreate) SetTeamID(i int) *UserCreate {
+	uc.mutation.SetTeamID(i)
+	return uc
+}
+
+// SetNillableTeamID sets the "team_id" field if the given value is not nil.
+func (uc *UserCreate) SetNillableTeamID(i *int) *UserCreate {
+	if i != nil {
+		uc.SetTeamID(*i)
+	}
+	return uc
+}
+
+// SetRole sets the "role" field.
+func (uc *UserCreate) SetRole(u user.Role) *UserCreate {
+	uc.mutation.SetRole(u)
+	return uc
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (uc *UserCreate) SetNillableRole(u *user.Role) *UserCreate {
+	if u != nil {
+		uc.SetRole(*u)
+	}
+	return uc
+}
+
+// SetBannedAt sets the "banned_at" field.
+func (uc *UserCreate) SetBannedAt(t time.Time) *UserCreate {
+	uc.mutation.SetBannedAt(t)
+	return uc
+}
+
+// SetNillableBannedAt sets the "banned_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillableBannedAt(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetBannedAt(*t)
+	}
+	return uc
+}
+
+// SetBanReason sets the "ban_reason" field.
+func (uc *UserCreate) SetBanReason(s string) *UserCreate {
+	uc.mutation.SetBanReason(s)
+	return uc
+}
+
+// SetNillableBanReason sets the "ban_reason" field if the given value is not nil.
+func (uc *UserCreate) SetNillableBanReason(s *string) *UserCreate {
+	if s != nil {
+		uc.SetBanReason(*s)
+	}
+	return uc
+}
+
 // SetID sets the "id" field.
 func (uc *UserCreate) SetID(i int64) *UserCreate {
 	uc.mutation.SetID(i)
@@ -73,6 +135,86 @@ func (uc *UserCreate) SetHype(h *Hype) *UserCreate {
 	return uc.SetHypeID(h.ID)
 }
 
+// AddBoardIDs adds the "boards" edge to the Board entity by IDs.
+func (uc *UserCreate) AddBoardIDs(ids ...int) *UserCreate {
+	uc.mutation.AddBoardIDs(ids...)
+	return uc
+}
+
+// AddBoards adds the "boards" edges to the Board entity.
+func (uc *UserCreate) AddBoards(b ...*Board) *UserCreate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uc.AddBoardIDs(ids...)
+}
+
+// AddChangeIDs adds the "changes" edge to the PixelChange entity by IDs.
+func (uc *UserCreate) AddChangeIDs(ids ...int) *UserCreate {
+	uc.mutation.AddChangeIDs(ids...)
+	return uc
+}
+
+// AddChanges adds the "changes" edges to the PixelChange entity.
+func (uc *UserCreate) AddChanges(p ...*PixelChange) *UserCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uc.AddChangeIDs(ids...)
+}
+
+// AddHypeLedgerIDs adds the "hype_ledger" edge to the HypeLedger entity by IDs.
+func (uc *UserCreate) AddHypeLedgerIDs(ids ...int) *UserCreate {
+	uc.mutation.AddHypeLedgerIDs(ids...)
+	return uc
+}
+
+// AddHypeLedger adds the "hype_ledger" edges to the HypeLedger entity.
+func (uc *UserCreate) AddHypeLedger(h ...*HypeLedger) *UserCreate {
+	ids := make([]int, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return uc.AddHypeLedgerIDs(ids...)
+}
+
+// AddHypeAdjustmentIDs adds the "hype_adjustments" edge to the HypeLedger entity by IDs.
+func (uc *UserCreate) AddHypeAdjustmentIDs(ids ...int) *UserCreate {
+	uc.mutation.AddHypeAdjustmentIDs(ids...)
+	return uc
+}
+
+// AddHypeAdjustments adds the "hype_adjustments" edges to the HypeLedger entity.
+func (uc *UserCreate) AddHypeAdjustments(h ...*HypeLedger) *UserCreate {
+	ids := make([]int, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return uc.AddHypeAdjustmentIDs(ids...)
+}
+
+// AddRegionIDs adds the "regions" edge to the Region entity by IDs.
+func (uc *UserCreate) AddRegionIDs(ids ...int) *UserCreate {
+	uc.mutation.AddRegionIDs(ids...)
+	return uc
+}
+
+// AddRegions adds the "regions" edges to the Region entity.
+func (uc *UserCreate) AddRegions(r ...*Region) *UserCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uc.AddRegionIDs(ids...)
+}
+
+// SetTeam sets the "team" edge to the Team entity.
+func (uc *UserCreate) SetTeam(t *Team) *UserCreate {
+	return uc.SetTeamID(t.ID)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uc *UserCreate) Mutation() *UserMutation {
 	return uc.mutation
@@ -80,6 +222,7 @@ func (uc *UserCreate) Mutation() *UserMutation {
 
 // Save creates the User in the database.
 func (uc *UserCreate) Save(ctx context.Context) (*User, error) {
+	uc.defaults()
 	return withHooks(ctx, uc.sqlSave, uc.mutation, uc.hooks)
 }
 
@@ -105,6 +248,14 @@ func (uc *UserCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (uc *UserCreate) defaults() {
+	if _, ok := uc.mutation.Role(); !ok {
+		v := user.DefaultRole
+		uc.mutation.SetRole(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (uc *UserCreate) check() error {
 	if _, ok := uc.mutation.DisplayName(); !ok {
@@ -113,6 +264,14 @@ func (uc *UserCreate) check() error {
 	if _, ok := uc.mutation.GameID(); !ok {
 		return &ValidationError{Name: "game_id", err: errors.New(`ent: missing required field "User.game_id"`)}
 	}
+	if _, ok := uc.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "User.role"`)}
+	}
+	if v, ok := uc.mutation.Role(); ok {
+		if err := user.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -153,6 +312,18 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldGameID, field.TypeString, value)
 		_node.GameID = value
 	}
+	if value, ok := uc.mutation.Role(); ok {
+		_spec.SetField(user.FieldRole, field.TypeEnum, value)
+		_node.Role = value
+	}
+	if value, ok := uc.mutation.BannedAt(); ok {
+		_spec.SetField(user.FieldBannedAt, field.TypeTime, value)
+		_node.BannedAt = &value
+	}
+	if value, ok := uc.mutation.BanReason(); ok {
+		_spec.SetField(user.FieldBanReason, field.TypeString, value)
+		_node.BanReason = value
+	}
 	if nodes := uc.mutation.PixelsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -185,6 +356,103 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := uc.mutation.BoardsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BoardsTable,
+			Columns: []string{user.BoardsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.ChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ChangesTable,
+			Columns: []string{user.ChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pixelchange.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.HypeLedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.HypeLedgerTable,
+			Columns: []string{user.HypeLedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hypeledger.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.HypeAdjustmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.HypeAdjustmentsTable,
+			Columns: []string{user.HypeAdjustmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hypeledger.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.RegionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RegionsTable,
+			Columns: []string{user.RegionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(region.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.TeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   user.TeamTable,
+			Columns: []string{user.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TeamID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -206,6 +474,7 @@ func (ucb *UserCreateBulk) Save(ctx context.Context) ([]*User, error) {
 	for i := range ucb.builders {
 		func(i int, root context.Context) {
 			builder := ucb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*UserMutation)
 				if !ok {
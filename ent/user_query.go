@@ -7,9 +7,14 @@ import (
 	"database/sql/driver"
 	"fmt"
 	"math"
+	"nevissGo/ent/board"
 	"nevissGo/ent/hype"
+	"nevissGo/ent/hypeledger"
 	"nevissGo/ent/pixel"
+	"nevissGo/ent/pixelchange"
 	"nevissGo/ent/predicate"
+	"nevissGo/ent/region"
+	"nevissGo/ent/team"
 	"nevissGo/ent/user"
 
 	"entgo.io/ent"
@@ -21,12 +26,18 @@ import (
 // UserQuery is the builder for querying User entities.
 type UserQuery struct {
 	config
-	ctx        *QueryContext
-	order      []user.OrderOption
-	inters     []Interceptor
-	predicates []predicate.User
-	withPixels *PixelQuery
-	withHype   *HypeQuery
+	ctx                 *QueryContext
+	order               []user.OrderOption
+	inters              []Interceptor
+	predicates          []predicate.User
+	withPixels          *PixelQuery
+	withHype            *HypeQuery
+	withBoards          *BoardQuery
+	withChanges         *PixelChangeQuery
+	withHypeLedger      *HypeLedgerQuery
+	withHypeAdjustments *HypeLedgerQuery
+	withRegions         *RegionQuery
+	withTeam            *TeamQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -107,6 +118,138 @@ func (uq *UserQuery) QueryHype() *HypeQuery {
 	return query
 }
 
+// QueryBoards chains the current query on the "boards" edge.
+func (uq *UserQuery) QueryBoards() *BoardQuery {
+	query := (&BoardClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(board.Table, board.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.BoardsTable, user.BoardsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryChanges chains the current query on the "changes" edge.
+func (uq *UserQuery) QueryChanges() *PixelChangeQuery {
+	query := (&PixelChangeClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(pixelchange.Table, pixelchange.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ChangesTable, user.ChangesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryHypeLedger chains the current query on the "hype_ledger" edge.
+func (uq *UserQuery) QueryHypeLedger() *HypeLedgerQuery {
+	query := (&HypeLedgerClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(hypeledger.Table, hypeledger.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.HypeLedgerTable, user.HypeLedgerColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryHypeAdjustments chains the current query on the "hype_adjustments" edge.
+func (uq *UserQuery) QueryHypeAdjustments() *HypeLedgerQuery {
+	query := (&HypeLedgerClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(hypeledger.Table, hypeledger.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.HypeAdjustmentsTable, user.HypeAdjustmentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRegions chains the current query on the "regions" edge.
+func (uq *UserQuery) QueryRegions() *RegionQuery {
+	query := (&RegionClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(region.Table, region.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.RegionsTable, user.RegionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTeam chains the current query on the "team" edge.
+func (uq *UserQuery) QueryTeam() *TeamQuery {
+	query := (&TeamClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(team.Table, team.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, user.TeamTable, user.TeamColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first User entity from the query.
 // Returns a *NotFoundError when no User was found.
 func (uq *UserQuery) First(ctx context.Context) (*User, error) {
@@ -294,13 +437,19 @@ func (uq *UserQuery) Clone() *UserQuery {
 		return nil
 	}
 	return &UserQuery{
-		config:     uq.config,
-		ctx:        uq.ctx.Clone(),
-		order:      append([]user.OrderOption{}, uq.order...),
-		inters:     append([]Interceptor{}, uq.inters...),
-		predicates: append([]predicate.User{}, uq.predicates...),
-		withPixels: uq.withPixels.Clone(),
-		withHype:   uq.withHype.Clone(),
+		config:              uq.config,
+		ctx:                 uq.ctx.Clone(),
+		order:               append([]user.OrderOption{}, uq.order...),
+		inters:              append([]Interceptor{}, uq.inters...),
+		predicates:          append([]predicate.User{}, uq.predicates...),
+		withPixels:          uq.withPixels.Clone(),
+		withHype:            uq.withHype.Clone(),
+		withBoards:          uq.withBoards.Clone(),
+		withChanges:         uq.withChanges.Clone(),
+		withHypeLedger:      uq.withHypeLedger.Clone(),
+		withHypeAdjustments: uq.withHypeAdjustments.Clone(),
+		withRegions:         uq.withRegions.Clone(),
+		withTeam:            uq.withTeam.Clone(),
 		// clone intermediate query.
 		sql:  uq.sql.Clone(),
 		path: uq.path,
@@ -329,6 +478,72 @@ func (uq *UserQuery) WithHype(opts ...func(*HypeQuery)) *UserQuery {
 	return uq
 }
 
+// WithBoards tells the query-builder to eager-load the nodes that are connected to
+// the "boards" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithBoards(opts ...func(*BoardQuery)) *UserQuery {
+	query := (&BoardClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withBoards = query
+	return uq
+}
+
+// WithChanges tells the query-builder to eager-load the nodes that are connected to
+// the "changes" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithChanges(opts ...func(*PixelChangeQuery)) *UserQuery {
+	query := (&PixelChangeClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withChanges = query
+	return uq
+}
+
+// WithHypeLedger tells the query-builder to eager-load the nodes that are connected to
+// the "hype_ledger" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithHypeLedger(opts ...func(*HypeLedgerQuery)) *UserQuery {
+	query := (&HypeLedgerClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withHypeLedger = query
+	return uq
+}
+
+// WithHypeAdjustments tells the query-builder to eager-load the nodes that are connected to
+// the "hype_adjustments" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithHypeAdjustments(opts ...func(*HypeLedgerQuery)) *UserQuery {
+	query := (&HypeLedgerClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withHypeAdjustments = query
+	return uq
+}
+
+// WithRegions tells the query-builder to eager-load the nodes that are connected to
+// the "regions" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithRegions(opts ...func(*RegionQuery)) *UserQuery {
+	query := (&RegionClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withRegions = query
+	return uq
+}
+
+// WithTeam tells the query-builder to eager-load the nodes that are connected to
+// the "team" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithTeam(opts ...func(*TeamQuery)) *UserQuery {
+	query := (&TeamClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withTeam = query
+	return uq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -407,9 +622,15 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 	var (
 		nodes       = []*User{}
 		_spec       = uq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [8]bool{
 			uq.withPixels != nil,
 			uq.withHype != nil,
+			uq.withBoards != nil,
+			uq.withChanges != nil,
+			uq.withHypeLedger != nil,
+			uq.withHypeAdjustments != nil,
+			uq.withRegions != nil,
+			uq.withTeam != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -443,6 +664,47 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	if query := uq.withBoards; query != nil {
+		if err := uq.loadBoards(ctx, query, nodes,
+			func(n *User) { n.Edges.Boards = []*Board{} },
+			func(n *User, e *Board) { n.Edges.Boards = append(n.Edges.Boards, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withChanges; query != nil {
+		if err := uq.loadChanges(ctx, query, nodes,
+			func(n *User) { n.Edges.Changes = []*PixelChange{} },
+			func(n *User, e *PixelChange) { n.Edges.Changes = append(n.Edges.Changes, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withHypeLedger; query != nil {
+		if err := uq.loadHypeLedger(ctx, query, nodes,
+			func(n *User) { n.Edges.HypeLedger = []*HypeLedger{} },
+			func(n *User, e *HypeLedger) { n.Edges.HypeLedger = append(n.Edges.HypeLedger, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withHypeAdjustments; query != nil {
+		if err := uq.loadHypeAdjustments(ctx, query, nodes,
+			func(n *User) { n.Edges.HypeAdjustments = []*HypeLedger{} },
+			func(n *User, e *HypeLedger) { n.Edges.HypeAdjustments = append(n.Edges.HypeAdjustments, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withRegions; query != nil {
+		if err := uq.loadRegions(ctx, query, nodes,
+			func(n *User) { n.Edges.Regions = []*Region{} },
+			func(n *User, e *Region) { n.Edges.Regions = append(n.Edges.Regions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withTeam; query != nil {
+		if err := uq.loadTeam(ctx, query, nodes, nil,
+			func(n *User, e *Team) { n.Edges.Team = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -456,7 +718,9 @@ func (uq *UserQuery) loadPixels(ctx context.Context, query *PixelQuery, nodes []
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(pixel.FieldUserID)
+	}
 	query.Where(predicate.Pixel(func(s *sql.Selector) {
 		s.Where(sql.InValues(s.C(user.PixelsColumn), fks...))
 	}))
@@ -465,13 +729,10 @@ func (uq *UserQuery) loadPixels(ctx context.Context, query *PixelQuery, nodes []
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.user_pixels
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "user_pixels" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		fk := n.UserID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "user_pixels" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
@@ -505,6 +766,193 @@ func (uq *UserQuery) loadHype(ctx context.Context, query *HypeQuery, nodes []*Us
 	}
 	return nil
 }
+func (uq *UserQuery) loadBoards(ctx context.Context, query *BoardQuery, nodes []*User, init func(*User), assign func(*User, *Board)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int64]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Board(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.BoardsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_boards
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_boards" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_boards" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadChanges(ctx context.Context, query *PixelChangeQuery, nodes []*User, init func(*User), assign func(*User, *PixelChange)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int64]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(pixelchange.FieldUserID)
+	}
+	query.Where(predicate.PixelChange(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.ChangesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadHypeLedger(ctx context.Context, query *HypeLedgerQuery, nodes []*User, init func(*User), assign func(*User, *HypeLedger)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int64]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.HypeLedger(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.HypeLedgerColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_hype_ledger
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_hype_ledger" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_hype_ledger" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadHypeAdjustments(ctx context.Context, query *HypeLedgerQuery, nodes []*User, init func(*User), assign func(*User, *HypeLedger)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int64]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.HypeLedger(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.HypeAdjustmentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_hype_adjustments
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_hype_adjustments" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_hype_adjustments" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadRegions(ctx context.Context, query *RegionQuery, nodes []*User, init func(*User), assign func(*User, *Region)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int64]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Region(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.RegionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_regions
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_regions" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_regions" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadTeam(ctx context.Context, query *TeamQuery, nodes []*User, init func(*User), assign func(*User, *Team)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*User)
+	for i := range nodes {
+		if nodes[i].TeamID == nil {
+			continue
+		}
+		fk := *nodes[i].TeamID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(team.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "team_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (uq *UserQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := uq.querySpec()
@@ -531,6 +979,9 @@ func (uq *UserQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if uq.withTeam != nil {
+			_spec.Node.AddColumnOnce(user.FieldTeamID)
+		}
 	}
 	if ps := uq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
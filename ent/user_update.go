@@ -6,10 +6,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"nevissGo/ent/board"
 	"nevissGo/ent/hype"
+	"nevissGo/ent/hypeledger"
 	"nevissGo/ent/pixel"
+	"nevissGo/ent/pixelchange"
 	"nevissGo/ent/predicate"
+	"nevissGo/ent/region"
+	"nevissGo/ent/team"
 	"nevissGo/ent/user"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -57,6 +63,80 @@ func (uu *UserUpdate) SetNillableGameID(s *string) *UserUpdate {
 	return uu
 }
 
+// SetTeamID sets the "team_id" field.
+func (uu *UserUpdate) SetTeamID(i int) *UserUpdate {
+	uu.mutation.SetTeamID(i)
+	return uu
+}
+
+// SetNillableTeamID sets the "team_id" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableTeamID(i *int) *UserUpdate {
+	if i != nil {
+		uu.SetTeamID(*i)
+	}
+	return uu
+}
+
+// ClearTeamID clears the value of the "team_id" field.
+func (uu *UserUpdate) ClearTeamID() *UserUpdate {
+	uu.mutation.ClearTeamID()
+	return uu
+}
+
+// SetRole sets the "role" field.
+func (uu *UserUpdate) SetRole(u user.Role) *UserUpdate {
+	uu.mutation.SetRole(u)
+	return uu
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableRole(u *user.Role) *UserUpdate {
+	if u != nil {
+		uu.SetRole(*u)
+	}
+	return uu
+}
+
+// SetBannedAt sets the "banned_at" field.
+func (uu *UserUpdate) SetBannedAt(t time.Time) *UserUpdate {
+	uu.mutation.SetBannedAt(t)
+	return uu
+}
+
+// SetNillableBannedAt sets the "banned_at" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableBannedAt(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetBannedAt(*t)
+	}
+	return uu
+}
+
+// ClearBannedAt clears the value of the "banned_at" field.
+func (uu *UserUpdate) ClearBannedAt() *UserUpdate {
+	uu.mutation.ClearBannedAt()
+	return uu
+}
+
+// SetBanReason sets the "ban_reason" field.
+func (uu *UserUpdate) SetBanReason(s string) *UserUpdate {
+	uu.mutation.SetBanReason(s)
+	return uu
+}
+
+// SetNillableBanReason sets the "ban_reason" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableBanReason(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetBanReason(*s)
+	}
+	return uu
+}
+
+// ClearBanReason clears the value of the "ban_reason" field.
+func (uu *UserUpdate) ClearBanReason() *UserUpdate {
+	uu.mutation.ClearBanReason()
+	return uu
+}
+
 // AddPixelIDs adds the "pixels" edge to the Pixel entity by IDs.
 func (uu *UserUpdate) AddPixelIDs(ids ...int) *UserUpdate {
 	uu.mutation.AddPixelIDs(ids...)
@@ -91,6 +171,86 @@ func (uu *UserUpdate) SetHype(h *Hype) *UserUpdate {
 	return uu.SetHypeID(h.ID)
 }
 
+// AddBoardIDs adds the "boards" edge to the Board entity by IDs.
+func (uu *UserUpdate) AddBoardIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddBoardIDs(ids...)
+	return uu
+}
+
+// AddBoards adds the "boards" edges to the Board entity.
+func (uu *UserUpdate) AddBoards(b ...*Board) *UserUpdate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uu.AddBoardIDs(ids...)
+}
+
+// AddChangeIDs adds the "changes" edge to the PixelChange entity by IDs.
+func (uu *UserUpdate) AddChangeIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddChangeIDs(ids...)
+	return uu
+}
+
+// AddChanges adds the "changes" edges to the PixelChange entity.
+func (uu *UserUpdate) AddChanges(p ...*PixelChange) *UserUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uu.AddChangeIDs(ids...)
+}
+
+// AddHypeLedgerIDs adds the "hype_ledger" edge to the HypeLedger entity by IDs.
+func (uu *UserUpdate) AddHypeLedgerIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddHypeLedgerIDs(ids...)
+	return uu
+}
+
+// AddHypeLedger adds the "hype_ledger" edges to the HypeLedger entity.
+func (uu *UserUpdate) AddHypeLedger(h ...*HypeLedger) *UserUpdate {
+	ids := make([]int, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return uu.AddHypeLedgerIDs(ids...)
+}
+
+// AddHypeAdjustmentIDs adds the "hype_adjustments" edge to the HypeLedger entity by IDs.
+func (uu *UserUpdate) AddHypeAdjustmentIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddHypeAdjustmentIDs(ids...)
+	return uu
+}
+
+// AddHypeAdjustments adds the "hype_adjustments" edges to the HypeLedger entity.
+func (uu *UserUpdate) AddHypeAdjustments(h ...*HypeLedger) *UserUpdate {
+	ids := make([]int, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return uu.AddHypeAdjustmentIDs(ids...)
+}
+
+// AddRegionIDs adds the "regions" edge to the Region entity by IDs.
+func (uu *UserUpdate) AddRegionIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddRegionIDs(ids...)
+	return uu
+}
+
+// AddRegions adds the "regions" edges to the Region entity.
+func (uu *UserUpdate) AddRegions(r ...*Region) *UserUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uu.AddRegionIDs(ids...)
+}
+
+// SetTeam sets the "team" edge to the Team entity.
+func (uu *UserUpdate) SetTeam(t *Team) *UserUpdate {
+	return uu.SetTeamID(t.ID)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uu *UserUpdate) Mutation() *UserMutation {
 	return uu.mutation
@@ -123,6 +283,117 @@ func (uu *UserUpdate) ClearHype() *UserUpdate {
 	return uu
 }
 
+// ClearBoards clears all "boards" edges to the Board entity.
+func (uu *UserUpdate) ClearBoards() *UserUpdate {
+	uu.mutation.ClearBoards()
+	return uu
+}
+
+// RemoveBoardIDs removes the "boards" edge to Board entities by IDs.
+func (uu *UserUpdate) RemoveBoardIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveBoardIDs(ids...)
+	return uu
+}
+
+// RemoveBoards removes "boards" edges to Board entities.
+func (uu *UserUpdate) RemoveBoards(b ...*Board) *UserUpdate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uu.RemoveBoardIDs(ids...)
+}
+
+// ClearChanges clears all "changes" edges to the PixelChange entity.
+func (uu *UserUpdate) ClearChanges() *UserUpdate {
+	uu.mutation.ClearChanges()
+	return uu
+}
+
+// RemoveChangeIDs removes the "changes" edge to PixelChange entities by IDs.
+func (uu *UserUpdate) RemoveChangeIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveChangeIDs(ids...)
+	return uu
+}
+
+// RemoveChanges removes "changes" edges to PixelChange entities.
+func (uu *UserUpdate) RemoveChanges(p ...*PixelChange) *UserUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uu.RemoveChangeIDs(ids...)
+}
+
+// ClearHypeLedger clears all "hype_ledger" edges to the HypeLedger entity.
+func (uu *UserUpdate) ClearHypeLedger() *UserUpdate {
+	uu.mutation.ClearHypeLedger()
+	return uu
+}
+
+// RemoveHypeLedgerIDs removes the "hype_ledger" edge to HypeLedger entities by IDs.
+func (uu *UserUpdate) RemoveHypeLedgerIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveHypeLedgerIDs(ids...)
+	return uu
+}
+
+// RemoveHypeLedger removes "hype_ledger" edges to HypeLedger entities.
+func (uu *UserUpdate) RemoveHypeLedger(h ...*HypeLedger) *UserUpdate {
+	ids := make([]int, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return uu.RemoveHypeLedgerIDs(ids...)
+}
+
+// ClearHypeAdjustments clears all "hype_adjustments" edges to the HypeLedger entity.
+func (uu *UserUpdate) ClearHypeAdjustments() *UserUpdate {
+	uu.mutation.ClearHypeAdjustments()
+	return uu
+}
+
+// RemoveHypeAdjustmentIDs removes the "hype_adjustments" edge to HypeLedger entities by IDs.
+func (uu *UserUpdate) RemoveHypeAdjustmentIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveHypeAdjustmentIDs(ids...)
+	return uu
+}
+
+// RemoveHypeAdjustments removes "hype_adjustments" edges to HypeLedger entities.
+func (uu *UserUpdate) RemoveHypeAdjustments(h ...*HypeLedger) *UserUpdate {
+	ids := make([]int, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return uu.RemoveHypeAdjustmentIDs(ids...)
+}
+
+// ClearRegions clears all "regions" edges to the Region entity.
+func (uu *UserUpdate) ClearRegions() *UserUpdate {
+	uu.mutation.ClearRegions()
+	return uu
+}
+
+// RemoveRegionIDs removes the "regions" edge to Region entities by IDs.
+func (uu *UserUpdate) RemoveRegionIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveRegionIDs(ids...)
+	return uu
+}
+
+// RemoveRegions removes "regions" edges to Region entities.
+func (uu *UserUpdate) RemoveRegions(r ...*Region) *UserUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uu.RemoveRegionIDs(ids...)
+}
+
+// ClearTeam clears the "team" edge to the Team entity.
+func (uu *UserUpdate) ClearTeam() *UserUpdate {
+	uu.mutation.ClearTeam()
+	return uu
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, uu.sqlSave, uu.mutation, uu.hooks)
@@ -150,7 +421,20 @@ func (uu *UserUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (uu *UserUpdate) check() error {
+	if v, ok := uu.mutation.Role(); ok {
+		if err := user.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := uu.check(); err != nil {
+		return n, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64))
 	if ps := uu.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -165,6 +449,21 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := uu.mutation.GameID(); ok {
 		_spec.SetField(user.FieldGameID, field.TypeString, value)
 	}
+	if value, ok := uu.mutation.Role(); ok {
+		_spec.SetField(user.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := uu.mutation.BannedAt(); ok {
+		_spec.SetField(user.FieldBannedAt, field.TypeTime, value)
+	}
+	if uu.mutation.BannedAtCleared() {
+		_spec.ClearField(user.FieldBannedAt, field.TypeTime)
+	}
+	if value, ok := uu.mutation.BanReason(); ok {
+		_spec.SetField(user.FieldBanReason, field.TypeString, value)
+	}
+	if uu.mutation.BanReasonCleared() {
+		_spec.ClearField(user.FieldBanReason, field.TypeString)
+	}
 	if uu.mutation.PixelsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -239,86 +538,494 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{user.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if uu.mutation.BoardsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BoardsTable,
+			Columns: []string{user.BoardsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeInt),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	uu.mutation.done = true
-	return n, nil
-}
-
-// UserUpdateOne is the builder for updating a single User entity.
-type UserUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *UserMutation
-}
-
-// SetDisplayName sets the "display_name" field.
-func (uuo *UserUpdateOne) SetDisplayName(s string) *UserUpdateOne {
-	uuo.mutation.SetDisplayName(s)
-	return uuo
-}
-
-// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableDisplayName(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetDisplayName(*s)
+	if nodes := uu.mutation.RemovedBoardsIDs(); len(nodes) > 0 && !uu.mutation.BoardsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BoardsTable,
+			Columns: []string{user.BoardsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo
-}
-
-// SetGameID sets the "game_id" field.
-func (uuo *UserUpdateOne) SetGameID(s string) *UserUpdateOne {
-	uuo.mutation.SetGameID(s)
-	return uuo
-}
-
-// SetNillableGameID sets the "game_id" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableGameID(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetGameID(*s)
+	if nodes := uu.mutation.BoardsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BoardsTable,
+			Columns: []string{user.BoardsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo
-}
-
-// AddPixelIDs adds the "pixels" edge to the Pixel entity by IDs.
-func (uuo *UserUpdateOne) AddPixelIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.AddPixelIDs(ids...)
-	return uuo
-}
-
-// AddPixels adds the "pixels" edges to the Pixel entity.
-func (uuo *UserUpdateOne) AddPixels(p ...*Pixel) *UserUpdateOne {
-	ids := make([]int, len(p))
-	for i := range p {
-		ids[i] = p[i].ID
+	if uu.mutation.ChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ChangesTable,
+			Columns: []string{user.ChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pixelchange.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.AddPixelIDs(ids...)
-}
-
-// SetHypeID sets the "hype" edge to the Hype entity by ID.
-func (uuo *UserUpdateOne) SetHypeID(id int) *UserUpdateOne {
-	uuo.mutation.SetHypeID(id)
-	return uuo
-}
-
-// SetNillableHypeID sets the "hype" edge to the Hype entity by ID if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableHypeID(id *int) *UserUpdateOne {
-	if id != nil {
-		uuo = uuo.SetHypeID(*id)
+	if nodes := uu.mutation.RemovedChangesIDs(); len(nodes) > 0 && !uu.mutation.ChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ChangesTable,
+			Columns: []string{user.ChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pixelchange.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo
-}
-
-// SetHype sets the "hype" edge to the Hype entity.
-func (uuo *UserUpdateOne) SetHype(h *Hype) *UserUpdateOne {
-	return uuo.SetHypeID(h.ID)
+	if nodes := uu.mutation.ChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ChangesTable,
+			Columns: []string{user.ChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pixelchange.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.HypeLedgerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.HypeLedgerTable,
+			Columns: []string{user.HypeLedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hypeledger.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedHypeLedgerIDs(); len(nodes) > 0 && !uu.mutation.HypeLedgerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.HypeLedgerTable,
+			Columns: []string{user.HypeLedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hypeledger.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.HypeLedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.HypeLedgerTable,
+			Columns: []string{user.HypeLedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hypeledger.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.HypeAdjustmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.HypeAdjustmentsTable,
+			Columns: []string{user.HypeAdjustmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hypeledger.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedHypeAdjustmentsIDs(); len(nodes) > 0 && !uu.mutation.HypeAdjustmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.HypeAdjustmentsTable,
+			Columns: []string{user.HypeAdjustmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hypeledger.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.HypeAdjustmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.HypeAdjustmentsTable,
+			Columns: []string{user.HypeAdjustmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hypeledger.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.RegionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RegionsTable,
+			Columns: []string{user.RegionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(region.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedRegionsIDs(); len(nodes) > 0 && !uu.mutation.RegionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RegionsTable,
+			Columns: []string{user.RegionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(region.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RegionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RegionsTable,
+			Columns: []string{user.RegionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(region.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.TeamCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   user.TeamTable,
+			Columns: []string{user.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.TeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   user.TeamTable,
+			Columns: []string{user.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{user.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	uu.mutation.done = true
+	return n, nil
+}
+
+// UserUpdateOne is the builder for updating a single User entity.
+type UserUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserMutation
+}
+
+// SetDisplayName sets the "display_name" field.
+func (uuo *UserUpdateOne) SetDisplayName(s string) *UserUpdateOne {
+	uuo.mutation.SetDisplayName(s)
+	return uuo
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableDisplayName(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetDisplayName(*s)
+	}
+	return uuo
+}
+
+// SetGameID sets the "game_id" field.
+func (uuo *UserUpdateOne) SetGameID(s string) *UserUpdateOne {
+	uuo.mutation.SetGameID(s)
+	return uuo
+}
+
+// SetNillableGameID sets the "game_id" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableGameID(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetGameID(*s)
+	}
+	return uuo
+}
+
+// SetTeamID sets the "team_id" field.
+func (uuo *UserUpdateOne) SetTeamID(i int) *UserUpdateOne {
+	uuo.mutation.SetTeamID(i)
+	return uuo
+}
+
+// SetNillableTeamID sets the "team_id" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableTeamID(i *int) *UserUpdateOne {
+	if i != nil {
+		uuo.SetTeamID(*i)
+	}
+	return uuo
+}
+
+// ClearTeamID clears the value of the "team_id" field.
+func (uuo *UserUpdateOne) ClearTeamID() *UserUpdateOne {
+	uuo.mutation.ClearTeamID()
+	return uuo
+}
+
+// SetRole sets the "role" field.
+func (uuo *UserUpdateOne) SetRole(u user.Role) *UserUpdateOne {
+	uuo.mutation.SetRole(u)
+	return uuo
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableRole(u *user.Role) *UserUpdateOne {
+	if u != nil {
+		uuo.SetRole(*u)
+	}
+	return uuo
+}
+
+// SetBannedAt sets the "banned_at" field.
+func (uuo *UserUpdateOne) SetBannedAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetBannedAt(t)
+	return uuo
+}
+
+// SetNillableBannedAt sets the "banned_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableBannedAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetBannedAt(*t)
+	}
+	return uuo
+}
+
+// ClearBannedAt clears the value of the "banned_at" field.
+func (uuo *UserUpdateOne) ClearBannedAt() *UserUpdateOne {
+	uuo.mutation.ClearBannedAt()
+	return uuo
+}
+
+// SetBanReason sets the "ban_reason" field.
+func (uuo *UserUpdateOne) SetBanReason(s string) *UserUpdateOne {
+	uuo.mutation.SetBanReason(s)
+	return uuo
+}
+
+// SetNillableBanReason sets the "ban_reason" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableBanReason(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetBanReason(*s)
+	}
+	return uuo
+}
+
+// ClearBanReason clears the value of the "ban_reason" field.
+func (uuo *UserUpdateOne) ClearBanReason() *UserUpdateOne {
+	uuo.mutation.ClearBanReason()
+	return uuo
+}
+
+// AddPixelIDs adds the "pixels" edge to the Pixel entity by IDs.
+func (uuo *UserUpdateOne) AddPixelIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddPixelIDs(ids...)
+	return uuo
+}
+
+// AddPixels adds the "pixels" edges to the Pixel entity.
+func (uuo *UserUpdateOne) AddPixels(p ...*Pixel) *UserUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uuo.AddPixelIDs(ids...)
+}
+
+// SetHypeID sets the "hype" edge to the Hype entity by ID.
+func (uuo *UserUpdateOne) SetHypeID(id int) *UserUpdateOne {
+	uuo.mutation.SetHypeID(id)
+	return uuo
+}
+
+// SetNillableHypeID sets the "hype" edge to the Hype entity by ID if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableHypeID(id *int) *UserUpdateOne {
+	if id != nil {
+		uuo = uuo.SetHypeID(*id)
+	}
+	return uuo
+}
+
+// SetHype sets the "hype" edge to the Hype entity.
+func (uuo *UserUpdateOne) SetHype(h *Hype) *UserUpdateOne {
+	return uuo.SetHypeID(h.ID)
+}
+
+// AddBoardIDs adds the "boards" edge to the Board entity by IDs.
+func (uuo *UserUpdateOne) AddBoardIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddBoardIDs(ids...)
+	return uuo
+}
+
+// AddBoards adds the "boards" edges to the Board entity.
+func (uuo *UserUpdateOne) AddBoards(b ...*Board) *UserUpdateOne {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uuo.AddBoardIDs(ids...)
+}
+
+// AddChangeIDs adds the "changes" edge to the PixelChange entity by IDs.
+func (uuo *UserUpdateOne) AddChangeIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddChangeIDs(ids...)
+	return uuo
+}
+
+// AddChanges adds the "changes" edges to the PixelChange entity.
+func (uuo *UserUpdateOne) AddChanges(p ...*PixelChange) *UserUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uuo.AddChangeIDs(ids...)
+}
+
+// AddHypeLedgerIDs adds the "hype_ledger" edge to the HypeLedger entity by IDs.
+func (uuo *UserUpdateOne) AddHypeLedgerIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddHypeLedgerIDs(ids...)
+	return uuo
+}
+
+// AddHypeLedger adds the "hype_ledger" edges to the HypeLedger entity.
+func (uuo *UserUpdateOne) AddHypeLedger(h ...*HypeLedger) *UserUpdateOne {
+	ids := make([]int, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return uuo.AddHypeLedgerIDs(ids...)
+}
+
+// AddHypeAdjustmentIDs adds the "hype_adjustments" edge to the HypeLedger entity by IDs.
+func (uuo *UserUpdateOne) AddHypeAdjustmentIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddHypeAdjustmentIDs(ids...)
+	return uuo
+}
+
+// AddHypeAdjustments adds the "hype_adjustments" edges to the HypeLedger entity.
+func (uuo *UserUpdateOne) AddHypeAdjustments(h ...*HypeLedger) *UserUpdateOne {
+	ids := make([]int, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return uuo.AddHypeAdjustmentIDs(ids...)
+}
+
+// AddRegionIDs adds the "regions" edge to the Region entity by IDs.
+func (uuo *UserUpdateOne) AddRegionIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddRegionIDs(ids...)
+	return uuo
+}
+
+// AddRegions adds the "regions" edges to the Region entity.
+func (uuo *UserUpdateOne) AddRegions(r ...*Region) *UserUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uuo.AddRegionIDs(ids...)
+}
+
+// SetTeam sets the "team" edge to the Team entity.
+func (uuo *UserUpdateOne) SetTeam(t *Team) *UserUpdateOne {
+	return uuo.SetTeamID(t.ID)
 }
 
 // Mutation returns the UserMutation object of the builder.
@@ -353,6 +1060,117 @@ func (uuo *UserUpdateOne) ClearHype() *UserUpdateOne {
 	return uuo
 }
 
+// ClearBoards clears all "boards" edges to the Board entity.
+func (uuo *UserUpdateOne) ClearBoards() *UserUpdateOne {
+	uuo.mutation.ClearBoards()
+	return uuo
+}
+
+// RemoveBoardIDs removes the "boards" edge to Board entities by IDs.
+func (uuo *UserUpdateOne) RemoveBoardIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveBoardIDs(ids...)
+	return uuo
+}
+
+// RemoveBoards removes "boards" edges to Board entities.
+func (uuo *UserUpdateOne) RemoveBoards(b ...*Board) *UserUpdateOne {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uuo.RemoveBoardIDs(ids...)
+}
+
+// ClearChanges clears all "changes" edges to the PixelChange entity.
+func (uuo *UserUpdateOne) ClearChanges() *UserUpdateOne {
+	uuo.mutation.ClearChanges()
+	return uuo
+}
+
+// RemoveChangeIDs removes the "changes" edge to PixelChange entities by IDs.
+func (uuo *UserUpdateOne) RemoveChangeIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveChangeIDs(ids...)
+	return uuo
+}
+
+// RemoveChanges removes "changes" edges to PixelChange entities.
+func (uuo *UserUpdateOne) RemoveChanges(p ...*PixelChange) *UserUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uuo.RemoveChangeIDs(ids...)
+}
+
+// ClearHypeLedger clears all "hype_ledger" edges to the HypeLedger entity.
+func (uuo *UserUpdateOne) ClearHypeLedger() *UserUpdateOne {
+	uuo.mutation.ClearHypeLedger()
+	return uuo
+}
+
+// RemoveHypeLedgerIDs removes the "hype_ledger" edge to HypeLedger entities by IDs.
+func (uuo *UserUpdateOne) RemoveHypeLedgerIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveHypeLedgerIDs(ids...)
+	return uuo
+}
+
+// RemoveHypeLedger removes "hype_ledger" edges to HypeLedger entities.
+func (uuo *UserUpdateOne) RemoveHypeLedger(h ...*HypeLedger) *UserUpdateOne {
+	ids := make([]int, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return uuo.RemoveHypeLedgerIDs(ids...)
+}
+
+// ClearHypeAdjustments clears all "hype_adjustments" edges to the HypeLedger entity.
+func (uuo *UserUpdateOne) ClearHypeAdjustments() *UserUpdateOne {
+	uuo.mutation.ClearHypeAdjustments()
+	return uuo
+}
+
+// RemoveHypeAdjustmentIDs removes the "hype_adjustments" edge to HypeLedger entities by IDs.
+func (uuo *UserUpdateOne) RemoveHypeAdjustmentIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveHypeAdjustmentIDs(ids...)
+	return uuo
+}
+
+// RemoveHypeAdjustments removes "hype_adjustments" edges to HypeLedger entities.
+func (uuo *UserUpdateOne) RemoveHypeAdjustments(h ...*HypeLedger) *UserUpdateOne {
+	ids := make([]int, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return uuo.RemoveHypeAdjustmentIDs(ids...)
+}
+
+// ClearRegions clears all "regions" edges to the Region entity.
+func (uuo *UserUpdateOne) ClearRegions() *UserUpdateOne {
+	uuo.mutation.ClearRegions()
+	return uuo
+}
+
+// RemoveRegionIDs removes the "regions" edge to Region entities by IDs.
+func (uuo *UserUpdateOne) RemoveRegionIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveRegionIDs(ids...)
+	return uuo
+}
+
+// RemoveRegions removes "regions" edges to Region entities.
+func (uuo *UserUpdateOne) RemoveRegions(r ...*Region) *UserUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uuo.RemoveRegionIDs(ids...)
+}
+
+// ClearTeam clears the "team" edge to the Team entity.
+func (uuo *UserUpdateOne) ClearTeam() *UserUpdateOne {
+	uuo.mutation.ClearTeam()
+	return uuo
+}
+
 // Where appends a list predicates to the UserUpdate builder.
 func (uuo *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
 	uuo.mutation.Where(ps...)
@@ -393,7 +1211,20 @@ func (uuo *UserUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (uuo *UserUpdateOne) check() error {
+	if v, ok := uuo.mutation.Role(); ok {
+		if err := user.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
+	if err := uuo.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64))
 	id, ok := uuo.mutation.ID()
 	if !ok {
@@ -425,6 +1256,21 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 	if value, ok := uuo.mutation.GameID(); ok {
 		_spec.SetField(user.FieldGameID, field.TypeString, value)
 	}
+	if value, ok := uuo.mutation.Role(); ok {
+		_spec.SetField(user.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := uuo.mutation.BannedAt(); ok {
+		_spec.SetField(user.FieldBannedAt, field.TypeTime, value)
+	}
+	if uuo.mutation.BannedAtCleared() {
+		_spec.ClearField(user.FieldBannedAt, field.TypeTime)
+	}
+	if value, ok := uuo.mutation.BanReason(); ok {
+		_spec.SetField(user.FieldBanReason, field.TypeString, value)
+	}
+	if uuo.mutation.BanReasonCleared() {
+		_spec.ClearField(user.FieldBanReason, field.TypeString)
+	}
 	if uuo.mutation.PixelsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -499,6 +1345,260 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if uuo.mutation.BoardsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BoardsTable,
+			Columns: []string{user.BoardsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedBoardsIDs(); len(nodes) > 0 && !uuo.mutation.BoardsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BoardsTable,
+			Columns: []string{user.BoardsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.BoardsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BoardsTable,
+			Columns: []string{user.BoardsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.ChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ChangesTable,
+			Columns: []string{user.ChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pixelchange.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedChangesIDs(); len(nodes) > 0 && !uuo.mutation.ChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ChangesTable,
+			Columns: []string{user.ChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pixelchange.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.ChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ChangesTable,
+			Columns: []string{user.ChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pixelchange.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.HypeLedgerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.HypeLedgerTable,
+			Columns: []string{user.HypeLedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hypeledger.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedHypeLedgerIDs(); len(nodes) > 0 && !uuo.mutation.HypeLedgerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.HypeLedgerTable,
+			Columns: []string{user.HypeLedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hypeledger.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.HypeLedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.HypeLedgerTable,
+			Columns: []string{user.HypeLedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hypeledger.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.HypeAdjustmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.HypeAdjustmentsTable,
+			Columns: []string{user.HypeAdjustmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hypeledger.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedHypeAdjustmentsIDs(); len(nodes) > 0 && !uuo.mutation.HypeAdjustmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.HypeAdjustmentsTable,
+			Columns: []string{user.HypeAdjustmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hypeledger.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.HypeAdjustmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.HypeAdjustmentsTable,
+			Columns: []string{user.HypeAdjustmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hypeledger.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.RegionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RegionsTable,
+			Columns: []string{user.RegionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(region.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedRegionsIDs(); len(nodes) > 0 && !uuo.mutation.RegionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RegionsTable,
+			Columns: []string{user.RegionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(region.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RegionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RegionsTable,
+			Columns: []string{user.RegionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(region.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.TeamCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   user.TeamTable,
+			Columns: []string{user.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.TeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   user.TeamTable,
+			Columns: []string{user.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &User{config: uuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
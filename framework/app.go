@@ -7,6 +7,8 @@ import (
 	"github.com/labstack/echo/v4/middleware"
 	"github.com/sirupsen/logrus"
 	"nevissGo/ent"
+	"nevissGo/pkg/palette"
+	"reflect"
 	"slices"
 )
 
@@ -25,6 +27,11 @@ type App struct {
 	client    *ent.Client
 	endpoints *Endpoints
 	validate  *validator.Validate
+
+	encoders   map[reflect.Type]func(data any) any
+	outboxWake chan struct{}
+	// outboxLastID is the last event the outbox dispatched.
+	outboxLastID int
 }
 
 func NewApp(client *ent.Client, cent Centrifugo, config Config) *App {
@@ -36,6 +43,9 @@ func NewApp(client *ent.Client, cent Centrifugo, config Config) *App {
 		endpoints: &Endpoints{endpoints: make(map[string]EndpointHandler)},
 		validate:  validate,
 		Event:     cent,
+
+		encoders:   make(map[reflect.Type]func(data any) any),
+		outboxWake: make(chan struct{}, 1),
 	}
 
 	if err := validate.RegisterValidation("action", func(fl validator.FieldLevel) bool {
@@ -44,6 +54,19 @@ func NewApp(client *ent.Client, cent Centrifugo, config Config) *App {
 		logrus.WithError(err).Fatal("couldn't register action validation")
 	}
 
+	if err := validate.RegisterValidation("color", func(fl validator.FieldLevel) bool {
+		return palette.IsKnownColor(fl.Field().String())
+	}); err != nil {
+		logrus.WithError(err).Fatal("couldn't register color validation")
+	}
+
+	if err := validate.RegisterValidation("palette", func(fl validator.FieldLevel) bool {
+		_, ok := palette.Get(fl.Field().String())
+		return ok
+	}); err != nil {
+		logrus.WithError(err).Fatal("couldn't register palette validation")
+	}
+
 	return app
 
 }
@@ -97,10 +120,6 @@ func (a *App) ServeEndpoints() error {
 		c.JSON(200, response)
 	}
 
-	for _, middlewareFunc := range a.endpoints.middlewares {
-		e.Use(middlewareFunc)
-	}
-
 	e.POST("/call", func(c echo.Context) error {
 		user := c.Get("user").(ent.User)
 
@@ -116,7 +135,17 @@ func (a *App) ServeEndpoints() error {
 		}
 
 		return a.endpoints.endpoints[request.Action](ctx)
-	})
+	}, a.endpoints.middlewares...)
+
+	for _, r := range a.endpoints.routes {
+		handler := r.handler
+		e.Add(r.method, r.path, func(c echo.Context) error {
+			return handler(&Context{
+				Context: c,
+				App:     a,
+			})
+		})
+	}
 
 	return e.Start(a.config.Addr)
 }
@@ -3,16 +3,71 @@ package framework
 import (
 	"github.com/labstack/echo/v4"
 	"github.com/samber/lo"
+	"nevissGo/ent"
+	"nevissGo/ent/user"
 )
 
 type EndpointHandler func(ctx *Context) error
 type Endpoints struct {
 	endpoints   map[string]EndpointHandler
+	routes      []route
 	middlewares []echo.MiddlewareFunc
 }
 
-func (e *Endpoints) Register(action string, handler EndpointHandler) {
-	e.endpoints[action] = handler
+type route struct {
+	method  string
+	path    string
+	handler EndpointHandler
+}
+
+// Permission guards an action. Returning an error refuses the call before the
+// handler runs.
+type Permission func(ctx *Context) error
+
+// AdminOnly refuses the call unless the user is an admin.
+func AdminOnly(ctx *Context) error {
+	if ctx.User == nil || ctx.User.Role != user.RoleAdmin {
+		return NewForbiddenError("Only admins can do this")
+	}
+	return nil
+}
+
+// EnsureNotBanned keeps banned users out of every action, whichever way they
+// authenticate.
+func EnsureNotBanned(user *ent.User) error {
+	if user.BannedAt == nil {
+		return nil
+	}
+
+	return NewForbiddenError("You are banned").WithFields(Fields{
+		"reason": user.BanReason,
+	})
+}
+
+func (e *Endpoints) Register(action string, handler EndpointHandler, permissions ...Permission) {
+	if len(permissions) == 0 {
+		e.endpoints[action] = handler
+		return
+	}
+
+	e.endpoints[action] = func(ctx *Context) error {
+		for _, permission := range permissions {
+			if err := permission(ctx); err != nil {
+				return err
+			}
+		}
+		return handler(ctx)
+	}
+}
+
+// Route registers a plain HTTP route next to /call. Routes skip the endpoint
+// middlewares, so the handler gets a context without a user.
+func (e *Endpoints) Route(method, path string, handler EndpointHandler) {
+	e.routes = append(e.routes, route{
+		method:  method,
+		path:    path,
+		handler: handler,
+	})
 }
 
 func (e *Endpoints) Middleware(middlewareFunc echo.MiddlewareFunc) {
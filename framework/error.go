@@ -1,6 +1,10 @@
 package framework
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
 
 var _ error = &Error{}
 
@@ -11,11 +15,15 @@ type Error struct {
 }
 
 func (e *Error) Error() string {
+	keys := make([]string, 0, len(e.Fields))
+	for k := range e.Fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
 	fields := ""
-	if e.Fields != nil {
-		for k, v := range e.Fields {
-			fields += k + ": " + v.(string) + ", "
-		}
+	for _, k := range keys {
+		fields += k + ": " + fmt.Sprint(e.Fields[k]) + ", "
 	}
 
 	return e.Message + " (" + fields + ")"
@@ -49,6 +57,31 @@ func NewUnauthorizedError(message string) *Error {
 	}
 }
 
+func NewForbiddenError(message string) *Error {
+	return &Error{
+		ErrorCode: 403,
+		Message:   message,
+	}
+}
+
+// NewConflictError is returned when a concurrent request changed the same
+// data first. The request can be retried.
+func NewConflictError(message string) *Error {
+	return &Error{
+		ErrorCode: 409,
+		Message:   message,
+	}
+}
+
+// NewTooManyRequestsError is returned when a user has to wait before trying
+// again.
+func NewTooManyRequestsError(message string) *Error {
+	return &Error{
+		ErrorCode: 429,
+		Message:   message,
+	}
+}
+
 func ExtErrorCode(err error) int {
 	var e *Error
 	if errors.As(err, &e) {
@@ -7,6 +7,7 @@ import (
 	"github.com/centrifugal/gocent/v3"
 	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
+	"nevissGo/ent"
 	"strings"
 )
 
@@ -16,6 +17,45 @@ type Centrifugo interface {
 	OnlineWSUsers(ctx context.Context) (int, error)
 	PersonalMany(ctx context.Context, usersIds []any, eventName string, data any) error
 	Broadcast(ctx context.Context, eventName string, data any) error
+	TeamMessage(ctx context.Context, teamID int, eventName string, data any) error
+}
+
+// Subscriber is an event transport that subscribes clients to their channels
+// itself, rather than trusting the list in their token.
+type Subscriber interface {
+	// Resubscribe moves the open connections of a user to the channels they
+	// have now.
+	Resubscribe(ctx context.Context, userID int64) error
+}
+
+// ChannelsChanged tells the event transport that a user joined or left a
+// team, or was banned, once the change has committed.
+func (a *App) ChannelsChanged(ctx context.Context, userID int64) {
+	subscriber, ok := a.Event.(Subscriber)
+	if !ok {
+		return
+	}
+	if err := subscriber.Resubscribe(ctx, userID); err != nil {
+		logrus.WithError(err).WithField("user_id", userID).Error("couldn't resubscribe user")
+	}
+}
+
+// TeamChannel is the channel only the members of a team are subscribed to.
+func TeamChannel(teamID int) string {
+	return fmt.Sprintf("team:%d", teamID)
+}
+
+// UserChannels lists the channels a user is subscribed to.
+func UserChannels(user *ent.User) []string {
+	channels := []string{
+		fmt.Sprintf("personal:#%d", user.ID),
+		fmt.Sprintf("personal:#%s", user.GameID),
+		"personal:broadcast",
+	}
+	if user.TeamID != nil {
+		channels = append(channels, TeamChannel(*user.TeamID))
+	}
+	return channels
 }
 
 func EventUserIDs(ids []int64) []any {
@@ -109,6 +149,28 @@ func (c *CentrifugoClient) PersonalMessage(ctx context.Context, userID any, even
 	return err
 }
 
+func (c *CentrifugoClient) TeamMessage(ctx context.Context, teamID int, eventName string, data any) error {
+	if c.centClient == nil {
+		return nil
+	}
+
+	dataBytes, err := json.Marshal(map[string]any{
+		"event": eventName,
+		"data":  data,
+	})
+	if err != nil {
+		logrus.WithError(err).Error("couldn't marshal team data")
+		return err
+	}
+
+	_, err = c.centClient.Publish(ctx, TeamChannel(teamID), dataBytes)
+	if err != nil {
+		logrus.WithError(err).WithField("team_id", teamID).Error("couldn't publish team message")
+		return err
+	}
+	return err
+}
+
 func (c *CentrifugoClient) OnlineWSUsers(ctx context.Context) (int, error) {
 	if c.centClient == nil {
 		return 0, nil
@@ -0,0 +1,329 @@
+package framework
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/websocket"
+	"nevissGo/ent"
+)
+
+const (
+	hubSendBuffer   = 64
+	hubHeartbeat    = 25 * time.Second
+	hubWriteTimeout = 10 * time.Second
+)
+
+var _ Centrifugo = &Hub{}
+var _ Endpoint = &Hub{}
+var _ Subscriber = &Hub{}
+
+// Hub sends events to clients connected to the server itself, over WebSocket
+// or Server-Sent Events, so the game runs without a Centrifugo server. Like
+// Centrifugo, clients authenticate with their user token and receive the same
+// {"event", "data"} messages. Their channels come from the database rather
+// than the token, and are updated through Resubscribe when the user joins or
+// leaves a team. Only the global online count is kept; there is no presence
+// per channel.
+//
+// Clients that fall behind are disconnected rather than slowing down the
+// others; they reconnect and catch up like after any other disconnect.
+type Hub struct {
+	client    *ent.Client
+	secretKey string
+
+	mu       sync.RWMutex
+	channels map[string]map[*hubClient]struct{}
+	users    map[string]int
+}
+
+type hubClient struct {
+	userID   string
+	channels []string
+	send     chan []byte
+	done     chan struct{}
+	once     sync.Once
+}
+
+func (c *hubClient) drop() {
+	c.once.Do(func() {
+		close(c.done)
+	})
+}
+
+func NewHub(client *ent.Client, secretKey string) *Hub {
+	return &Hub{
+		client:    client,
+		secretKey: secretKey,
+		channels:  map[string]map[*hubClient]struct{}{},
+		users:     map[string]int{},
+	}
+}
+
+func (h *Hub) Endpoints(router *Endpoints) {
+	router.Route(http.MethodGet, "/events/websocket", h.ServeWebSocket)
+	router.Route(http.MethodGet, "/events/sse", h.ServeSSE)
+}
+
+func (h *Hub) Broadcast(ctx context.Context, eventName string, data any) error {
+	return h.publish([]string{"personal:broadcast"}, eventName, data)
+}
+
+func (h *Hub) PersonalMany(ctx context.Context, usersIds []any, eventName string, data any) error {
+	channels := make([]string, len(usersIds))
+	for i, id := range usersIds {
+		channels[i] = fmt.Sprintf("personal:#%v", id)
+	}
+	return h.publish(channels, eventName, data)
+}
+
+func (h *Hub) PersonalMessage(ctx context.Context, userID any, eventName string, data any) error {
+	return h.publish([]string{fmt.Sprintf("personal:#%v", userID)}, eventName, data)
+}
+
+func (h *Hub) TeamMessage(ctx context.Context, teamID int, eventName string, data any) error {
+	return h.publish([]string{TeamChannel(teamID)}, eventName, data)
+}
+
+// OnlineWSUsers counts the users with at least one open connection.
+func (h *Hub) OnlineWSUsers(ctx context.Context) (int, error) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	return len(h.users), nil
+}
+
+// publish sends the event once to every client subscribed to any of the
+// channels.
+func (h *Hub) publish(channels []string, eventName string, data any) error {
+	message, err := json.Marshal(map[string]any{
+		"event": eventName,
+		"data":  data,
+	})
+	if err != nil {
+		logrus.WithError(err).Error("couldn't marshal event data")
+		return err
+	}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	sent := map[*hubClient]bool{}
+	for _, channel := range channels {
+		for client := range h.channels[channel] {
+			if sent[client] {
+				continue
+			}
+			sent[client] = true
+
+			select {
+			case client.send <- message:
+			default:
+				logrus.WithField("user_id", client.userID).Warn("Disconnecting slow event client")
+				client.drop()
+			}
+		}
+	}
+	return nil
+}
+
+// authorize reads the user token from the token query parameter, as browsers
+// can not set headers on WebSocket and EventSource requests, or from the
+// Authorization header the API uses. Banned users are refused like on the API.
+func (h *Hub) authorize(c *Context) (*hubClient, error) {
+	raw := c.QueryParam("token")
+	if raw == "" {
+		raw = strings.TrimPrefix(c.Request().Header.Get("Authorization"), "JWT:")
+	}
+
+	token, err := jwt.Parse(raw, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return []byte(h.secretKey), nil
+	})
+	if err != nil || !token.Valid {
+		return nil, NewUnauthorizedError("Unauthorized")
+	}
+
+	claims, _ := token.Claims.(jwt.MapClaims)
+	userID, err := strconv.ParseInt(fmt.Sprint(claims["sub"]), 10, 64)
+	if err != nil {
+		return nil, NewUnauthorizedError("Unauthorized")
+	}
+	user, err := h.client.User.Get(c.Request().Context(), userID)
+	if err != nil {
+		logrus.WithError(err).Error("couldn't get event stream user")
+		return nil, NewUnauthorizedError("Unauthorized")
+	}
+	if err := EnsureNotBanned(user); err != nil {
+		return nil, err
+	}
+
+	return &hubClient{
+		userID:   fmt.Sprint(user.ID),
+		channels: UserChannels(user),
+		send:     make(chan []byte, hubSendBuffer),
+		done:     make(chan struct{}),
+	}, nil
+}
+
+// Resubscribe moves the open connections of a user to the channels they have
+// now. Connections of banned users are closed.
+func (h *Hub) Resubscribe(ctx context.Context, userID int64) error {
+	user, err := h.client.User.Get(ctx, userID)
+	if err != nil {
+		return err
+	}
+	channels := UserChannels(user)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	// Every connection of the user is on their personal channel, which they
+	// are taken off and put back on below.
+	clients := lo.Keys(h.channels[fmt.Sprintf("personal:#%d", userID)])
+	for _, client := range clients {
+		if user.BannedAt != nil {
+			client.drop()
+			continue
+		}
+		h.leaveChannels(client)
+		client.channels = channels
+		h.joinChannels(client)
+	}
+	return nil
+}
+
+func (h *Hub) subscribe(client *hubClient) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.joinChannels(client)
+	h.users[client.userID]++
+}
+
+func (h *Hub) unsubscribe(client *hubClient) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.leaveChannels(client)
+	h.users[client.userID]--
+	if h.users[client.userID] <= 0 {
+		delete(h.users, client.userID)
+	}
+	client.drop()
+}
+
+// joinChannels and leaveChannels must be called with the lock held.
+func (h *Hub) joinChannels(client *hubClient) {
+	for _, channel := range client.channels {
+		if h.channels[channel] == nil {
+			h.channels[channel] = map[*hubClient]struct{}{}
+		}
+		h.channels[channel][client] = struct{}{}
+	}
+}
+
+func (h *Hub) leaveChannels(client *hubClient) {
+	for _, channel := range client.channels {
+		delete(h.channels[channel], client)
+		if len(h.channels[channel]) == 0 {
+			delete(h.channels, channel)
+		}
+	}
+}
+
+// ServeWebSocket streams events as text messages. Messages from the client
+// are ignored.
+func (h *Hub) ServeWebSocket(c *Context) error {
+	client, err := h.authorize(c)
+	if err != nil {
+		return err
+	}
+
+	// The token authorizes the connection, so any origin may connect.
+	server := websocket.Server{Handler: func(ws *websocket.Conn) {
+		defer ws.Close()
+
+		h.subscribe(client)
+		defer h.unsubscribe(client)
+
+		go func() {
+			_, _ = io.Copy(io.Discard, ws)
+			client.drop()
+		}()
+
+		heartbeat := time.NewTicker(hubHeartbeat)
+		defer heartbeat.Stop()
+
+		for {
+			ws.PayloadType = websocket.TextFrame
+			var message []byte
+			select {
+			case <-client.done:
+				return
+			case message = <-client.send:
+			case <-heartbeat.C:
+				ws.PayloadType = websocket.PingFrame
+			}
+
+			_ = ws.SetWriteDeadline(time.Now().Add(hubWriteTimeout))
+			if _, err := ws.Write(message); err != nil {
+				return
+			}
+		}
+	}}
+	server.ServeHTTP(c.Response(), c.Request())
+	return nil
+}
+
+// ServeSSE streams events as Server-Sent Events, for clients and proxies that
+// do not support WebSocket.
+func (h *Hub) ServeSSE(c *Context) error {
+	client, err := h.authorize(c)
+	if err != nil {
+		return err
+	}
+
+	w := c.Response()
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	w.Flush()
+
+	h.subscribe(client)
+	defer h.unsubscribe(client)
+
+	heartbeat := time.NewTicker(hubHeartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-c.Request().Context().Done():
+			return nil
+		case <-client.done:
+			return nil
+		case message := <-client.send:
+			if _, err := fmt.Fprintf(w, "data: %s\n\n", message); err != nil {
+				return nil
+			}
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return nil
+			}
+		}
+		w.Flush()
+	}
+}
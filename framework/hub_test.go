@@ -0,0 +1,182 @@
+package framework
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/websocket"
+	"nevissGo/ent"
+)
+
+const hubTestSecret = "secret"
+
+func hubTestServer(t *testing.T, hub *Hub) *httptest.Server {
+	e := echo.New()
+	e.GET("/events/websocket", func(c echo.Context) error {
+		return hub.ServeWebSocket(&Context{Context: c})
+	})
+	e.GET("/events/sse", func(c echo.Context) error {
+		return hub.ServeSSE(&Context{Context: c})
+	})
+
+	server := httptest.NewServer(e)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func hubTestToken(t *testing.T, userID int64, channels ...string) string {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"sub":      userID,
+		"channels": channels,
+		"exp":      time.Now().Add(time.Hour).Unix(),
+	}).SignedString([]byte(hubTestSecret))
+	require.NoError(t, err)
+	return token
+}
+
+func hubTestUser(app *TestingApp, userID int64) *ent.UserCreate {
+	return app.Client().User.Create().
+		SetID(userID).
+		SetDisplayName("User").
+		SetGameID(fmt.Sprint("game", userID))
+}
+
+func waitOnline(t *testing.T, hub *Hub, users int) {
+	require.Eventually(t, func() bool {
+		online, _ := hub.OnlineWSUsers(context.Background())
+		return online == users
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestHubWebSocket(t *testing.T) {
+	ctx := context.Background()
+	app := NewTestingApp(t)
+	team := app.Client().Team.Create().SetName("own").SetInviteCode("own").SaveX(ctx)
+	left := app.Client().Team.Create().SetName("left").SetInviteCode("left").SaveX(ctx)
+	hubTestUser(app, 1).SetTeamID(team.ID).SaveX(ctx)
+	hub := NewHub(app.Client(), hubTestSecret)
+	server := hubTestServer(t, hub)
+
+	// The token still lists the team the user has left.
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/events/websocket?token=" +
+		hubTestToken(t, 1, "personal:broadcast", TeamChannel(left.ID))
+	ws, err := websocket.Dial(url, "", server.URL)
+	require.NoError(t, err)
+	waitOnline(t, hub, 1)
+
+	require.NoError(t, hub.TeamMessage(ctx, left.ID, "team:message", "other team"))
+	require.NoError(t, hub.TeamMessage(ctx, team.ID, "team:message", "own team"))
+	require.NoError(t, hub.Broadcast(ctx, "pixel:updated", map[string]int{"seq": 3}))
+
+	var message string
+	require.NoError(t, websocket.Message.Receive(ws, &message))
+	assert.JSONEq(t, `{"event": "team:message", "data": "own team"}`, message)
+	require.NoError(t, websocket.Message.Receive(ws, &message))
+	assert.JSONEq(t, `{"event": "pixel:updated", "data": {"seq": 3}}`, message)
+
+	require.NoError(t, ws.Close())
+	waitOnline(t, hub, 0)
+}
+
+func TestHubSSE(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	app := NewTestingApp(t)
+	hubTestUser(app, 2).SaveX(ctx)
+	hub := NewHub(app.Client(), hubTestSecret)
+	server := hubTestServer(t, hub)
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/events/sse", nil)
+	require.NoError(t, err)
+	request.Header.Set("Authorization", "JWT:"+hubTestToken(t, 2, "personal:#2"))
+	response, err := http.DefaultClient.Do(request)
+	require.NoError(t, err)
+	defer response.Body.Close()
+	assert.Equal(t, "text/event-stream", response.Header.Get("Content-Type"))
+	waitOnline(t, hub, 1)
+
+	require.NoError(t, hub.PersonalMessage(ctx, 3, "hype:updated", 1))
+	require.NoError(t, hub.PersonalMany(ctx, []any{2, 3}, "hype:updated", 2))
+
+	line, err := bufio.NewReader(response.Body).ReadString('\n')
+	require.NoError(t, err)
+	assert.Equal(t, `data: {"data":2,"event":"hype:updated"}`+"\n", line)
+
+	cancel()
+	waitOnline(t, hub, 0)
+}
+
+func TestHubResubscribe(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	app := NewTestingApp(t)
+	member := hubTestUser(app, 1).SaveX(ctx)
+	hub := NewHub(app.Client(), hubTestSecret)
+	app.Event = hub
+	server := hubTestServer(t, hub)
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/events/sse?token="+hubTestToken(t, 1), nil)
+	require.NoError(t, err)
+	response, err := http.DefaultClient.Do(request)
+	require.NoError(t, err)
+	defer response.Body.Close()
+	waitOnline(t, hub, 1)
+
+	// The open connection follows the user into their new team.
+	team := app.Client().Team.Create().SetName("team").SetInviteCode("team").SaveX(ctx)
+	member.Update().SetTeamID(team.ID).ExecX(ctx)
+	app.ChannelsChanged(ctx, member.ID)
+	require.NoError(t, hub.TeamMessage(ctx, team.ID, "team:message", "hello"))
+
+	body := bufio.NewReader(response.Body)
+	line, err := body.ReadString('\n')
+	require.NoError(t, err)
+	assert.Equal(t, `data: {"data":"hello","event":"team:message"}`+"\n", line)
+
+	// Banned users are disconnected.
+	member.Update().SetBannedAt(time.Now()).ExecX(ctx)
+	app.ChannelsChanged(ctx, member.ID)
+	waitOnline(t, hub, 0)
+}
+
+func TestHubRefusesInvalidTokens(t *testing.T) {
+	ctx := context.Background()
+	app := NewTestingApp(t)
+	hubTestUser(app, 1).SaveX(ctx)
+	hubTestUser(app, 2).SetBannedAt(time.Now()).SaveX(ctx)
+	hub := NewHub(app.Client(), hubTestSecret)
+	server := hubTestServer(t, hub)
+
+	forged, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"sub":      1,
+		"channels": []string{"personal:broadcast"},
+	}).SignedString([]byte("other secret"))
+	require.NoError(t, err)
+	expired, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"sub": 1,
+		"exp": time.Now().Add(-time.Minute).Unix(),
+	}).SignedString([]byte(hubTestSecret))
+	require.NoError(t, err)
+	banned := hubTestToken(t, 2)
+	unknown := hubTestToken(t, 3)
+
+	for _, token := range []string{"", forged, expired, banned, unknown} {
+		response, err := http.Get(server.URL + "/events/sse?token=" + token)
+		require.NoError(t, err)
+		response.Body.Close()
+		assert.NotEqual(t, http.StatusOK, response.StatusCode)
+	}
+	online, err := hub.OnlineWSUsers(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, online)
+}
@@ -0,0 +1,230 @@
+package framework
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"nevissGo/ent"
+	"nevissGo/ent/outboxevent"
+)
+
+const (
+	outboxBatchSize   = 100
+	outboxMinBackoff  = 100 * time.Millisecond
+	outboxMaxBackoff  = 10 * time.Second
+	outboxSendTimeout = 5 * time.Second
+	// outboxGapGrace is how long a gap in the event IDs may be an event whose
+	// transaction has not committed yet.
+	outboxGapGrace = 2 * time.Second
+	// OutboxMaxAge is how long an event is retried before it is dropped.
+	// Clients that missed it catch up from the board sequence numbers.
+	OutboxMaxAge = 10 * time.Minute
+)
+
+// Event is a message for clients, sent to everyone or to the members of a
+// team.
+type Event struct {
+	Kind   outboxevent.Kind
+	Target string
+	Name   string
+	Data   any
+}
+
+func BroadcastEvent(name string, data any) Event {
+	return Event{Kind: outboxevent.KindBroadcast, Name: name, Data: data}
+}
+
+func TeamEvent(teamID int, name string, data any) Event {
+	return Event{Kind: outboxevent.KindTeam, Target: strconv.Itoa(teamID), Name: name, Data: data}
+}
+
+// RegisterEventEncoder makes the app send event data of type T as the value
+// fn returns, so services can announce changes without knowing how clients
+// see them. Data of other types is sent as it is.
+func RegisterEventEncoder[T any](app *App, fn func(T) any) {
+	app.encoders[reflect.TypeFor[T]()] = func(data any) any {
+		return fn(data.(T))
+	}
+}
+
+func (a *App) encodeEvent(e Event) ([]byte, error) {
+	data := e.Data
+	if encode, ok := a.encoders[reflect.TypeOf(data)]; ok {
+		data = encode(data)
+	}
+	return json.Marshal(data)
+}
+
+// Enqueue stores events in the outbox within the transaction of the change
+// they announce, so they are sent if and only if the change commits, and in
+// the order they were stored.
+func (a *App) Enqueue(ctx context.Context, tx *ent.Tx, events ...Event) error {
+	creates := make([]*ent.OutboxEventCreate, len(events))
+	for i, e := range events {
+		payload, err := a.encodeEvent(e)
+		if err != nil {
+			logrus.WithError(err).WithField("event", e.Name).Error("Failed to encode event")
+			return NewInternalError("Failed to encode event")
+		}
+
+		creates[i] = tx.OutboxEvent.Create().
+			SetKind(e.Kind).
+			SetTarget(e.Target).
+			SetName(e.Name).
+			SetPayload(payload)
+	}
+
+	if err := tx.OutboxEvent.CreateBulk(creates...).Exec(ctx); err != nil {
+		logrus.WithError(err).Error("Failed to store events")
+		return NewInternalError("Failed to store events")
+	}
+
+	tx.OnCommit(func(next ent.Committer) ent.Committer {
+		return ent.CommitFunc(func(ctx context.Context, tx *ent.Tx) error {
+			err := next.Commit(ctx, tx)
+			if err == nil {
+				a.wakeOutbox()
+			}
+			return err
+		})
+	})
+	return nil
+}
+
+// Publish stores events that do not go with a change in the outbox, so they
+// are still sent in order with the others.
+func (a *App) Publish(ctx context.Context, events ...Event) error {
+	return a.TX(ctx, func(tx *ent.Tx) error {
+		return a.Enqueue(ctx, tx, events...)
+	})
+}
+
+func (a *App) wakeOutbox() {
+	select {
+	case a.outboxWake <- struct{}{}:
+	default:
+	}
+}
+
+// RunOutbox sends the stored events in order. It wakes up when events are
+// committed by this process and polls for the ones stored by others, like
+// the operator commands. An event that fails is retried with a growing delay
+// and holds back the events after it, so clients never see them out of order.
+// So does a gap in the IDs for a moment, see DispatchOutbox.
+func (a *App) RunOutbox(ctx context.Context, poll time.Duration) {
+	for {
+		wait, err := a.DispatchOutbox(ctx)
+		if err != nil {
+			logrus.WithError(err).Error("Failed to dispatch events")
+			wait = poll
+		}
+		wait = min(wait, poll)
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-a.outboxWake:
+		case <-timer.C:
+		}
+		timer.Stop()
+	}
+}
+
+// DispatchOutbox sends a batch of stored events and returns how long to wait
+// before the next batch.
+func (a *App) DispatchOutbox(ctx context.Context) (time.Duration, error) {
+	events, err := a.client.OutboxEvent.Query().
+		Order(ent.Asc(outboxevent.FieldID)).
+		Limit(outboxBatchSize).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var done []int
+	last := a.outboxLastID
+	wait := time.Duration(0)
+	for _, e := range events {
+		now := time.Now()
+		// IDs are taken when events are stored, not when they commit, so a
+		// gap may be an event of a transaction that is still running. Sending
+		// past it would deliver the two out of order; gaps left by rolled
+		// back transactions are passed once the grace period is over.
+		if last != 0 && e.ID > last+1 && now.Sub(e.CreatedAt) < outboxGapGrace {
+			wait = outboxGapGrace - now.Sub(e.CreatedAt)
+			break
+		}
+		if e.ID < last {
+			logrus.WithFields(logrus.Fields{
+				"id":    e.ID,
+				"event": e.Name,
+			}).Warn("Sending event committed after later ones")
+		}
+
+		if now.Sub(e.CreatedAt) > OutboxMaxAge {
+			logrus.WithFields(logrus.Fields{
+				"id":       e.ID,
+				"event":    e.Name,
+				"kind":     e.Kind,
+				"target":   e.Target,
+				"attempts": e.Attempts,
+			}).Error("Dropping event that could not be sent")
+			done = append(done, e.ID)
+			last = max(last, e.ID)
+			continue
+		}
+		if e.NextAttemptAt.After(now) {
+			wait = e.NextAttemptAt.Sub(now)
+			break
+		}
+
+		if err := a.sendEvent(ctx, e); err != nil {
+			backoff := min(outboxMinBackoff<<min(e.Attempts, 16), outboxMaxBackoff)
+			err = a.client.OutboxEvent.UpdateOne(e).
+				AddAttempts(1).
+				SetNextAttemptAt(now.Add(backoff)).
+				Exec(ctx)
+			if err != nil {
+				return 0, err
+			}
+			wait = backoff
+			break
+		}
+		done = append(done, e.ID)
+		last = max(last, e.ID)
+	}
+
+	if len(done) > 0 {
+		if _, err := a.client.OutboxEvent.Delete().Where(outboxevent.IDIn(done...)).Exec(ctx); err != nil {
+			return 0, err
+		}
+		a.outboxLastID = last
+	}
+	if wait == 0 && len(events) < outboxBatchSize {
+		wait = outboxMaxBackoff
+	}
+	return wait, nil
+}
+
+func (a *App) sendEvent(ctx context.Context, e *ent.OutboxEvent) error {
+	ctx, cancel := context.WithTimeout(ctx, outboxSendTimeout)
+	defer cancel()
+
+	data := json.RawMessage(e.Payload)
+	switch e.Kind {
+	case outboxevent.KindTeam:
+		teamID, err := strconv.Atoi(e.Target)
+		if err != nil {
+			return err
+		}
+		return a.Event.TeamMessage(ctx, teamID, e.Name, data)
+	default:
+		return a.Event.Broadcast(ctx, e.Name, data)
+	}
+}
@@ -18,9 +18,7 @@ func NewTestingApp(t *testing.T) *TestingApp {
 	client, err := ent.Open("sqlite3", "file:"+fileID+"?mode=memory&cache=shared&_fk=1")
 	require.NoError(t, err)
 
-	app := &App{
-		client: client,
-	}
+	app := NewApp(client, nil, Config{})
 
 	require.NoError(t, client.Schema.Create(context.Background()))
 
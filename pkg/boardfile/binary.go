@@ -0,0 +1,187 @@
+package boardfile
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// magic starts every binary board file.
+const magic = "NVBD"
+
+// The binary format is, after the magic and all as unsigned varints:
+//
+//	version, name, palette, width, height,
+//	the colors used as a table of names,
+//	the pixel count and for every pixel, ordered by position, the distance to
+//	the previous position, the index of its color in the table and its owner.
+//
+// Strings are written as their length followed by their bytes.
+
+// WriteBinary encodes the board in the compact binary form.
+func WriteBinary(w io.Writer, b *Board) error {
+	bw := bufio.NewWriter(w)
+	e := &encoder{w: bw}
+
+	if err := b.Validate(); err != nil {
+		return err
+	}
+
+	var colors []string
+	index := make(map[string]uint64)
+	for _, p := range b.Pixels {
+		if _, ok := index[p.Color]; !ok {
+			index[p.Color] = uint64(len(colors))
+			colors = append(colors, p.Color)
+		}
+	}
+
+	e.bytes([]byte(magic))
+	e.uvarint(uint64(b.Version))
+	e.string(b.Name)
+	e.string(b.Palette)
+	e.uvarint(uint64(b.Width))
+	e.uvarint(uint64(b.Height))
+
+	e.uvarint(uint64(len(colors)))
+	for _, c := range colors {
+		e.string(c)
+	}
+
+	e.uvarint(uint64(len(b.Pixels)))
+	previous := 0
+	for _, p := range b.Pixels {
+		e.uvarint(uint64(p.Position - previous))
+		e.uvarint(index[p.Color])
+		e.uvarint(uint64(p.Owner))
+		previous = p.Position
+	}
+
+	if e.err != nil {
+		return e.err
+	}
+	return bw.Flush()
+}
+
+// ReadBinary decodes a board written by WriteBinary.
+func ReadBinary(r io.Reader) (*Board, error) {
+	d := &decoder{r: bufio.NewReader(r)}
+
+	head := make([]byte, len(magic))
+	if _, err := io.ReadFull(d.r, head); err != nil || string(head) != magic {
+		return nil, errors.New("not a binary board file")
+	}
+
+	b := &Board{}
+	b.Version = int(d.uvarint())
+	if d.err == nil && (b.Version < 1 || b.Version > Version) {
+		return nil, fmt.Errorf("unsupported board file version %d", b.Version)
+	}
+	b.Name = d.string()
+	b.Palette = d.string()
+	b.Width = int(d.uvarint())
+	b.Height = int(d.uvarint())
+
+	colors := make([]string, d.count())
+	for i := range colors {
+		colors[i] = d.string()
+	}
+
+	// The size is checked before the pixels are read, so a file can not make
+	// the decoder allocate more than a board can hold.
+	count := d.count()
+	if d.err == nil {
+		if err := b.validateSize(count); err != nil {
+			return nil, err
+		}
+	}
+	b.Pixels = make([]Pixel, count)
+	position := 0
+	for i := range b.Pixels {
+		position += int(d.uvarint())
+		color := d.uvarint()
+		owner := d.uvarint()
+		if d.err == nil && color >= uint64(len(colors)) {
+			return nil, fmt.Errorf("pixel %d has an unknown color", position)
+		}
+		if d.err != nil {
+			break
+		}
+		b.Pixels[i] = Pixel{
+			Position: position,
+			Color:    colors[color],
+			Owner:    int64(owner),
+		}
+	}
+
+	if d.err != nil {
+		return nil, fmt.Errorf("decoding board file: %w", d.err)
+	}
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// encoder and decoder keep the first error, so a whole file can be written or
+// read before checking it once.
+type encoder struct {
+	w   io.Writer
+	buf [binary.MaxVarintLen64]byte
+	err error
+}
+
+func (e *encoder) bytes(p []byte) {
+	if e.err == nil {
+		_, e.err = e.w.Write(p)
+	}
+}
+
+func (e *encoder) uvarint(v uint64) {
+	n := binary.PutUvarint(e.buf[:], v)
+	e.bytes(e.buf[:n])
+}
+
+func (e *encoder) string(s string) {
+	e.uvarint(uint64(len(s)))
+	e.bytes([]byte(s))
+}
+
+// maxCount bounds lengths read from a file, so a corrupt file can not make
+// the decoder allocate without limit.
+const maxCount = 1 << 24
+
+type decoder struct {
+	r   *bufio.Reader
+	err error
+}
+
+func (d *decoder) uvarint() uint64 {
+	if d.err != nil {
+		return 0
+	}
+	var v uint64
+	v, d.err = binary.ReadUvarint(d.r)
+	return v
+}
+
+func (d *decoder) count() int {
+	n := d.uvarint()
+	if n > maxCount {
+		d.err = fmt.Errorf("length %d is too large", n)
+		return 0
+	}
+	return int(n)
+}
+
+func (d *decoder) string() string {
+	n := d.count()
+	if d.err != nil {
+		return ""
+	}
+	buf := make([]byte, n)
+	_, d.err = io.ReadFull(d.r, buf)
+	return string(buf)
+}
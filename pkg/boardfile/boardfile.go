@@ -0,0 +1,131 @@
+// Package boardfile is the portable format boards are exported to and
+// imported from. The same board can be written as JSON, which is easy to read
+// and edit, or in a compact binary form for archives.
+package boardfile
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// Version is the version of the format written by this package. Files of a
+// newer version are refused.
+const Version = 1
+
+// MaxSize is the largest width and height of a board file, the same as the
+// largest board the game creates.
+const MaxSize = 512
+
+const (
+	FormatJSON   = "json"
+	FormatBinary = "binary"
+)
+
+// Board is a board with its painted pixels. Unpainted pixels are left out.
+type Board struct {
+	Version int     `json:"version"`
+	Name    string  `json:"name"`
+	Width   int     `json:"width"`
+	Height  int     `json:"height"`
+	Palette string  `json:"palette"`
+	Pixels  []Pixel `json:"pixels"`
+}
+
+// Pixel is a painted pixel. Owner is the Telegram ID of the user who painted
+// it, which stays the same across databases, or zero.
+type Pixel struct {
+	Position int    `json:"position"`
+	Color    string `json:"color"`
+	Owner    int64  `json:"owner,omitempty"`
+}
+
+// Validate checks that the board fits the format: a known version,
+// dimensions up to MaxSize and at most one pixel per position inside the
+// board. It also sorts the pixels by position.
+func (b *Board) Validate() error {
+	if b.Version < 1 || b.Version > Version {
+		return fmt.Errorf("unsupported board file version %d", b.Version)
+	}
+	if err := b.validateSize(len(b.Pixels)); err != nil {
+		return err
+	}
+
+	sort.Slice(b.Pixels, func(i, j int) bool {
+		return b.Pixels[i].Position < b.Pixels[j].Position
+	})
+	for i, p := range b.Pixels {
+		if p.Position < 0 || p.Position >= b.Width*b.Height {
+			return fmt.Errorf("pixel %d is out of bounds", p.Position)
+		}
+		if i > 0 && b.Pixels[i-1].Position == p.Position {
+			return fmt.Errorf("pixel %d appears twice", p.Position)
+		}
+		if p.Color == "" {
+			return fmt.Errorf("pixel %d has no color", p.Position)
+		}
+		if p.Owner < 0 {
+			return fmt.Errorf("pixel %d has an invalid owner", p.Position)
+		}
+	}
+	return nil
+}
+
+// validateSize checks the dimensions and that the board has room for the
+// given number of pixels.
+func (b *Board) validateSize(pixels int) error {
+	if b.Width <= 0 || b.Height <= 0 {
+		return errors.New("board size must be positive")
+	}
+	if b.Width > MaxSize || b.Height > MaxSize {
+		return fmt.Errorf("board size must be at most %dx%d", MaxSize, MaxSize)
+	}
+	if pixels > b.Width*b.Height {
+		return fmt.Errorf("board has %d pixels but only %d positions", pixels, b.Width*b.Height)
+	}
+	return nil
+}
+
+// Write encodes the board in the given format.
+func Write(w io.Writer, b *Board, format string) error {
+	switch format {
+	case FormatJSON:
+		return WriteJSON(w, b)
+	case FormatBinary:
+		return WriteBinary(w, b)
+	default:
+		return fmt.Errorf("unknown board file format %q", format)
+	}
+}
+
+func WriteJSON(w io.Writer, b *Board) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(b)
+}
+
+func ReadJSON(r io.Reader) (*Board, error) {
+	var b Board
+	if err := json.NewDecoder(r).Decode(&b); err != nil {
+		return nil, fmt.Errorf("decoding board file: %w", err)
+	}
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+// Read decodes a board in either format, telling them apart by the binary
+// magic bytes.
+func Read(r io.Reader) (*Board, error) {
+	br := bufio.NewReader(r)
+	head, err := br.Peek(len(magic))
+	if err == nil && bytes.Equal(head, []byte(magic)) {
+		return ReadBinary(br)
+	}
+	return ReadJSON(br)
+}
@@ -0,0 +1,96 @@
+package boardfile
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testBoard() *Board {
+	return &Board{
+		Version: Version,
+		Name:    "event",
+		Width:   4,
+		Height:  3,
+		Palette: "default",
+		Pixels: []Pixel{
+			{Position: 11, Color: "black"},
+			{Position: 0, Color: "red-dark", Owner: 1234567890},
+			{Position: 5, Color: "black", Owner: 42},
+		},
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, format := range []string{FormatJSON, FormatBinary} {
+		t.Run(format, func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, Write(&buf, testBoard(), format))
+
+			decoded, err := Read(&buf)
+			require.NoError(t, err)
+
+			expected := testBoard()
+			require.NoError(t, expected.Validate())
+			assert.Equal(t, expected, decoded)
+		})
+	}
+}
+
+func TestBinaryIsCompact(t *testing.T) {
+	b := &Board{Version: Version, Name: "big", Width: 100, Height: 100, Palette: "default"}
+	for i := 0; i < 10000; i++ {
+		b.Pixels = append(b.Pixels, Pixel{Position: i, Color: "black", Owner: 1})
+	}
+
+	var binary, json bytes.Buffer
+	require.NoError(t, WriteBinary(&binary, b))
+	require.NoError(t, WriteJSON(&json, b))
+	assert.Less(t, binary.Len(), 4*len(b.Pixels))
+	assert.Less(t, binary.Len()*10, json.Len())
+}
+
+func TestInvalidFiles(t *testing.T) {
+	newer := testBoard()
+	newer.Version = Version + 1
+	var buf bytes.Buffer
+	require.NoError(t, WriteJSON(&buf, newer))
+	_, err := Read(&buf)
+	assert.ErrorContains(t, err, "unsupported board file version")
+
+	duplicate := testBoard()
+	duplicate.Pixels = append(duplicate.Pixels, Pixel{Position: 5, Color: "white"})
+	assert.ErrorContains(t, duplicate.Validate(), "appears twice")
+
+	outside := testBoard()
+	outside.Pixels[0].Position = 12
+	assert.ErrorContains(t, outside.Validate(), "out of bounds")
+
+	huge := testBoard()
+	huge.Width = MaxSize + 1
+	assert.ErrorContains(t, huge.Validate(), "at most")
+
+	crowded := testBoard()
+	crowded.Height = 4
+	crowded.Pixels = nil
+	for i := 0; i <= 12; i++ {
+		crowded.Pixels = append(crowded.Pixels, Pixel{Position: i, Color: "white"})
+	}
+	buf.Reset()
+	require.NoError(t, WriteBinary(&buf, crowded))
+	crowded.Height = 3
+	assert.ErrorContains(t, crowded.Validate(), "only 12 positions")
+
+	// A binary file claiming more pixels than the board holds is refused
+	// before they are read.
+	data := bytes.Replace(buf.Bytes(), []byte("default\x04\x04"), []byte("default\x04\x03"), 1)
+	_, err = Read(bytes.NewReader(data))
+	assert.ErrorContains(t, err, "only 12 positions")
+
+	buf.Reset()
+	require.NoError(t, WriteBinary(&buf, testBoard()))
+	_, err = Read(bytes.NewReader(buf.Bytes()[:buf.Len()-2]))
+	assert.Error(t, err)
+}
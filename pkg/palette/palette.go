@@ -0,0 +1,132 @@
+package palette
+
+import (
+	"slices"
+	"sort"
+)
+
+const DefaultName = "default"
+
+type Color struct {
+	Name string `json:"name"`
+	Hex  string `json:"hex"`
+}
+
+type Palette struct {
+	Name   string  `json:"name"`
+	Colors []Color `json:"colors"`
+}
+
+// Default is the palette the web app has always shipped with.
+var Default = Palette{
+	Name: DefaultName,
+	Colors: []Color{
+		{Name: "red-light", Hex: "#FFCDD2"},
+		{Name: "red-dark", Hex: "#EF9A9A"},
+		{Name: "blue-light", Hex: "#BBDEFB"},
+		{Name: "blue-dark", Hex: "#64B5F6"},
+		{Name: "green-light", Hex: "#C8E6C9"},
+		{Name: "green-dark", Hex: "#81C784"},
+		{Name: "yellow-light", Hex: "#FFF9C4"},
+		{Name: "yellow-dark", Hex: "#FFF176"},
+		{Name: "purple-light", Hex: "#E1BEE7"},
+		{Name: "purple-dark", Hex: "#BA68C8"},
+		{Name: "orange-light", Hex: "#FFE0B2"},
+		{Name: "orange-dark", Hex: "#FFB74D"},
+		{Name: "pink-light", Hex: "#F8BBD0"},
+		{Name: "pink-dark", Hex: "#F06292"},
+		{Name: "cyan-light", Hex: "#B2EBF2"},
+		{Name: "cyan-dark", Hex: "#4DD0E1"},
+		{Name: "teal-light", Hex: "#B2DFDB"},
+		{Name: "teal-dark", Hex: "#4DB6AC"},
+		{Name: "white", Hex: "#FFFFFF"},
+		{Name: "black", Hex: "#4c4c4c"},
+		{Name: "gray", Hex: "#B0BEC5"},
+	},
+}
+
+// Classic is the sixteen color palette of the original r/place.
+var Classic = Palette{
+	Name: "classic",
+	Colors: []Color{
+		{Name: "white", Hex: "#FFFFFF"},
+		{Name: "light-gray", Hex: "#E4E4E4"},
+		{Name: "gray", Hex: "#888888"},
+		{Name: "black", Hex: "#222222"},
+		{Name: "pink", Hex: "#FFA7D1"},
+		{Name: "red", Hex: "#E50000"},
+		{Name: "orange", Hex: "#E59500"},
+		{Name: "brown", Hex: "#A06A42"},
+		{Name: "yellow", Hex: "#E5D900"},
+		{Name: "light-green", Hex: "#94E044"},
+		{Name: "green", Hex: "#02BE01"},
+		{Name: "cyan", Hex: "#00D3DD"},
+		{Name: "blue", Hex: "#0083C7"},
+		{Name: "dark-blue", Hex: "#0000EA"},
+		{Name: "purple", Hex: "#CF6EE4"},
+		{Name: "dark-purple", Hex: "#820080"},
+	},
+}
+
+var registry = map[string]Palette{
+	Default.Name: Default,
+	Classic.Name: Classic,
+}
+
+func Register(p Palette) {
+	registry[p.Name] = p
+}
+
+func Get(name string) (Palette, bool) {
+	p, ok := registry[name]
+	return p, ok
+}
+
+// All returns every registered palette ordered by name.
+func All() []Palette {
+	result := make([]Palette, 0, len(registry))
+	for _, p := range registry {
+		result = append(result, p)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
+// IsKnownColor reports whether any registered palette has the given color.
+func IsKnownColor(name string) bool {
+	for _, p := range registry {
+		if p.Contains(name) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p Palette) Contains(name string) bool {
+	return p.Index(name) >= 0
+}
+
+// Index returns the position of the color in the palette, or -1.
+func (p Palette) Index(name string) int {
+	return slices.IndexFunc(p.Colors, func(c Color) bool {
+		return c.Name == name
+	})
+}
+
+func (p Palette) Hex(name string) (string, bool) {
+	i := p.Index(name)
+	if i < 0 {
+		return "", false
+	}
+	return p.Colors[i].Hex, true
+}
+
+func (p Palette) Names() []string {
+	names := make([]string, len(p.Colors))
+	for i, c := range p.Colors {
+		names[i] = c.Name
+	}
+	return names
+}
@@ -0,0 +1,31 @@
+package palette
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaletteLookups(t *testing.T) {
+	hex, ok := Default.Hex("white")
+	assert.True(t, ok)
+	assert.Equal(t, "#FFFFFF", hex)
+
+	assert.Equal(t, 0, Default.Index("red-light"))
+	assert.Equal(t, -1, Default.Index("dark-purple"))
+	assert.True(t, Classic.Contains("dark-purple"))
+
+	assert.True(t, IsKnownColor("dark-purple"))
+	assert.False(t, IsKnownColor("<script>"))
+}
+
+func TestAllIsSortedByName(t *testing.T) {
+	all := All()
+	for i := 1; i < len(all); i++ {
+		assert.Less(t, all[i-1].Name, all[i].Name)
+	}
+
+	p, ok := Get(DefaultName)
+	assert.True(t, ok)
+	assert.Equal(t, Default.Names(), p.Names())
+}
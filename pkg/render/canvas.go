@@ -0,0 +1,104 @@
+package render
+
+import (
+	"image"
+	"image/color"
+	"strconv"
+	"strings"
+
+	"nevissGo/pkg/palette"
+)
+
+const blankColor = "white"
+
+// Canvas is a width×height grid of palette indices.
+type Canvas struct {
+	Width   int
+	Height  int
+	Palette palette.Palette
+
+	pixels []uint8
+	colors color.Palette
+	blank  uint8
+}
+
+func NewCanvas(width, height int, p palette.Palette) *Canvas {
+	c := &Canvas{
+		Width:   width,
+		Height:  height,
+		Palette: p,
+		pixels:  make([]uint8, width*height),
+		colors:  make(color.Palette, len(p.Colors)),
+	}
+
+	for i, pc := range p.Colors {
+		c.colors[i] = parseHex(pc.Hex)
+	}
+
+	if i := p.Index(blankColor); i >= 0 {
+		c.blank = uint8(i)
+	}
+	for i := range c.pixels {
+		c.pixels[i] = c.blank
+	}
+
+	return c
+}
+
+// Set paints the pixel at the given position. Positions outside the canvas and
+// colors outside the palette are ignored, painting them blank instead.
+func (c *Canvas) Set(position int, name string) {
+	if position < 0 || position >= len(c.pixels) {
+		return
+	}
+
+	i := c.Palette.Index(name)
+	if i < 0 {
+		c.pixels[position] = c.blank
+		return
+	}
+	c.pixels[position] = uint8(i)
+}
+
+// Indices returns a copy of the palette index of every pixel, row by row.
+func (c *Canvas) Indices() []uint8 {
+	result := make([]uint8, len(c.pixels))
+	copy(result, c.pixels)
+	return result
+}
+
+// Image renders the canvas with every pixel drawn as a scale×scale square.
+func (c *Canvas) Image(scale int) *image.Paletted {
+	if scale < 1 {
+		scale = 1
+	}
+
+	img := image.NewPaletted(image.Rect(0, 0, c.Width*scale, c.Height*scale), c.colors)
+	for y := 0; y < c.Height; y++ {
+		for x := 0; x < c.Width; x++ {
+			index := c.pixels[y*c.Width+x]
+			for dy := 0; dy < scale; dy++ {
+				row := img.Pix[(y*scale+dy)*img.Stride:]
+				for dx := 0; dx < scale; dx++ {
+					row[x*scale+dx] = index
+				}
+			}
+		}
+	}
+
+	return img
+}
+
+func parseHex(hex string) color.RGBA {
+	value, err := strconv.ParseUint(strings.TrimPrefix(hex, "#"), 16, 32)
+	if err != nil {
+		return color.RGBA{A: 0xff}
+	}
+
+	return color.RGBA{
+		R: uint8(value >> 16),
+		G: uint8(value >> 8),
+		B: uint8(value),
+		A: 0xff,
+	}
+}
@@ -0,0 +1,145 @@
+package render
+
+import (
+	"bufio"
+	"compress/lzw"
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+// gifWriter encodes an animated GIF frame by frame, straight from a canvas.
+// image/gif needs every frame in memory before it encodes any of them, which
+// long timelapses of large boards can not afford. Only one scaled row is held
+// at a time here.
+type gifWriter struct {
+	w      *bufio.Writer
+	width  int
+	height int
+	scale  int
+	bits   int
+	delay  int
+	frames int
+	row    []byte
+}
+
+func newGIFWriter(w io.Writer, canvas *Canvas, scale, delay int) (*gifWriter, error) {
+	if len(canvas.colors) > 256 {
+		return nil, errors.New("gif: palette has more than 256 colors")
+	}
+
+	g := &gifWriter{
+		w:      bufio.NewWriter(w),
+		width:  canvas.Width * scale,
+		height: canvas.Height * scale,
+		scale:  scale,
+		bits:   1,
+		delay:  delay,
+		row:    make([]byte, canvas.Width*scale),
+	}
+	for 1<<g.bits < len(canvas.colors) {
+		g.bits++
+	}
+
+	g.w.WriteString("GIF89a")
+	g.writeUint16(g.width)
+	g.writeUint16(g.height)
+	// A global color table follows; no background color or aspect ratio.
+	g.w.Write([]byte{0x80 | byte(g.bits-1)<<4 | byte(g.bits-1), 0, 0})
+	for i := 0; i < 1<<g.bits; i++ {
+		var r, gr, b uint32
+		if i < len(canvas.colors) {
+			r, gr, b, _ = canvas.colors[i].RGBA()
+		}
+		g.w.Write([]byte{byte(r >> 8), byte(gr >> 8), byte(b >> 8)})
+	}
+
+	// Loop forever.
+	g.w.Write([]byte{0x21, 0xff, 0x0b})
+	g.w.WriteString("NETSCAPE2.0")
+	g.w.Write([]byte{0x03, 0x01, 0x00, 0x00, 0x00})
+	return g, nil
+}
+
+func (g *gifWriter) writeUint16(v int) {
+	g.w.Write(binary.LittleEndian.AppendUint16(nil, uint16(v)))
+}
+
+// frame writes the canvas as it is now.
+func (g *gifWriter) frame(canvas *Canvas) error {
+	g.w.Write([]byte{0x21, 0xf9, 0x04, 0x00})
+	g.writeUint16(g.delay)
+	g.w.Write([]byte{0x00, 0x00})
+
+	g.w.WriteByte(0x2c)
+	g.writeUint16(0)
+	g.writeUint16(0)
+	g.writeUint16(g.width)
+	g.writeUint16(g.height)
+	g.w.WriteByte(0x00)
+
+	litWidth := max(g.bits, 2)
+	g.w.WriteByte(byte(litWidth))
+	blocks := &gifBlockWriter{w: g.w}
+	compressor := lzw.NewWriter(blocks, lzw.LSB, litWidth)
+	for y := 0; y < canvas.Height; y++ {
+		for x := 0; x < canvas.Width; x++ {
+			index := canvas.pixels[y*canvas.Width+x]
+			for dx := 0; dx < g.scale; dx++ {
+				g.row[x*g.scale+dx] = index
+			}
+		}
+		for dy := 0; dy < g.scale; dy++ {
+			if _, err := compressor.Write(g.row); err != nil {
+				return err
+			}
+		}
+	}
+	if err := compressor.Close(); err != nil {
+		return err
+	}
+	if err := blocks.flush(); err != nil {
+		return err
+	}
+	g.frames++
+	return g.w.WriteByte(0x00)
+}
+
+func (g *gifWriter) close() error {
+	g.w.WriteByte(0x3b)
+	return g.w.Flush()
+}
+
+// gifBlockWriter splits image data into the sub-blocks of up to 255 bytes
+// GIF stores it in.
+type gifBlockWriter struct {
+	w   *bufio.Writer
+	buf [255]byte
+	n   int
+}
+
+func (b *gifBlockWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		n := copy(b.buf[b.n:], p)
+		b.n += n
+		p = p[n:]
+		written += n
+		if b.n == len(b.buf) {
+			if err := b.flush(); err != nil {
+				return written, err
+			}
+		}
+	}
+	return written, nil
+}
+
+func (b *gifBlockWriter) flush() error {
+	if b.n == 0 {
+		return nil
+	}
+	b.w.WriteByte(byte(b.n))
+	_, err := b.w.Write(b.buf[:b.n])
+	b.n = 0
+	return err
+}
@@ -0,0 +1,48 @@
+package render
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+)
+
+// gridColor is drawn between cells when grid lines are enabled.
+var gridColor = color.RGBA{R: 0xe0, G: 0xe0, B: 0xe0, A: 0xff}
+
+type SnapshotOptions struct {
+	Scale int
+	Grid  bool
+}
+
+// Snapshot renders the canvas as a still image. Grid lines are only drawn when
+// cells are large enough to stay visible next to them.
+func (c *Canvas) Snapshot(options SnapshotOptions) *image.Paletted {
+	img := c.Image(options.Scale)
+	if !options.Grid || options.Scale < 3 || len(c.colors) >= 256 {
+		return img
+	}
+
+	img.Palette = append(append(color.Palette{}, c.colors...), gridColor)
+	grid := uint8(len(img.Palette) - 1)
+
+	bounds := img.Bounds()
+	for y := 0; y < bounds.Dy(); y++ {
+		for x := 0; x < bounds.Dx(); x++ {
+			if x%options.Scale == 0 || y%options.Scale == 0 {
+				img.Pix[y*img.Stride+x] = grid
+			}
+		}
+	}
+
+	return img
+}
+
+// PNG encodes a snapshot of the canvas.
+func (c *Canvas) PNG(options SnapshotOptions) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, c.Snapshot(options)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
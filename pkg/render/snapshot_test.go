@@ -0,0 +1,38 @@
+package render
+
+import (
+	"bytes"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"nevissGo/pkg/palette"
+)
+
+func TestSnapshot(t *testing.T) {
+	canvas := NewCanvas(2, 1, palette.Default)
+	canvas.Set(1, "black")
+	canvas.Set(5, "black")
+
+	img := canvas.Snapshot(SnapshotOptions{Scale: 4})
+	assert.Equal(t, 8, img.Bounds().Dx())
+	assert.Equal(t, uint8(palette.Default.Index("white")), img.ColorIndexAt(1, 1))
+	assert.Equal(t, uint8(palette.Default.Index("black")), img.ColorIndexAt(5, 1))
+
+	grid := canvas.Snapshot(SnapshotOptions{Scale: 4, Grid: true})
+	assert.Equal(t, gridColor, grid.At(4, 2))
+	assert.Equal(t, gridColor, grid.At(1, 0))
+	assert.Equal(t, uint8(palette.Default.Index("black")), grid.ColorIndexAt(5, 1))
+}
+
+func TestPNG(t *testing.T) {
+	canvas := NewCanvas(3, 3, palette.Default)
+	encoded, err := canvas.PNG(SnapshotOptions{Scale: 2})
+	require.NoError(t, err)
+
+	decoded, err := png.Decode(bytes.NewReader(encoded))
+	require.NoError(t, err)
+	assert.Equal(t, 6, decoded.Bounds().Dx())
+	assert.Equal(t, 6, decoded.Bounds().Dy())
+}
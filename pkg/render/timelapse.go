@@ -0,0 +1,71 @@
+package render
+
+import (
+	"io"
+	"time"
+
+	"nevissGo/pkg/palette"
+)
+
+type Change struct {
+	Position int
+	Color    string
+	At       time.Time
+}
+
+type TimelapseOptions struct {
+	// Interval is the amount of board time covered by each frame.
+	Interval time.Duration
+	// FrameDelay is how long each frame is shown, rounded to 10ms.
+	FrameDelay time.Duration
+	Scale      int
+}
+
+// FrameCount returns how many frames a timelapse of the changes would have.
+func FrameCount(changes []Change, interval time.Duration) int {
+	if len(changes) == 0 || interval <= 0 {
+		return 1
+	}
+
+	span := changes[len(changes)-1].At.Sub(changes[0].At)
+	return int(span/interval) + 2
+}
+
+// Timelapse replays the changes, which must be in the order they were painted,
+// onto a blank canvas and writes a frame every interval to w as an animated
+// GIF. The first frame is the blank canvas and the last one is the final
+// board. Frames are encoded as they are captured, so memory does not grow
+// with their number. It returns how many frames were written.
+func Timelapse(w io.Writer, width, height int, p palette.Palette, changes []Change, options TimelapseOptions) (int, error) {
+	canvas := NewCanvas(width, height, p)
+	delay := int(options.FrameDelay / (10 * time.Millisecond))
+	if delay < 1 {
+		delay = 1
+	}
+
+	encoder, err := newGIFWriter(w, canvas, max(options.Scale, 1), delay)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := encoder.frame(canvas); err != nil {
+		return 0, err
+	}
+	if len(changes) > 0 && options.Interval > 0 {
+		frameEnd := changes[0].At.Add(options.Interval)
+		for _, change := range changes {
+			for !change.At.Before(frameEnd) {
+				if err := encoder.frame(canvas); err != nil {
+					return 0, err
+				}
+				frameEnd = frameEnd.Add(options.Interval)
+			}
+			canvas.Set(change.Position, change.Color)
+		}
+		if err := encoder.frame(canvas); err != nil {
+			return 0, err
+		}
+	}
+
+	return encoder.frames, encoder.close()
+}
@@ -0,0 +1,84 @@
+package render
+
+import (
+	"bytes"
+	"image/gif"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"nevissGo/pkg/palette"
+)
+
+func TestTimelapseFrames(t *testing.T) {
+	start := time.Now()
+	changes := []Change{
+		{Position: 0, Color: "black", At: start},
+		{Position: 1, Color: "red-dark", At: start.Add(30 * time.Second)},
+		{Position: 3, Color: "blue-light", At: start.Add(2*time.Minute + time.Second)},
+	}
+
+	var buf bytes.Buffer
+	frames, err := Timelapse(&buf, 2, 2, palette.Default, changes, TimelapseOptions{
+		Interval:   time.Minute,
+		FrameDelay: 200 * time.Millisecond,
+		Scale:      2,
+	})
+	require.NoError(t, err)
+	animation, err := gif.DecodeAll(&buf)
+	require.NoError(t, err)
+
+	assert.Equal(t, FrameCount(changes, time.Minute), frames)
+	require.Len(t, animation.Image, frames)
+	assert.Len(t, animation.Image, 4)
+	assert.Equal(t, 20, animation.Delay[0])
+	assert.Equal(t, 4, animation.Config.Width)
+
+	white := uint8(palette.Default.Index("white"))
+	black := uint8(palette.Default.Index("black"))
+	blue := uint8(palette.Default.Index("blue-light"))
+
+	first := animation.Image[0]
+	assert.Equal(t, white, first.ColorIndexAt(0, 0))
+
+	second := animation.Image[1]
+	assert.Equal(t, black, second.ColorIndexAt(1, 1))
+	assert.Equal(t, white, second.ColorIndexAt(3, 3))
+
+	last := animation.Image[len(animation.Image)-1]
+	assert.Equal(t, blue, last.ColorIndexAt(3, 3))
+	assert.Equal(t, blue, last.ColorIndexAt(2, 2))
+}
+
+func TestTimelapseWithoutChanges(t *testing.T) {
+	var buf bytes.Buffer
+	frames, err := Timelapse(&buf, 3, 3, palette.Default, nil, TimelapseOptions{Interval: time.Minute, Scale: 1})
+	require.NoError(t, err)
+	animation, err := gif.DecodeAll(&buf)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, frames)
+	assert.Len(t, animation.Image, 1)
+	assert.Equal(t, 1, animation.Delay[0])
+	assert.Equal(t, 3, animation.Config.Width)
+}
+
+func TestTimelapseMatchesCanvas(t *testing.T) {
+	start := time.Now()
+	canvas := NewCanvas(5, 4, palette.Default)
+	var changes []Change
+	for i := 0; i < 20; i++ {
+		color := palette.Default.Colors[(i*7)%len(palette.Default.Colors)].Name
+		changes = append(changes, Change{Position: (i * 3) % 20, Color: color, At: start})
+		canvas.Set((i*3)%20, color)
+	}
+
+	var buf bytes.Buffer
+	_, err := Timelapse(&buf, 5, 4, palette.Default, changes, TimelapseOptions{Interval: time.Minute, Scale: 3})
+	require.NoError(t, err)
+	animation, err := gif.DecodeAll(&buf)
+	require.NoError(t, err)
+
+	assert.Equal(t, canvas.Image(3).Pix, animation.Image[len(animation.Image)-1].Pix)
+}
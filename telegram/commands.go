@@ -0,0 +1,170 @@
+package telegram
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"gopkg.in/telebot.v4"
+	"nevissGo/app/serializer"
+	"nevissGo/ent"
+	"nevissGo/framework"
+	"nevissGo/pkg/render"
+)
+
+const (
+	boardImageScale = 12
+	topLimit        = 10
+	failureMessage  = "مشکلی پیش اومد، لطفا دوباره تلاش کن."
+)
+
+func (t *Telegram) handleBoard(c telebot.Context) error {
+	ctx := context.Background()
+
+	boardID := 0
+	if args := c.Args(); len(args) > 0 {
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			return c.Reply("شماره بورد معتبر نیست.")
+		}
+		boardID = id
+	}
+
+	board, err := t.services.Pixels.GetBoard(ctx, boardID)
+	if err != nil {
+		logrus.WithError(err).WithField("board_id", boardID).Error("couldn't get board for telegram")
+		return c.Reply(failureMessage)
+	}
+
+	image, err := t.services.Pixels.Snapshot(ctx, board.ID, render.SnapshotOptions{Scale: boardImageScale})
+	if err != nil {
+		logrus.WithError(err).WithField("board_id", board.ID).Error("couldn't render board for telegram")
+		return c.Reply(failureMessage)
+	}
+
+	return c.Reply(&telebot.Photo{
+		File:    telebot.FromReader(bytes.NewReader(image)),
+		Caption: fmt.Sprintf("🖼 وضعیت فعلی بورد «%s»", board.Name),
+	})
+}
+
+func (t *Telegram) handleHype(c telebot.Context) error {
+	ctx := context.Background()
+
+	user, err := t.currentUser(ctx, c)
+	if err != nil {
+		return replyUserError(c, err)
+	}
+
+	hype, err := t.services.Hype.GetHype(ctx, user.ID)
+	if err != nil {
+		logrus.WithError(err).WithField("user_id", user.ID).Error("couldn't get hype for telegram")
+		return c.Reply(failureMessage)
+	}
+
+	info := serializer.NewHype(hype)
+	text := fmt.Sprintf("🔥 هایپ تو: %d از %d", info.AmountRemaining, info.MaxHype)
+	if info.AmountRemaining >= info.MaxHype {
+		text += "\n✅ هایپت پره!"
+	} else {
+		secondsPerHype := 60 / float64(hype.HypePerMinute)
+		untilFull := float64(info.TimeUntilNextHype) + float64(info.MaxHype-info.AmountRemaining-1)*secondsPerHype
+		text += fmt.Sprintf("\n⏳ هایپ بعدی: %s", formatDuration(time.Duration(info.TimeUntilNextHype)*time.Second))
+		text += fmt.Sprintf("\n🔋 پر شدن کامل: %s", formatDuration(time.Duration(untilFull)*time.Second))
+	}
+
+	return c.Reply(text)
+}
+
+func (t *Telegram) handleTop(c telebot.Context) error {
+	ctx := context.Background()
+
+	board, entries, err := t.services.Leaderboard.TopByOwnedPixels(ctx, 0, topLimit)
+	if err != nil {
+		logrus.WithError(err).Error("couldn't get leaderboard for telegram")
+		return c.Reply(failureMessage)
+	}
+
+	if len(entries) == 0 {
+		return c.Reply("هنوز کسی روی بورد نقاشی نکرده!")
+	}
+
+	lines := []string{fmt.Sprintf("🏆 برترین‌های بورد «%s»:", board.Name)}
+	for _, entry := range entries {
+		lines = append(lines, fmt.Sprintf("%d. %s — %d تصدانه", entry.Rank, displayName(entry.User), entry.Score))
+	}
+
+	return c.Reply(strings.Join(lines, "\n"))
+}
+
+func (t *Telegram) handleMe(c telebot.Context) error {
+	ctx := context.Background()
+
+	user, err := t.currentUser(ctx, c)
+	if err != nil {
+		return replyUserError(c, err)
+	}
+
+	owned, err := t.services.Leaderboard.OwnedPixels(ctx, user.ID)
+	if err != nil {
+		return c.Reply(failureMessage)
+	}
+
+	paints, err := t.services.Leaderboard.TotalPaints(ctx, user.ID)
+	if err != nil {
+		return c.Reply(failureMessage)
+	}
+
+	return c.Reply(fmt.Sprintf("👤 %s\n🎨 تصدانه‌های فعلی: %d\n🖌 تعداد کل رنگ‌آمیزی‌ها: %d", user.DisplayName, owned, paints))
+}
+
+// currentUser registers the sender on first contact, the same way the web app
+// does, and returns the stored user. Banned users are refused like on the API.
+func (t *Telegram) currentUser(ctx context.Context, c telebot.Context) (*ent.User, error) {
+	sender := c.Sender()
+
+	user := &ent.User{
+		ID:          sender.ID,
+		DisplayName: sender.FirstName,
+	}
+	if err := t.services.Users.GetOrRegister(ctx, user); err != nil {
+		logrus.WithError(err).WithField("user_id", sender.ID).Error("couldn't register telegram user")
+		return nil, err
+	}
+	if err := framework.EnsureNotBanned(user); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// replyUserError answers a command currentUser refused, telling banned users
+// why.
+func replyUserError(c telebot.Context, err error) error {
+	if framework.ExtErrorCode(err) != http.StatusForbidden {
+		return c.Reply(failureMessage)
+	}
+
+	text := "⛔️ حسابت مسدود شده."
+	if reason, _ := framework.ExtErrorFields(err)["reason"].(string); reason != "" {
+		text += "\nدلیل: " + reason
+	}
+	return c.Reply(text)
+}
+
+func displayName(user *ent.User) string {
+	if user == nil {
+		return "؟"
+	}
+	return user.DisplayName
+}
+
+func formatDuration(d time.Duration) string {
+	seconds := int(d.Round(time.Second).Seconds())
+	return fmt.Sprintf("%02d:%02d", seconds/60, seconds%60)
+}
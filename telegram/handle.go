@@ -2,7 +2,6 @@ package telegram
 
 import (
 	"gopkg.in/telebot.v4"
-	"os"
 )
 
 func (t *Telegram) handle(c telebot.Context) error {
@@ -16,7 +15,7 @@ func (t *Telegram) handle(c telebot.Context) error {
 				{
 					Text: "🎮 اجرای بازی",
 					WebApp: &telebot.WebApp{
-						URL: os.Getenv("WEBAPP_URL"),
+						URL: t.webAppURL,
 					},
 				},
 			},
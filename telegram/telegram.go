@@ -3,17 +3,27 @@ package telegram
 import (
 	"github.com/sirupsen/logrus"
 	"gopkg.in/telebot.v4"
-	"os"
+	"nevissGo/app/service"
+	"nevissGo/config"
 	"time"
 )
 
+type Services struct {
+	Users       *service.Users
+	Pixels      *service.Pixels
+	Hype        *service.Hype
+	Leaderboard *service.Leaderboard
+}
+
 type Telegram struct {
-	bot *telebot.Bot
+	bot       *telebot.Bot
+	services  Services
+	webAppURL string
 }
 
-func NewTelegram() (*Telegram, error) {
+func NewTelegram(cfg config.Telegram, services Services) (*Telegram, error) {
 	bot, err := telebot.NewBot(telebot.Settings{
-		Token:  os.Getenv("TELEGRAM_TOKEN"),
+		Token:  cfg.Token,
 		Poller: &telebot.LongPoller{Timeout: 10 * time.Second},
 	})
 
@@ -23,11 +33,27 @@ func NewTelegram() (*Telegram, error) {
 	}
 
 	return &Telegram{
-		bot: bot,
+		bot:       bot,
+		services:  services,
+		webAppURL: cfg.WebAppURL,
 	}, nil
 }
 
 func (t *Telegram) Start() {
+	err := t.bot.SetCommands([]telebot.Command{
+		{Text: "board", Description: "تصویر بورد"},
+		{Text: "hype", Description: "هایپ باقی‌مونده"},
+		{Text: "top", Description: "برترین نقاش‌ها"},
+		{Text: "me", Description: "آمار من"},
+	})
+	if err != nil {
+		logrus.WithError(err).Warn("couldn't set telegram bot commands")
+	}
+
+	t.bot.Handle("/board", t.handleBoard)
+	t.bot.Handle("/hype", t.handleHype)
+	t.bot.Handle("/top", t.handleTop)
+	t.bot.Handle("/me", t.handleMe)
 	t.bot.Handle(telebot.OnText, t.handle)
 
 	t.bot.Start()
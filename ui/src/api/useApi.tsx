@@ -1,11 +1,23 @@
 import {getInitData} from "../hooks/telegram.ts";
 import {HTTPError} from "../store/types.ts";
-import {BoardSerializer, HypeSerializer, UserWithToken} from "../types/serializer.ts";
+import {
+    BoardSerializer,
+    BoardSinceSerializer,
+    ChunkSerializer,
+    CompactBoardSerializer,
+    HypeSerializer,
+    LeaderboardSerializer,
+    PixelWithUserSerializer,
+    RegionsSerializer,
+    TeamMembershipSerializer,
+    UserWithToken
+} from "../types/serializer.ts";
 
-async function call<T>(action: string, data: any) {
+async function call<T>(action: string, data: any): Promise<T> {
     let token = localStorage.getItem("pixel_jwt") || '';
+    const stored = !!token && token !== "undefined";
 
-    if (!token || token === "undefined") {
+    if (!stored) {
         try {
             token = "INIT_DATA:" + getInitData()
         } catch {
@@ -29,26 +41,84 @@ async function call<T>(action: string, data: any) {
 
     const response = await result.json();
     if (!response.ok) {
+        // Stored tokens expire; the Telegram init data logs in again.
+        if (stored && response.error_code === 401) {
+            localStorage.removeItem("pixel_jwt");
+            return call<T>(action, data);
+        }
         throw response as HTTPError;
     }
 
     return response.data as T;
 }
 
+// The board is fetched with one color index per pixel, which is much smaller
+// than sending every pixel as an object. Owners are fetched per pixel.
+function decodeBoard(compact: CompactBoardSerializer): BoardSerializer {
+    const indices = atob(compact.pixels);
+    const pixels: PixelWithUserSerializer[] = new Array(indices.length);
+    for (let i = 0; i < indices.length; i++) {
+        pixels[i] = {id: i, color: compact.colors[indices.charCodeAt(i)], updated_at: 0};
+    }
+
+    return {
+        board_id: compact.board_id,
+        width: compact.width,
+        height: compact.height,
+        seq: compact.seq,
+        updated_at: 0,
+        pixels,
+    };
+}
+
+// Team changes come with a new token so the realtime connection subscribes to
+// the right team channel.
+function storeMembership(membership: TeamMembershipSerializer) {
+    localStorage.setItem("pixel_jwt", membership.token);
+    return membership;
+}
+
 export function useApi() {
     return {
         async login() {
             return await call<UserWithToken>("users/login", {});
         },
         async getBoard() {
-            return await call<BoardSerializer>("pixels/board", {});
+            return decodeBoard(await call<CompactBoardSerializer>("pixels/board", {encoding: "compact"}));
+        },
+        async getChunk(boardId: number, chunkX: number, chunkY: number) {
+            return await call<ChunkSerializer>("pixels/chunk", {board_id: boardId, chunk_x: chunkX, chunk_y: chunkY});
+        },
+        async getPixel(boardId: number, pixelId: number) {
+            return await call<PixelWithUserSerializer>("pixels/get", {board_id: boardId, pixel_id: pixelId});
+        },
+        async getBoardSince(boardId: number, since: number) {
+            return await call<BoardSinceSerializer>("pixels/board_since", {board_id: boardId, since});
         },
         async setPixel(id: number, color: string) {
             return await call<string>("pixels/update", {pixel_id: id, new_color: color});
         },
+        async setPixels(boardId: number, pixels: { pixel_id: number, color: string }[]) {
+            return await call<string>("pixels/update_batch", {board_id: boardId, pixels});
+        },
+        async getRegions(boardId: number) {
+            return await call<RegionsSerializer>("regions/list", {board_id: boardId});
+        },
         async getHype() {
             return await call<HypeSerializer>("hype/count", {});
         },
+        async getLeaderboard(boardId: number, metric: string, window: string) {
+            return await call<LeaderboardSerializer>("leaderboard/top", {board_id: boardId, metric, window, limit: 5});
+        },
+        async createTeam(name: string) {
+            return storeMembership(await call<TeamMembershipSerializer>("teams/create", {name}));
+        },
+        async joinTeam(inviteCode: string) {
+            return storeMembership(await call<TeamMembershipSerializer>("teams/join", {invite_code: inviteCode}));
+        },
+        async leaveTeam() {
+            return storeMembership(await call<TeamMembershipSerializer>("teams/leave", {}));
+        },
         async getOnlineUsersCount() {
             return await call<number>("online_users/count", {});
         }
@@ -1,10 +1,10 @@
 import styles from './BoardUpdateInfo.module.css';
 import {Paragraph} from "./Typo.tsx";
-import {UpdatedBoardSerializer} from "../types/serializer.ts";
+import {PixelUpdatedSerializer} from "../types/serializer.ts";
 
 
 export type BoardUpdateInfoProps = {
-    boardUpdate: UpdatedBoardSerializer | null;
+    boardUpdate: PixelUpdatedSerializer | null;
 }
 
 export function BoardUpdateInfo({boardUpdate}: BoardUpdateInfoProps) {
@@ -0,0 +1,55 @@
+import {useEffect, useState} from "react";
+import classNames from "classnames";
+import styles from './Leaderboard.module.css';
+import {Paragraph} from "./Typo.tsx";
+import {useApi} from "../api/useApi.tsx";
+import {useSubscription} from "../context/centrifuge.tsx";
+import {LeaderboardSerializer, LeaderboardUpdatedSerializer} from "../types/serializer.ts";
+import {forceFarsiNumbers} from "../utils.ts";
+
+export type LeaderboardProps = {
+    boardId: number;
+}
+
+export function Leaderboard({boardId}: LeaderboardProps) {
+    const api = useApi();
+    const [leaderboard, setLeaderboard] = useState<LeaderboardSerializer | null>(null);
+    const update = useSubscription<LeaderboardUpdatedSerializer>("leaderboard:updated");
+
+    useEffect(() => {
+        api.getLeaderboard(boardId, "owned", "all").then(setLeaderboard);
+    }, [boardId]);
+
+    // The event carries the top of the board but not our own rank, so we only
+    // refetch when the broadcast is for the board we are looking at.
+    useEffect(() => {
+        if (!update || update.board_id !== boardId)
+            return;
+
+        api.getLeaderboard(boardId, "owned", "all").then(setLeaderboard);
+    }, [update]);
+
+    if (!leaderboard || leaderboard.entries.length === 0)
+        return null;
+
+    const me = leaderboard.me;
+    const meListed = me && leaderboard.entries.some(entry => entry.rank === me.rank);
+
+    return (
+        <div className={styles.LeaderboardHolder}>
+            <h3>برترین‌ها</h3>
+            {leaderboard.entries.map(entry =>
+                <div key={entry.rank} className={classNames(styles.Entry, {[styles.Me]: me?.rank === entry.rank})}>
+                    <Paragraph>{forceFarsiNumbers(entry.rank)}. {entry.user?.display_name}</Paragraph>
+                    <Paragraph>{forceFarsiNumbers(entry.score)} تصدانه</Paragraph>
+                </div>
+            )}
+            {me && !meListed && (
+                <div className={classNames(styles.Entry, styles.Me)}>
+                    <Paragraph>{forceFarsiNumbers(me.rank)}. {me.user?.display_name}</Paragraph>
+                    <Paragraph>{forceFarsiNumbers(me.score)} تصدانه</Paragraph>
+                </div>
+            )}
+        </div>
+    )
+}
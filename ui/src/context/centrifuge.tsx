@@ -1,6 +1,7 @@
 import React, {createContext, useContext, useEffect, useMemo, useState} from 'react';
 import {Centrifuge, ServerPublicationContext} from 'centrifuge';
-import {useAppSelector} from '../store/store';
+import {useAppDispatch, useAppSelector} from '../store/store';
+import {loginUser} from '../store/user';
 
 export interface CentrifugeContextValue {
     centrifuge: Centrifuge | null;
@@ -21,16 +22,49 @@ interface ServerEvent<T> {
 
 export const CentrifugeProvider: React.FC<CentrifugeProviderProps> = ({url, children}) => {
     const jwtToken = useAppSelector(state => state.user?.auth?.value?.token);
+    const dispatch = useAppDispatch();
 
     const [latestUpdate, setLatestUpdate] = useState<ServerEvent<any> | null>(null);
 
+    // The server can send events itself instead of through Centrifugo.
+    const builtin = import.meta.env.VITE_EVENTS_TRANSPORT === 'builtin';
+
     const centrifuge = useMemo(() => {
-        if (!jwtToken) return null;
+        if (!jwtToken || builtin) return null;
 
+        // Tokens expire, so a new one is fetched when Centrifugo asks for it.
         return new Centrifuge(url, {
-            getToken: async () => jwtToken,
+            token: jwtToken,
+            getToken: async () => (await dispatch(loginUser()).unwrap()).token,
         });
-    }, [url, jwtToken]);
+    }, [url, jwtToken, builtin, dispatch]);
+
+    useEffect(() => {
+        if (!jwtToken || !builtin) return;
+
+        const source = new EventSource(import.meta.env.BASE_URL + "/api/events/sse?token=" + encodeURIComponent(jwtToken));
+
+        source.onopen = () => {
+            console.log("connected to event stream.");
+        };
+
+        source.onmessage = (message: MessageEvent<string>) => {
+            setLatestUpdate(JSON.parse(message.data) as ServerEvent<any>);
+        };
+
+        // The browser retries dropped connections itself but gives up on a
+        // refused one, as when the token expired.
+        source.onerror = () => {
+            if (source.readyState === EventSource.CLOSED) {
+                dispatch(loginUser());
+            }
+        };
+
+        return () => {
+            console.log("disconnected from event stream.")
+            source.close();
+        };
+    }, [jwtToken, builtin, dispatch]);
 
     useEffect(() => {
         if (!centrifuge) return;
@@ -1,11 +1,18 @@
 import styles from './Board.module.css'
 import classNames from "classnames";
-import {useCallback, useEffect, useState} from "react";
+import {useCallback, useEffect, useMemo, useState} from "react";
 import {useDetectClickOutside} from "react-detect-click-outside";
 import {EditForm} from "../components/EditForm.tsx";
 import {Color, colorToHex} from "../types/colors.ts";
 import {useApi} from "../api/useApi.tsx";
-import {BoardSerializer, UpdatedBoardSerializer} from "../types/serializer.ts";
+import {
+    BoardResizedSerializer,
+    BoardSerializer,
+    PixelsUpdatedSerializer,
+    PixelUpdatedSerializer,
+    PixelWithUserSerializer,
+    RegionsSerializer
+} from "../types/serializer.ts";
 import {fetchUserHype} from "../store/user.ts";
 import {useAppDispatch, useAppSelector} from "../store/store.ts";
 import {CenterRow, Row} from "../components/Grid.tsx";
@@ -14,6 +21,7 @@ import {Paragraph} from "../components/Typo.tsx";
 import {forceFarsiNumbers} from "../utils.ts";
 import {FaFire} from "react-icons/fa";
 import {BoardUpdateInfo} from "../components/BoardUpdateInfo.tsx";
+import {Leaderboard} from "../components/Leaderboard.tsx";
 import {useSubscription} from "../context/centrifuge.tsx";
 
 export default function Board() {
@@ -23,25 +31,61 @@ export default function Board() {
     const currentUser = useCurrentUser();
 
 
-    const [lastUpdatedAt, setLastUpdatedAt] = useState<UpdatedBoardSerializer | null>(null);
-    const boardUpdateSig = useSubscription<UpdatedBoardSerializer>("board:updated")
+    const [regions, setRegions] = useState<RegionsSerializer | null>(null);
+    const protectedPixels = useMemo(() => board && regions ? protectedPositions(board, regions) : new Set<number>(), [board?.width, regions]);
 
     useEffect(() => {
-        if (!boardUpdateSig)
+        if (board)
+            api.getRegions(board.board_id).then(setRegions);
+    }, [board?.board_id]);
+
+    const [lastUpdatedAt, setLastUpdatedAt] = useState<PixelUpdatedSerializer | null>(null);
+    const pixelUpdateSig = useSubscription<PixelUpdatedSerializer>("pixel:updated")
+    const pixelsUpdateSig = useSubscription<PixelsUpdatedSerializer>("pixels:updated")
+    const boardResizedSig = useSubscription<BoardResizedSerializer>("board:resized")
+
+    // Events carry consecutive sequence numbers ending at seq. When they do not
+    // follow right after what we have, some were missed and we catch up.
+    const applyUpdate = (boardId: number, pixels: PixelWithUserSerializer[], seq: number) => {
+        if (!board || boardId !== board.board_id || seq <= board.seq)
             return;
 
-        if (!boardUpdateSig.board?.updated_at)
+        if (seq - pixels.length === board.seq) {
+            setBoard(applyPixels(board, pixels, seq));
             return;
+        }
+
+        api.getBoardSince(board.board_id, board.seq).then(delta => {
+            // Pixels moved if the board was resized meanwhile.
+            if (delta.width !== board.width || delta.height !== board.height) {
+                updateBoard();
+                return;
+            }
+            setBoard(current => current && applyPixels(current, delta.pixels, delta.seq));
+        });
+    }
 
-        if (board?.updated_at && board?.updated_at > boardUpdateSig.board?.updated_at)
+    useEffect(() => {
+        if (!pixelUpdateSig?.pixel)
             return;
 
-        if (lastUpdatedAt?.board?.updated_at && lastUpdatedAt.board?.updated_at > boardUpdateSig.board?.updated_at)
+        if (board && pixelUpdateSig.board_id === board.board_id && pixelUpdateSig.seq > board.seq)
+            setLastUpdatedAt(pixelUpdateSig);
+
+        applyUpdate(pixelUpdateSig.board_id, [pixelUpdateSig.pixel], pixelUpdateSig.seq);
+    }, [board, pixelUpdateSig]);
+
+    useEffect(() => {
+        if (!pixelsUpdateSig?.pixels?.length)
             return;
 
-        setLastUpdatedAt(boardUpdateSig);
-        setBoard(boardUpdateSig.board);
-    }, [board?.updated_at, boardUpdateSig, lastUpdatedAt]);
+        applyUpdate(pixelsUpdateSig.board_id, pixelsUpdateSig.pixels, pixelsUpdateSig.seq);
+    }, [board, pixelsUpdateSig]);
+
+    useEffect(() => {
+        if (board && boardResizedSig?.board_id === board.board_id && boardResizedSig.seq > board.seq)
+            updateBoard();
+    }, [board, boardResizedSig]);
 
     const [isLoading, setIsLoading] = useState(false);
     const [countdown, setCountdown] = useState<number | null>(null);
@@ -156,6 +200,7 @@ export default function Board() {
                 <BoardItem key={pixel.id}
                            color={pixel.color as Color}
                            selected={selected === pixel.id}
+                           protected={protectedPixels.has(pixel.id)}
                            onClick={() => handleOnClick(pixel.id)}/>
             )}
         </div>
@@ -164,12 +209,43 @@ export default function Board() {
                                         selected={selected}/>}
 
         {selected === null && <BoardUpdateInfo boardUpdate={lastUpdatedAt}/>}
+
+        {selected === null && board && <Leaderboard boardId={board.board_id}/>}
     </div>
 }
 
-export function BoardItem({color, selected = false, onClick}: {
+function applyPixels(board: BoardSerializer, pixels: PixelWithUserSerializer[], seq: number): BoardSerializer {
+    if (seq <= board.seq)
+        return board;
+
+    const next = board.pixels.slice();
+    for (const pixel of pixels) {
+        next[pixel.id] = pixel;
+    }
+
+    return {...board, pixels: next, seq};
+}
+
+// protectedPositions lists the pixels covered by the regions, following the
+// same mask rules as the server.
+function protectedPositions(board: BoardSerializer, regions: RegionsSerializer): Set<number> {
+    const result = new Set<number>();
+    for (const region of regions.regions) {
+        for (let y = 0; y < region.height; y++) {
+            for (let x = 0; x < region.width; x++) {
+                if (region.mask && region.mask[y * region.width + x] !== '1')
+                    continue;
+                result.add((region.y + y) * board.width + region.x + x);
+            }
+        }
+    }
+    return result;
+}
+
+export function BoardItem({color, selected = false, protected: isProtected = false, onClick}: {
     color: Color;
     selected?: boolean;
+    protected?: boolean;
     onClick?: () => void;
 
 }) {
@@ -198,8 +274,9 @@ export function BoardItem({color, selected = false, onClick}: {
     return <div className={classNames(styles.BoardItem, {
         [styles.Selected]: selected,
         [styles.Changed]: changed,
+        [styles.Protected]: isProtected,
     })}
                 style={style}
-                onClick={onClick}
+                onClick={isProtected ? undefined : onClick}
     ></div>
 }
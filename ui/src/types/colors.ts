@@ -1,9 +1,52 @@
-export const Colors = ['red-light', 'red-dark', 'blue-light', 'blue-dark', 'green-light', 'green-dark',
-    'yellow-light', 'yellow-dark', 'purple-light', 'purple-dark', 'orange-light', 'orange-dark', 'pink-light',
-    'pink-dark', 'cyan-light', 'cyan-dark', 'teal-light', 'teal-dark', 'white', 'black','gray'] as const;
+/* Do not change, this code is generated from Golang palettes */
+
+export const Colors = ['red-light', 'red-dark', 'blue-light', 'blue-dark', 'green-light', 'green-dark', 'yellow-light', 'yellow-dark', 'purple-light', 'purple-dark', 'orange-light', 'orange-dark', 'pink-light', 'pink-dark', 'cyan-light', 'cyan-dark', 'teal-light', 'teal-dark', 'white', 'black', 'gray'] as const;
 
 export type Color = typeof Colors[number];
 
+export const Palettes: Record<string, { name: string, hex: string }[]> = {
+    'classic': [
+        {name: 'white', hex: '#FFFFFF'},
+        {name: 'light-gray', hex: '#E4E4E4'},
+        {name: 'gray', hex: '#888888'},
+        {name: 'black', hex: '#222222'},
+        {name: 'pink', hex: '#FFA7D1'},
+        {name: 'red', hex: '#E50000'},
+        {name: 'orange', hex: '#E59500'},
+        {name: 'brown', hex: '#A06A42'},
+        {name: 'yellow', hex: '#E5D900'},
+        {name: 'light-green', hex: '#94E044'},
+        {name: 'green', hex: '#02BE01'},
+        {name: 'cyan', hex: '#00D3DD'},
+        {name: 'blue', hex: '#0083C7'},
+        {name: 'dark-blue', hex: '#0000EA'},
+        {name: 'purple', hex: '#CF6EE4'},
+        {name: 'dark-purple', hex: '#820080'},
+    ],
+    'default': [
+        {name: 'red-light', hex: '#FFCDD2'},
+        {name: 'red-dark', hex: '#EF9A9A'},
+        {name: 'blue-light', hex: '#BBDEFB'},
+        {name: 'blue-dark', hex: '#64B5F6'},
+        {name: 'green-light', hex: '#C8E6C9'},
+        {name: 'green-dark', hex: '#81C784'},
+        {name: 'yellow-light', hex: '#FFF9C4'},
+        {name: 'yellow-dark', hex: '#FFF176'},
+        {name: 'purple-light', hex: '#E1BEE7'},
+        {name: 'purple-dark', hex: '#BA68C8'},
+        {name: 'orange-light', hex: '#FFE0B2'},
+        {name: 'orange-dark', hex: '#FFB74D'},
+        {name: 'pink-light', hex: '#F8BBD0'},
+        {name: 'pink-dark', hex: '#F06292'},
+        {name: 'cyan-light', hex: '#B2EBF2'},
+        {name: 'cyan-dark', hex: '#4DD0E1'},
+        {name: 'teal-light', hex: '#B2DFDB'},
+        {name: 'teal-dark', hex: '#4DB6AC'},
+        {name: 'white', hex: '#FFFFFF'},
+        {name: 'black', hex: '#4c4c4c'},
+        {name: 'gray', hex: '#B0BEC5'},
+    ],
+};
 
 export function colorToHex(color: Color): string {
     switch (color) {
@@ -53,4 +96,3 @@ export function colorToHex(color: Color): string {
 
     return '#FFFFFF';
 }
-
@@ -22,10 +22,45 @@ export interface PixelWithUserSerializer {
     updated_at: number;
 }
 export interface BoardSerializer {
+    board_id: number;
     pixels: PixelWithUserSerializer[];
     width: number;
     height: number;
     updated_at: number;
+    seq: number;
+}
+export interface CompactBoardSerializer {
+    board_id: number;
+    width: number;
+    height: number;
+    seq: number;
+    colors: string[];
+    pixels: string;
+}
+export interface ChunkSerializer {
+    board_id: number;
+    chunk_x: number;
+    chunk_y: number;
+    x: number;
+    y: number;
+    width: number;
+    height: number;
+    seq: number;
+    board_seq: number;
+    pixels: PixelWithUserSerializer[];
+}
+export interface CompactChunkSerializer {
+    board_id: number;
+    chunk_x: number;
+    chunk_y: number;
+    x: number;
+    y: number;
+    width: number;
+    height: number;
+    seq: number;
+    board_seq: number;
+    colors: string[];
+    pixels: string;
 }
 export interface HypeSerializer {
     amount_remaining: number;
@@ -34,7 +69,165 @@ export interface HypeSerializer {
     time_until_next_hype: number;
     last_updated_at: string;
 }
-export interface UpdatedBoardSerializer {
-    board?: BoardSerializer;
+export interface PixelUpdatedSerializer {
+    board_id: number;
+    pixel?: PixelWithUserSerializer;
     user: User;
+    seq: number;
+}
+export interface PixelsUpdatedSerializer {
+    board_id: number;
+    pixels: PixelWithUserSerializer[];
+    user: User;
+    seq: number;
+}
+export interface BoardSinceSerializer {
+    board_id: number;
+    width: number;
+    height: number;
+    pixels: PixelWithUserSerializer[];
+    seq: number;
+}
+export interface BoardResizedSerializer {
+    board_id: number;
+    width: number;
+    height: number;
+    seq: number;
+}
+export interface BoardInfoSerializer {
+    id: number;
+    name: string;
+    width: number;
+    height: number;
+    cooldown_seconds: number;
+    user_cooldown_seconds: number;
+    hype_cost: number;
+    palette: string;
+    open: boolean;
+    owner?: User;
+}
+export interface ColorSerializer {
+    name: string;
+    hex: string;
+}
+export interface PaletteSerializer {
+    name: string;
+    colors: ColorSerializer[];
+}
+export interface PixelChangeSerializer {
+    board_id: number;
+    pixel_id: number;
+    old_color: string;
+    new_color: string;
+    user?: User;
+    seq: number;
+    created_at: number;
+}
+export interface PixelChangesSerializer {
+    changes: PixelChangeSerializer[];
+    offset: number;
+    limit: number;
+}
+export interface TimelapseSerializer {
+    board_id: number;
+    frames: number;
+    content_type: string;
+    data: string;
+}
+export interface BoardExportSerializer {
+    board_id: number;
+    format: string;
+    content_type: string;
+    data: string;
+}
+export interface LeaderboardEntrySerializer {
+    rank: number;
+    user?: User;
+    score: number;
+}
+export interface LeaderboardSerializer {
+    board_id: number;
+    metric: string;
+    window: string;
+    entries: LeaderboardEntrySerializer[];
+    me?: LeaderboardEntrySerializer;
+}
+export interface LeaderboardUpdatedSerializer {
+    board_id: number;
+    owned?: LeaderboardSerializer;
+    paints?: LeaderboardSerializer;
+}
+export interface TeamSerializer {
+    id: number;
+    name: string;
+    invite_code?: string;
+    members?: User[];
+}
+export interface TeamMembershipSerializer {
+    team?: TeamSerializer;
+    token: string;
+}
+export interface TeamEventSerializer {
+    team_id: number;
+    user: User;
+    text?: string;
+    sent_at: number;
+}
+export interface TeamLeaderboardEntrySerializer {
+    rank: number;
+    team?: TeamSerializer;
+    score: number;
+}
+export interface TeamLeaderboardSerializer {
+    board_id: number;
+    metric: string;
+    window: string;
+    entries: TeamLeaderboardEntrySerializer[];
+    mine?: TeamLeaderboardEntrySerializer;
+}
+export interface HypeLedgerEntrySerializer {
+    kind: string;
+    amount: number;
+    balance: number;
+    reason: string;
+    actor?: User;
+    created_at: number;
+}
+export interface HypeLedgerSerializer {
+    entries: HypeLedgerEntrySerializer[];
+    offset: number;
+    limit: number;
+}
+export interface UserStatusSerializer {
+    board_id: number;
+    user_cooldown_seconds: number;
+    can_paint: boolean;
+    retry_after: number;
+}
+export interface RegionSerializer {
+    id: number;
+    board_id: number;
+    name: string;
+    x: number;
+    y: number;
+    width: number;
+    height: number;
+    mask?: string;
+}
+export interface RegionsSerializer {
+    board_id: number;
+    regions: RegionSerializer[];
+}
+export interface ModeratedUserSerializer {
+    id: string;
+    display_name: string;
+    role: string;
+    banned: boolean;
+    ban_reason?: string;
+    banned_at?: number;
+}
+export interface ModerationResultSerializer {
+    board_id: number;
+    changed: number;
+    seq: number;
 }
\ No newline at end of file
@@ -1 +1,5 @@
 /// <reference types="vite/client" />
+
+interface ImportMetaEnv {
+    readonly VITE_EVENTS_TRANSPORT?: 'centrifugo' | 'builtin';
+}